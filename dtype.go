@@ -16,38 +16,71 @@ type DType struct {
 	reflect.Type
 }
 
-/*
- * // Custom-made Float16 as not exist in Go
- * // Ref: https://github.com/golang/go/issues/32022
- * type GoFloat16 = int16 // not implemented yet
- * type GoComplexHalf = interface{} // not implemented yet!
- *  */
+// Custom-made types for dtypes which do not exist in Go.
+// Ref: https://github.com/golang/go/issues/32022
+//
+// They hold raw bits of a value and are used to distinguish DTypes only.
+// Tensor values of these dtypes are converted to/from Go `float32` (Half,
+// BFloat16) and `complex64` (ComplexHalf).
+type (
+	GoFloat16     uint16
+	GoBFloat16    uint16
+	GoComplexHalf uint32
+)
 
 // TODO: double check these Torch DType to Go type
 var (
-	Uint8 DType = DType{reflect.TypeOf(uint8(1))} // 0
-	Int8  DType = DType{reflect.TypeOf(int8(1))}  // 1
-	Int16 DType = DType{reflect.TypeOf(int16(1))} // 2
-	Int   DType = DType{reflect.TypeOf(int32(1))} // 3
-	Int64 DType = DType{reflect.TypeOf(int64(1))} // 4
-	// Half       DType   = DType{reflect.TypeOf(GoFloat16(1))}     // 5
-	Float  DType = DType{reflect.TypeOf(float32(1))} // 6
-	Double DType = DType{reflect.TypeOf(float64(1))} // 7
-	// ComplexHalf DType  = DType{reflect.TypeOf(GoComplexHalf(1))} // 8
-	// ComplexFloat DType  = DType{reflect.TypeOf(complex64(1))}  // 9
-	// ComplexDouble DType = DType{reflect.TypeOf(complex128(1))} // 10
-	Bool DType = DType{reflect.TypeOf(true)} // 11
+	Uint8         DType = DType{reflect.TypeOf(uint8(1))}         // 0
+	Int8          DType = DType{reflect.TypeOf(int8(1))}          // 1
+	Int16         DType = DType{reflect.TypeOf(int16(1))}         // 2
+	Int           DType = DType{reflect.TypeOf(int32(1))}         // 3
+	Int64         DType = DType{reflect.TypeOf(int64(1))}         // 4
+	Half          DType = DType{reflect.TypeOf(GoFloat16(1))}     // 5
+	Float         DType = DType{reflect.TypeOf(float32(1))}       // 6
+	Double        DType = DType{reflect.TypeOf(float64(1))}       // 7
+	ComplexHalf   DType = DType{reflect.TypeOf(GoComplexHalf(1))} // 8
+	ComplexFloat  DType = DType{reflect.TypeOf(complex64(1))}     // 9
+	ComplexDouble DType = DType{reflect.TypeOf(complex128(1))}    // 10
+	Bool          DType = DType{reflect.TypeOf(true)}             // 11
+	BFloat16      DType = DType{reflect.TypeOf(GoBFloat16(1))}    // 15
 )
 
+// String returns dtype name. It is Go type name for dtypes having Go
+// equivalent types.
+func (dt DType) String() string {
+	switch dt {
+	case Half:
+		return "float16"
+	case BFloat16:
+		return "bfloat16"
+	case ComplexHalf:
+		return "complex32"
+	case DType{}:
+		return "invalid"
+	}
+
+	return dt.Type.String()
+}
+
 var dtypeGoType = map[DType]reflect.Type{
-	Uint8:  reflect.TypeOf(uint8(1)),
-	Int8:   reflect.TypeOf(int8(1)),
-	Int16:  reflect.TypeOf(int16(1)),
-	Int:    reflect.TypeOf(int32(1)),
-	Int64:  reflect.TypeOf(int64(1)),
-	Float:  reflect.TypeOf(float32(1)),
-	Double: reflect.TypeOf(float64(1)),
-	Bool:   reflect.TypeOf(true),
+	Uint8:         reflect.TypeOf(uint8(1)),
+	Int8:          reflect.TypeOf(int8(1)),
+	Int16:         reflect.TypeOf(int16(1)),
+	Int:           reflect.TypeOf(int32(1)),
+	Int64:         reflect.TypeOf(int64(1)),
+	Float:         reflect.TypeOf(float32(1)),
+	Double:        reflect.TypeOf(float64(1)),
+	ComplexFloat:  reflect.TypeOf(complex64(1)),
+	ComplexDouble: reflect.TypeOf(complex128(1)),
+	Bool:          reflect.TypeOf(true),
+}
+
+// dtypeValueType is a map of DTypes without native Go type and Go type
+// of their values.
+var dtypeValueType = map[DType]reflect.Type{
+	Half:        reflect.TypeOf(float32(1)),
+	BFloat16:    reflect.TypeOf(float32(1)),
+	ComplexHalf: reflect.TypeOf(complex64(1)),
 }
 
 // ToDType infers and returns supported equivalent DType from given Go type
@@ -69,8 +102,14 @@ func ToDType(typ reflect.Type) (retVal DType, err error) {
 	return retVal, nil
 }
 
-// ToGoType infers and returns supported equivalent Go type from given DType
+// ToGoType infers and returns supported equivalent Go type from given DType.
+//
+// NOTE. Half and BFloat16 return `float32`, ComplexHalf returns `complex64`.
 func ToGoType(dtype DType) (retVal reflect.Type, err error) {
+	if typ, ok := dtypeValueType[dtype]; ok {
+		return typ, nil
+	}
+
 	if _, ok := dtypeGoType[dtype]; !ok {
 		err = fmt.Errorf("Unsupported DType %v", dtype)
 		return nil, err
@@ -82,19 +121,25 @@ func ToGoType(dtype DType) (retVal reflect.Type, err error) {
 }
 
 var dtypeCInt = map[DType]CInt{
-	Uint8:  0,
-	Int8:   1,
-	Int16:  2,
-	Int:    3,
-	Int64:  4,
-	Float:  6,
-	Double: 7,
-	Bool:   11,
+	Uint8:         0,
+	Int8:          1,
+	Int16:         2,
+	Int:           3,
+	Int64:         4,
+	Half:          5,
+	Float:         6,
+	Double:        7,
+	ComplexHalf:   8,
+	ComplexFloat:  9,
+	ComplexDouble: 10,
+	Bool:          11,
+	BFloat16:      15,
 }
 
 func DType2CInt(dt DType) (retVal CInt, err error) {
 	if _, ok := dtypeCInt[dt]; !ok {
 		err = fmt.Errorf("Unsupported CInt conversion from DType: %v\n", dt)
+		return -1, err
 	}
 
 	retVal = dtypeCInt[dt]
@@ -132,14 +177,19 @@ func CInt2DType(v CInt) (dtype DType, err error) {
 
 // dtypeSize is a map of DType and its size in Bytes
 var dtypeSize = map[DType]uint{
-	Uint8:  1,
-	Int8:   1,
-	Int16:  2,
-	Int:    4,
-	Int64:  8,
-	Float:  4,
-	Double: 8,
-	Bool:   1,
+	Uint8:         1,
+	Int8:          1,
+	Int16:         2,
+	Int:           4,
+	Int64:         8,
+	Half:          2,
+	Float:         4,
+	Double:        8,
+	ComplexHalf:   4,
+	ComplexFloat:  8,
+	ComplexDouble: 16,
+	Bool:          1,
+	BFloat16:      2,
 }
 
 // DTypeSize returns DType size in Bytes
@@ -212,7 +262,7 @@ func dataCheck(data interface{}, count int) (k reflect.Type, n int, err error) {
 
 		return goType, total, nil
 
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Bool:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
 		total++
 		if goType.String() != "invalid" {
 			goType = v.Type()
@@ -234,7 +284,7 @@ func ElementGoType(data interface{}) (retVal reflect.Type, err error) {
 func elementType(data reflect.Value) (dataType reflect.Type, err error) {
 	dataKind := data.Kind()
 	switch dataKind {
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Bool:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
 		dataType = data.Type()
 	case reflect.Slice, reflect.Array:
 		data = data.Elem()
@@ -279,6 +329,10 @@ func ElementDType(v interface{}) (retVal DType, err error) {
 		retVal = Float
 	case float64:
 		retVal = Double
+	case complex64:
+		retVal = ComplexFloat
+	case complex128:
+		retVal = ComplexDouble
 	case bool:
 		retVal = Bool
 	default:
@@ -327,14 +381,16 @@ func TypeOf(dt DType, shape []int64) (retVal reflect.Type, err error) {
  *  */
 
 var supportedTypes = map[reflect.Kind]bool{
-	reflect.Uint8:   true,
-	reflect.Int8:    true,
-	reflect.Int16:   true,
-	reflect.Int32:   true,
-	reflect.Int64:   true,
-	reflect.Float32: true,
-	reflect.Float64: true,
-	reflect.Bool:    true,
+	reflect.Uint8:      true,
+	reflect.Int8:       true,
+	reflect.Int16:      true,
+	reflect.Int32:      true,
+	reflect.Int64:      true,
+	reflect.Float32:    true,
+	reflect.Float64:    true,
+	reflect.Complex64:  true,
+	reflect.Complex128: true,
+	reflect.Bool:       true,
 }
 
 var scalarTypes = map[reflect.Kind]bool{
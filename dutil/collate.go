@@ -0,0 +1,394 @@
+package dutil
+
+import (
+	"fmt"
+	"reflect"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// CollateFunc turns samples of a batch into batched data, e.g. tensors with
+// a batch dimension.
+type CollateFunc func(samples []interface{}) (interface{}, error)
+
+var (
+	tensorType         = reflect.TypeOf(ts.Tensor{})
+	tensorPtrType      = reflect.TypeOf(&ts.Tensor{})
+	paddedBatchPtrType = reflect.TypeOf(&PaddedBatch{})
+)
+
+// DefaultCollate is the default CollateFunc. It recursively collates samples
+// of the same type:
+//
+//   - *ts.Tensor (or ts.Tensor): stacked along a new dimension 0.
+//   - numeric scalars (bool, integers, floats) and (nested) slices or arrays of
+//     them with the same length: a tensor of shape [batch, length...]. Go
+//     int, uint, uint16, uint32 and uint64 become Int64 tensors.
+//   - string: []string.
+//   - structs and maps with string keys: map[string]interface{} of collated
+//     exported fields or values.
+//   - other slices and arrays of the same length: []interface{} of collated
+//     elements at each position, e.g. [images, labels] for []ts.Tensor
+//     samples {image, label}.
+//
+// It does not drop tensors of samples.
+func DefaultCollate(samples []interface{}) (interface{}, error) {
+	if len(samples) == 0 {
+		err := fmt.Errorf("DefaultCollate: empty batch.\n")
+		return nil, err
+	}
+
+	values := make([]reflect.Value, len(samples))
+	for i, s := range samples {
+		values[i] = reflect.ValueOf(s)
+	}
+
+	return collate(values)
+}
+
+func collate(values []reflect.Value) (interface{}, error) {
+	for i, v := range values {
+		for v.IsValid() && v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		if !v.IsValid() {
+			err := fmt.Errorf("DefaultCollate: nil sample.\n")
+			return nil, err
+		}
+		values[i] = v
+	}
+
+	typ := values[0].Type()
+	for _, v := range values[1:] {
+		if v.Type() != typ {
+			err := fmt.Errorf("DefaultCollate: samples of different types (%v and %v).\n", typ, v.Type())
+			return nil, err
+		}
+	}
+
+	switch {
+	case typ == tensorPtrType || typ == tensorType:
+		tensors := make([]ts.Tensor, len(values))
+		for i, v := range values {
+			if typ == tensorPtrType {
+				tensors[i] = *v.Interface().(*ts.Tensor)
+			} else {
+				tensors[i] = v.Interface().(ts.Tensor)
+			}
+		}
+		return ts.Stack(tensors, 0)
+
+	case isNumeric(typ):
+		return numericTensor(values)
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		strs := make([]string, len(values))
+		for i, v := range values {
+			strs[i] = v.String()
+		}
+		return strs, nil
+
+	case reflect.Struct:
+		batch := make(map[string]interface{})
+		for f := 0; f < typ.NumField(); f++ {
+			field := typ.Field(f)
+			if field.PkgPath != "" { // unexported
+				continue
+			}
+			fields := make([]reflect.Value, len(values))
+			for i, v := range values {
+				fields[i] = v.Field(f)
+			}
+			data, err := collate(fields)
+			if err != nil {
+				dropItem(batch)
+				return nil, err
+			}
+			batch[field.Name] = data
+		}
+		return batch, nil
+
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			err := fmt.Errorf("DefaultCollate: unsupported map key type %v.\n", typ.Key())
+			return nil, err
+		}
+		batch := make(map[string]interface{})
+		for _, key := range values[0].MapKeys() {
+			elems := make([]reflect.Value, len(values))
+			for i, v := range values {
+				elems[i] = v.MapIndex(key)
+				if !elems[i].IsValid() {
+					dropItem(batch)
+					err := fmt.Errorf("DefaultCollate: key %q missing in sample %v.\n", key.String(), i)
+					return nil, err
+				}
+			}
+			data, err := collate(elems)
+			if err != nil {
+				dropItem(batch)
+				return nil, err
+			}
+			batch[key.String()] = data
+		}
+		return batch, nil
+
+	case reflect.Slice, reflect.Array:
+		n := values[0].Len()
+		for _, v := range values[1:] {
+			if v.Len() != n {
+				err := fmt.Errorf("DefaultCollate: samples of different lengths (%v and %v).\n", n, v.Len())
+				return nil, err
+			}
+		}
+		batch := make([]interface{}, n)
+		for j := 0; j < n; j++ {
+			elems := make([]reflect.Value, len(values))
+			for i, v := range values {
+				elems[i] = v.Index(j)
+			}
+			data, err := collate(elems)
+			if err != nil {
+				dropItem(batch)
+				return nil, err
+			}
+			batch[j] = data
+		}
+		return batch, nil
+
+	default:
+		err := fmt.Errorf("DefaultCollate: unsupported sample type %v.\n", typ)
+		return nil, err
+	}
+}
+
+// isNumeric returns whether typ is a numeric scalar type or a (nested) slice
+// or array of it.
+func isNumeric(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		return isNumeric(typ.Elem())
+	default:
+		_, ok := numericGoType(typ.Kind())
+		return ok
+	}
+}
+
+// numericGoType returns Go type of tensor elements for a numeric kind.
+func numericGoType(kind reflect.Kind) (reflect.Type, bool) {
+	switch kind {
+	case reflect.Bool:
+		return reflect.TypeOf(false), true
+	case reflect.Uint8:
+		return reflect.TypeOf(uint8(0)), true
+	case reflect.Int8:
+		return reflect.TypeOf(int8(0)), true
+	case reflect.Int16:
+		return reflect.TypeOf(int16(0)), true
+	case reflect.Int32:
+		return reflect.TypeOf(int32(0)), true
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.TypeOf(int64(0)), true
+	case reflect.Float32:
+		return reflect.TypeOf(float32(0)), true
+	case reflect.Float64:
+		return reflect.TypeOf(float64(0)), true
+	default:
+		return nil, false
+	}
+}
+
+// numericTensor creates a tensor of shape [len(values), length...] from
+// numeric scalars or (nested) slices or arrays of them.
+func numericTensor(values []reflect.Value) (*ts.Tensor, error) {
+	shape := []int64{int64(len(values))}
+	for v := values[0]; v.Kind() == reflect.Slice || v.Kind() == reflect.Array; {
+		shape = append(shape, int64(v.Len()))
+		if v.Len() == 0 {
+			break
+		}
+		v = v.Index(0)
+	}
+
+	typ := values[0].Type()
+	for typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+		typ = typ.Elem()
+	}
+	goType, _ := numericGoType(typ.Kind())
+
+	data := reflect.MakeSlice(reflect.SliceOf(goType), 0, int(ts.ElementCount(shape)))
+	var flatten func(v reflect.Value, dim int) error
+	flatten = func(v reflect.Value, dim int) error {
+		if dim == len(shape) {
+			data = reflect.Append(data, v.Convert(goType))
+			return nil
+		}
+		if v.Len() != int(shape[dim]) {
+			err := fmt.Errorf("DefaultCollate: samples of different lengths (%v and %v) at dimension %v.\n", shape[dim], v.Len(), dim)
+			return err
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := flatten(v.Index(i), dim+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, v := range values {
+		if err := flatten(v, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	x, err := ts.OfSlice(data.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	return x.View(shape, true)
+}
+
+// PaddedBatch is a batch of variable-length sequences padded to the same
+// length.
+type PaddedBatch struct {
+	Data    *ts.Tensor // [batch, maxLen, ...] padded sequences
+	Lengths *ts.Tensor // Int64 [batch] lengths of sequences, on CPU
+	Mask    *ts.Tensor // Bool [batch, maxLen], true at non-padded positions, on CPU
+}
+
+// Drop drops tensors of the batch.
+func (b *PaddedBatch) Drop() {
+	b.Data.MustDrop()
+	b.Lengths.MustDrop()
+	b.Mask.MustDrop()
+}
+
+// NewPadCollate creates a CollateFunc for variable-length sequences. Samples
+// are *ts.Tensor of shape [length, ...] with the same trailing dimensions, or
+// numeric slices, which are padded with `padValue` to the longest length and
+// returned as *PaddedBatch.
+//
+// It does not drop tensors of samples.
+func NewPadCollate(padValue float64) CollateFunc {
+	return func(samples []interface{}) (interface{}, error) {
+		return padCollate(samples, padValue)
+	}
+}
+
+func padCollate(samples []interface{}, padValue float64) (*PaddedBatch, error) {
+	if len(samples) == 0 {
+		err := fmt.Errorf("PadCollate: empty batch.\n")
+		return nil, err
+	}
+
+	seqs := make([]*ts.Tensor, len(samples))
+	defer func() {
+		// drop tensors created from slices.
+		for i, s := range samples {
+			if _, ok := s.(*ts.Tensor); !ok && seqs[i] != nil {
+				seqs[i].MustDrop()
+			}
+		}
+	}()
+	for i, s := range samples {
+		switch s := s.(type) {
+		case *ts.Tensor:
+			seqs[i] = s
+		default:
+			v := reflect.ValueOf(s)
+			if !v.IsValid() || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || !isNumeric(v.Type()) {
+				err := fmt.Errorf("PadCollate: expected sample of *ts.Tensor or numeric slice, got %T.\n", s)
+				return nil, err
+			}
+			x, err := numericTensor([]reflect.Value{v})
+			if err == nil {
+				x, err = x.Squeeze1(0, true)
+			}
+			if err != nil {
+				return nil, err
+			}
+			seqs[i] = x
+		}
+	}
+
+	shapes := make([][]int64, len(seqs))
+	var maxLen int64
+	for i, x := range seqs {
+		shape, err := x.Size()
+		if err != nil {
+			return nil, err
+		}
+		if len(shape) == 0 {
+			err := fmt.Errorf("PadCollate: expected sequences, got a scalar tensor.\n")
+			return nil, err
+		}
+		shapes[i] = shape
+		if shape[0] > maxLen {
+			maxLen = shape[0]
+		}
+	}
+
+	device, err := seqs[0].Device()
+	if err != nil {
+		return nil, err
+	}
+	dtype, err := seqs[0].DTypeE()
+	if err != nil {
+		return nil, err
+	}
+	size := append([]int64{int64(len(seqs)), maxLen}, shapes[0][1:]...)
+	padScalar := ts.FloatScalar(padValue)
+	data, err := ts.Full(size, padScalar, dtype, device)
+	padScalar.MustDrop()
+	if err != nil {
+		return nil, err
+	}
+
+	lengths := make([]int64, len(seqs))
+	mask := make([]bool, int64(len(seqs))*maxLen)
+	for i, x := range seqs {
+		lengths[i] = shapes[i][0]
+		for j := int64(0); j < lengths[i]; j++ {
+			mask[int64(i)*maxLen+j] = true
+		}
+		if lengths[i] == 0 {
+			continue
+		}
+
+		row, err := data.Select(0, int64(i), false)
+		if err == nil {
+			row, err = row.Narrow(0, 0, lengths[i], true)
+		}
+		if err == nil {
+			err = row.Copy_(x)
+			row.MustDrop()
+		}
+		if err != nil {
+			data.MustDrop()
+			return nil, err
+		}
+	}
+
+	lengthsTs, err := ts.OfSlice(lengths)
+	if err != nil {
+		data.MustDrop()
+		return nil, err
+	}
+	maskTs, err := ts.OfSlice(mask)
+	if err == nil {
+		maskTs, err = maskTs.View([]int64{int64(len(seqs)), maxLen}, true)
+	}
+	if err != nil {
+		data.MustDrop()
+		lengthsTs.MustDrop()
+		return nil, err
+	}
+
+	return &PaddedBatch{
+		Data:    data,
+		Lengths: lengthsTs,
+		Mask:    maskTs,
+	}, nil
+}
@@ -0,0 +1,183 @@
+package dutil_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch/dutil"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestDefaultCollate(t *testing.T) {
+	type sample struct {
+		Feature []float32
+		Label   int
+		Name    string
+	}
+	samples := []interface{}{
+		sample{[]float32{1, 2, 3}, 0, "a"},
+		sample{[]float32{4, 5, 6}, 1, "b"},
+	}
+
+	got, err := dutil.DefaultCollate(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := got.(map[string]interface{})
+	feature := batch["Feature"].(*ts.Tensor)
+	label := batch["Label"].(*ts.Tensor)
+	defer feature.MustDrop()
+	defer label.MustDrop()
+
+	wantShape := []int64{2, 3}
+	if !reflect.DeepEqual(wantShape, feature.MustSize()) {
+		t.Errorf("Want: %v\n", wantShape)
+		t.Errorf("Got: %v\n", feature.MustSize())
+	}
+	wantFeature := []float32{1, 2, 3, 4, 5, 6}
+	if !reflect.DeepEqual(wantFeature, feature.Vals()) {
+		t.Errorf("Want: %v\n", wantFeature)
+		t.Errorf("Got: %v\n", feature.Vals())
+	}
+	wantLabel := []int64{0, 1}
+	if !reflect.DeepEqual(wantLabel, label.Vals()) {
+		t.Errorf("Want: %v\n", wantLabel)
+		t.Errorf("Got: %v\n", label.Vals())
+	}
+	wantName := []string{"a", "b"}
+	if !reflect.DeepEqual(wantName, batch["Name"]) {
+		t.Errorf("Want: %v\n", wantName)
+		t.Errorf("Got: %v\n", batch["Name"])
+	}
+
+	// Samples of tensor pairs are collated position-wise.
+	x1, y1 := ts.MustOfSlice([]float64{1, 2}), ts.MustOfSlice([]int64{0})
+	x2, y2 := ts.MustOfSlice([]float64{3, 4}), ts.MustOfSlice([]int64{1})
+	got, err = dutil.DefaultCollate([]interface{}{[]*ts.Tensor{x1, y1}, []*ts.Tensor{x2, y2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair := got.([]interface{})
+	xs, ys := pair[0].(*ts.Tensor), pair[1].(*ts.Tensor)
+	wantShape = []int64{2, 1}
+	if !reflect.DeepEqual(wantShape, ys.MustSize()) {
+		t.Errorf("Want: %v\n", wantShape)
+		t.Errorf("Got: %v\n", ys.MustSize())
+	}
+	for _, x := range []*ts.Tensor{xs, ys, x1, y1, x2, y2} {
+		x.MustDrop()
+	}
+
+	if _, err := dutil.DefaultCollate([]interface{}{1, "a"}); err == nil {
+		t.Errorf("Want error for samples of different types\n")
+	}
+}
+
+func TestPadCollate(t *testing.T) {
+	collate := dutil.NewPadCollate(0)
+	got, err := collate([]interface{}{[]int64{1, 2}, []int64{3, 4, 5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := got.(*dutil.PaddedBatch)
+	defer batch.Drop()
+
+	wantData := []int64{1, 2, 0, 3, 4, 5}
+	if !reflect.DeepEqual(wantData, batch.Data.Vals()) {
+		t.Errorf("Want: %v\n", wantData)
+		t.Errorf("Got: %v\n", batch.Data.Vals())
+	}
+	wantLengths := []int64{2, 3}
+	if !reflect.DeepEqual(wantLengths, batch.Lengths.Vals()) {
+		t.Errorf("Want: %v\n", wantLengths)
+		t.Errorf("Got: %v\n", batch.Lengths.Vals())
+	}
+	wantMask := []bool{true, true, false, true, true, true}
+	if !reflect.DeepEqual(wantMask, batch.Mask.Vals()) {
+		t.Errorf("Want: %v\n", wantMask)
+		t.Errorf("Got: %v\n", batch.Mask.Vals())
+	}
+}
+
+func TestDataLoader_Collate(t *testing.T) {
+	data, err := dutil.NewSliceDataset([]int{0, 1, 2, 3, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := dutil.NewBatchSampler(5, 2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dl, err := dutil.NewDataLoader(data, s, dutil.WithCollate(dutil.DefaultCollate))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wants := [][]int64{{0, 1}, {2, 3}, {4}}
+	for _, want := range wants {
+		batch, err := dl.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		x := batch.(*ts.Tensor)
+		if !reflect.DeepEqual(want, x.Vals()) {
+			t.Errorf("Want: %v\n", want)
+			t.Errorf("Got: %v\n", x.Vals())
+		}
+		x.MustDrop()
+	}
+}
+
+func TestDataLoader_CollateOwnership(t *testing.T) {
+	// Tensors held by a SliceDataset are not dropped by DataLoader.
+	xs := []*ts.Tensor{ts.MustOfSlice([]float32{1, 2}), ts.MustOfSlice([]float32{3, 4})}
+	data, err := dutil.NewSliceDataset(xs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := dutil.NewBatchSampler(2, 2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dl, err := dutil.NewDataLoader(data, s, dutil.WithCollate(dutil.DefaultCollate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for epoch := 0; epoch < 2; epoch++ {
+		batch, err := dl.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch.(*ts.Tensor).MustDrop()
+		dl.Reset()
+	}
+	want := []float32{1, 2}
+	if !reflect.DeepEqual(want, xs[0].Vals()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", xs[0].Vals())
+	}
+	for _, x := range xs {
+		x.MustDrop()
+	}
+
+	// Samples of a TensorDataset are new tensors dropped after collating.
+	x := ts.MustOfSlice([]float32{1, 2, 3, 4}).MustView([]int64{2, 2}, true)
+	defer x.MustDrop()
+	ts.CheckLeaks(t, func() {
+		data, err := dutil.NewTensorDataset(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dl, err := dutil.NewDataLoader(data, s, dutil.WithCollate(dutil.DefaultCollate))
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch, err := dl.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, b := range batch.([]interface{}) {
+			b.(*ts.Tensor).MustDrop()
+		}
+	})
+}
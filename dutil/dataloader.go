@@ -1,6 +1,7 @@
 package dutil
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
@@ -9,19 +10,96 @@ import (
 
 // DataLoader combines a dataset and a sampler and provides
 // an iterable over the given dataset.
+//
+// By default, samples are loaded on the caller's goroutine. With
+// `WithNumWorkers(n)`, they are loaded by n worker goroutines ahead of time
+// (see `WithPrefetch`), each worker loading a whole batch, and returned in
+// sampler order. `Dataset.Item` must then be safe for concurrent use.
+// Workers of `Next` run until the end of the iteration, `Reset` or `Close`.
 type DataLoader struct {
 	dataset   Dataset
+	sampler   Sampler
 	indexes   []int // order of samples in dataset for interation.
 	batchSize int
 	currIdx   int
+
+	numWorkers int
+	prefetch   int
+	collate    CollateFunc
+	fresh      bool        // whether dataset items are new tensors, see FreshItemDataset
+	iter       *loaderIter // ongoing iteration of `Next` with workers
+}
+
+type DataLoaderOptions struct {
+	NumWorkers int
+	Prefetch   int
+	Collate    CollateFunc
+}
+
+type DataLoaderOption func(*DataLoaderOptions)
+
+func NewDataLoaderOptions(options ...DataLoaderOption) DataLoaderOptions {
+	opts := DataLoaderOptions{
+		NumWorkers: 0,
+		Prefetch:   2,
+		Collate:    nil,
+	}
+
+	for _, o := range options {
+		o(&opts)
+	}
+
+	return opts
+}
+
+// WithNumWorkers sets number of worker goroutines loading samples. Default 0
+// loads samples on the caller's goroutine.
+func WithNumWorkers(n int) DataLoaderOption {
+	return func(o *DataLoaderOptions) {
+		o.NumWorkers = n
+	}
 }
 
-func NewDataLoader(data Dataset, s Sampler) (*DataLoader, error) {
+// WithPrefetch sets number of batches loaded in advance by each worker
+// (default 2). As PyTorch `prefetch_factor`, at most about NumWorkers *
+// Prefetch batches are loaded but not yet consumed.
+func WithPrefetch(k int) DataLoaderOption {
+	return func(o *DataLoaderOptions) {
+		o.Prefetch = k
+	}
+}
+
+// WithCollate sets a function turning samples of each batch into batched
+// data, e.g. `DefaultCollate` or `NewPadCollate(0)`. It is also applied to
+// single samples if batch size is 1. Default nil returns a sample, or a slice
+// of samples for a batch.
+//
+// Collated data are owned by the caller. Tensors of samples are dropped after
+// collating only if the dataset is a FreshItemDataset (e.g. `TensorDataset`)
+// so collate should not return tensors of samples.
+func WithCollate(collate CollateFunc) DataLoaderOption {
+	return func(o *DataLoaderOptions) {
+		o.Collate = collate
+	}
+}
+
+// NewDataLoader creates a new DataLoader.
+//
+// s: Optional (can be nil). Default is SequentialSampler for slice dataset
+// and RandomSampler for map dataset.
+// opt: Optional. WithNumWorkers, WithPrefetch and WithCollate.
+func NewDataLoader(data Dataset, s Sampler, opt ...DataLoaderOption) (*DataLoader, error) {
 	dkind, err := checkDKind(data)
 	if err != nil {
 		return nil, err
 	}
 
+	opts := NewDataLoaderOptions(opt...)
+	if opts.NumWorkers < 0 || opts.Prefetch < 1 {
+		err := fmt.Errorf("Invalid DataLoader options: number of workers (%v) must be equal or greater than 0 and prefetch (%v) must be equal or greater than 1.\n", opts.NumWorkers, opts.Prefetch)
+		return nil, err
+	}
+
 	// Use default Sampler if no specified
 	if s == nil {
 		switch dkind {
@@ -36,10 +114,15 @@ func NewDataLoader(data Dataset, s Sampler) (*DataLoader, error) {
 	}
 
 	return &DataLoader{
-		dataset:   data,
-		indexes:   s.Sample(),
-		batchSize: s.BatchSize(),
-		currIdx:   0,
+		dataset:    data,
+		sampler:    s,
+		indexes:    s.Sample(),
+		batchSize:  s.BatchSize(),
+		currIdx:    0,
+		numWorkers: opts.NumWorkers,
+		prefetch:   opts.Prefetch,
+		collate:    opts.Collate,
+		fresh:      freshItems(data),
 	}, nil
 }
 
@@ -70,60 +153,312 @@ func (dl *DataLoader) Next() (interface{}, error) {
 		return nil, err
 	}
 
-	// Non-batching
-	if dl.batchSize == 1 {
-		item, err := dl.dataset.Item(dl.currIdx)
+	end := dl.currIdx + dl.batchSize
+	// NOTE. length of indexes can be shorter than dataset length
+	if end > len(dl.indexes) {
+		end = len(dl.indexes)
+	}
+
+	if dl.numWorkers == 0 {
+		items, err := dl.loadBatch(dl.indexes[dl.currIdx:end])
 		if err != nil {
 			return nil, err
 		}
 
-		dl.currIdx += 1
-		return item, nil
+		dl.currIdx = end
+		return items, nil
 	}
 
-	// Batch sampling
-	elem, err := dl.dataset.Item(0)
-	if err != nil {
-		return nil, err
+	if dl.iter == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		dl.iter = &loaderIter{
+			batches: dl.load(ctx, dl.currIdx),
+			cancel:  cancel,
+		}
 	}
 
-	elemType := reflect.TypeOf(elem)
-	// Free up memory if element is Tensor
-	switch elemType.String() {
-	case "[]tensor.Tensor":
-		for _, el := range elem.([]ts.Tensor) {
-			el.MustDrop()
-		}
-	case "*tensor.Tensor":
-		elem.(*ts.Tensor).MustDrop()
+	batch := <-dl.iter.batches
+	dl.currIdx = end
+	if batch.Err != nil {
+		// NOTE. iteration stops after an error. Next call restarts it from
+		// the next batch.
+		dl.stopIter()
+		return nil, batch.Err
 	}
 
-	items := reflect.MakeSlice(reflect.SliceOf(elemType), 0, dl.dataset.Len())
-	nextIndex := dl.currIdx + dl.batchSize
+	return batch.Data, nil
+}
 
-	// NOTE. length of indexes can be shorter than dataset length
-	if nextIndex >= len(dl.indexes) {
-		nextIndex = len(dl.indexes)
+// Batch is a sample, or a batch of samples if sampler batch size is greater
+// than 1, loaded by DataLoader. Err is set if loading failed.
+type Batch struct {
+	Data interface{}
+	Err  error
+}
+
+// Iter returns a channel delivering remaining samples (or batches) in sampler
+// order, loaded by worker goroutines (a single one if DataLoader has no
+// workers).
+//
+// The channel is closed after the last sample, after a Batch with error or
+// when ctx is done. Samples already loaded but not received are then
+// discarded (tensors are dropped if collated or loaded from a
+// FreshItemDataset). After
+// calling Iter, HasNext returns false until Reset is called.
+//
+// Example:
+//
+//	for batch := range dl.Iter(ctx) {
+//		if batch.Err != nil {
+//			log.Fatal(batch.Err)
+//		}
+//		// use batch.Data
+//	}
+func (dl *DataLoader) Iter(ctx context.Context) <-chan Batch {
+	dl.stopIter()
+	start := dl.currIdx
+	dl.currIdx = len(dl.indexes)
+
+	return dl.load(ctx, start)
+}
+
+// loaderIter is an iteration of worker goroutines.
+type loaderIter struct {
+	batches <-chan Batch
+	cancel  context.CancelFunc
+}
+
+// stopIter stops ongoing iteration of Next if any.
+func (dl *DataLoader) stopIter() {
+	if dl.iter == nil {
+		return
 	}
-	for i := dl.currIdx; i < nextIndex; i++ {
-		item, err := dl.dataset.Item(i)
+
+	dl.iter.cancel()
+	for batch := range dl.iter.batches {
+		dl.discard(batch.Data)
+	}
+	dl.iter = nil
+}
+
+// load loads batches from indexes[start:] with worker goroutines and sends
+// them in order to the returned channel.
+func (dl *DataLoader) load(ctx context.Context, start int) <-chan Batch {
+	ctx, cancel := context.WithCancel(ctx)
+
+	numWorkers := dl.numWorkers
+	if numWorkers == 0 {
+		numWorkers = 1
+	}
+
+	type job struct {
+		indexes []int
+		result  chan Batch
+	}
+	jobs := make(chan job)
+	// results of jobs taken by workers, in order.
+	pending := make(chan chan Batch, numWorkers*dl.prefetch)
+	out := make(chan Batch)
+
+	for w := 0; w < numWorkers; w++ {
+		go func() {
+			for j := range jobs {
+				data, err := dl.loadBatch(j.indexes)
+				j.result <- Batch{Data: data, Err: err}
+			}
+		}()
+	}
+
+	// Dispatcher
+	go func() {
+		defer close(pending)
+		defer close(jobs)
+
+		for i := start; i < len(dl.indexes); i += dl.batchSize {
+			end := i + dl.batchSize
+			if end > len(dl.indexes) {
+				end = len(dl.indexes)
+			}
+
+			j := job{dl.indexes[i:end], make(chan Batch, 1)}
+			select {
+			case jobs <- j:
+			case <-ctx.Done():
+				return
+			}
+			// NOTE. collector always receives from pending until it is
+			// closed so that results of all taken jobs can be dropped.
+			pending <- j.result
+		}
+	}()
+
+	// Collector
+	go func() {
+		defer close(out)
+		defer func() {
+			cancel()
+			for result := range pending {
+				batch := <-result
+				dl.discard(batch.Data)
+			}
+		}()
+
+		for result := range pending {
+			var batch Batch
+			select {
+			case batch = <-result:
+			case <-ctx.Done():
+				batch = <-result
+				dl.discard(batch.Data)
+				return
+			}
+
+			select {
+			case out <- batch:
+			case <-ctx.Done():
+				dl.discard(batch.Data)
+				return
+			}
+
+			if batch.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// loadBatch loads samples at indexes and collates them if a CollateFunc is
+// set. Otherwise, it returns the sample if batch size is 1 or a slice of
+// samples.
+func (dl *DataLoader) loadBatch(indexes []int) (interface{}, error) {
+	if dl.collate != nil {
+		samples := make([]interface{}, 0, len(indexes))
+		defer func() {
+			dl.dropSamples(samples)
+		}()
+		for _, idx := range indexes {
+			item, err := dl.dataset.Item(idx)
+			if err != nil {
+				return nil, err
+			}
+			samples = append(samples, item)
+		}
+
+		return dl.collate(samples)
+	}
+
+	if dl.batchSize == 1 {
+		return dl.dataset.Item(indexes[0])
+	}
+
+	var items reflect.Value
+	for i, idx := range indexes {
+		item, err := dl.dataset.Item(idx)
 		if err != nil {
+			if i > 0 {
+				dl.dropSamples(items.Interface())
+			}
 			return nil, err
 		}
+		if i == 0 {
+			items = reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(item)), 0, len(indexes))
+		}
 		items = reflect.Append(items, reflect.ValueOf(item))
 	}
 
-	dl.currIdx = nextIndex
 	return items.Interface(), nil
 }
 
+// discard discards loaded data which is not consumed. Collated data are
+// dropped, samples only if they are new tensors of a FreshItemDataset.
+func (dl *DataLoader) discard(data interface{}) {
+	if dl.collate != nil {
+		dropItem(data)
+		return
+	}
+	dl.dropSamples(data)
+}
+
+// dropSamples drops samples loaded by the DataLoader if they are new tensors
+// of a FreshItemDataset. Otherwise, they can be held by the dataset.
+func (dl *DataLoader) dropSamples(samples interface{}) {
+	if dl.fresh {
+		dropItem(samples)
+	}
+}
+
+// dropItem frees up memory if item holds tensors: *ts.Tensor, ts.Tensor and
+// *PaddedBatch directly or in (nested) slices, arrays, maps, structs and
+// interfaces. Other pointers are not followed.
+func dropItem(item interface{}) {
+	dropValue(reflect.ValueOf(item))
+}
+
+func dropValue(v reflect.Value) {
+	if !v.IsValid() {
+		return
+	}
+
+	switch v.Type() {
+	case tensorPtrType:
+		if !v.IsNil() {
+			v.Interface().(*ts.Tensor).MustDrop()
+		}
+		return
+	case tensorType:
+		x := v.Interface().(ts.Tensor)
+		x.MustDrop()
+		return
+	case paddedBatchPtrType:
+		if !v.IsNil() {
+			v.Interface().(*PaddedBatch).Drop()
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		dropValue(v.Elem())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			dropValue(v.Index(i))
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			dropValue(iter.Value())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				dropValue(v.Field(i))
+			}
+		}
+	}
+}
+
 // HasNext returns whether there is a next item in the iteration.
 func (dl *DataLoader) HasNext() bool {
 	return dl.currIdx < len(dl.indexes)
 }
 
-// Reset reset index to start position.
+// Close stops worker goroutines of an ongoing iteration of `Next` and
+// discards batches they loaded but not yet returned. It should be called
+// (e.g. deferred) when a DataLoader with workers is no longer used before
+// the end of an iteration. A later call to Next restarts workers.
+func (dl *DataLoader) Close() {
+	dl.stopIter()
+}
+
+// Reset reset index to start position and draws new sample indices from the
+// sampler, e.g. a new shuffle of RandomSampler or shards of ShardedSampler
+// for the epoch set by `SetEpoch`. It also stops worker goroutines of an
+// ongoing iteration of `Next` and discards samples they loaded.
 func (dl *DataLoader) Reset() {
+	dl.stopIter()
+	dl.indexes = dl.sampler.Sample()
 	dl.currIdx = 0
 }
 
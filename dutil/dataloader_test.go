@@ -1,9 +1,11 @@
 package dutil_test
 
 import (
-	// "reflect"
+	"context"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/sugarme/gotch/dutil"
 )
@@ -42,3 +44,122 @@ func TestDataLoader_Next(t *testing.T) {
 		t.Errorf("Got: %v\n", got)
 	}
 }
+
+// slowDataset returns idx * 10 after a delay varying with idx so that workers
+// finish out of order. It fails at failIdx if not negative.
+type slowDataset struct {
+	n       int
+	failIdx int
+}
+
+func (ds *slowDataset) Item(idx int) (interface{}, error) {
+	time.Sleep(time.Duration(idx%3) * time.Millisecond)
+	if idx == ds.failIdx {
+		return nil, fmt.Errorf("item %v failed", idx)
+	}
+	return idx * 10, nil
+}
+
+func (ds *slowDataset) DType() reflect.Type {
+	return reflect.TypeOf([]int{})
+}
+
+func (ds *slowDataset) Len() int {
+	return ds.n
+}
+
+func TestDataLoader_NumWorkers(t *testing.T) {
+	s, err := dutil.NewBatchSampler(20, 3, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dl, err := dutil.NewDataLoader(&slowDataset{20, -1}, s, dutil.WithNumWorkers(4), dutil.WithPrefetch(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []int
+	for dl.HasNext() {
+		batch, err := dl.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, batch.([]int)...)
+	}
+	var want []int
+	for i := 0; i < 20; i++ {
+		want = append(want, i*10)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	// Channel iteration gives the same order.
+	dl.Reset()
+	got = nil
+	for batch := range dl.Iter(context.Background()) {
+		if batch.Err != nil {
+			t.Fatal(batch.Err)
+		}
+		got = append(got, batch.Data.([]int)...)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+	if dl.HasNext() {
+		t.Errorf("Want no next item after Iter\n")
+	}
+
+	// Close stops workers in the middle of an iteration.
+	dl.Reset()
+	if _, err := dl.Next(); err != nil {
+		t.Fatal(err)
+	}
+	dl.Close()
+	batch, err := dl.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]int{30, 40, 50}, batch) {
+		t.Errorf("Want: %v\n", []int{30, 40, 50})
+		t.Errorf("Got: %v\n", batch)
+	}
+	dl.Close()
+}
+
+func TestDataLoader_IterCancel(t *testing.T) {
+	dl, err := dutil.NewDataLoader(&slowDataset{100, 5}, nil, dutil.WithNumWorkers(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Iteration stops after an error.
+	var (
+		n       int
+		lastErr error
+	)
+	for batch := range dl.Iter(context.Background()) {
+		n++
+		lastErr = batch.Err
+	}
+	if n != 6 || lastErr == nil {
+		t.Errorf("Want 6 samples ending with error, got %v samples and error %v\n", n, lastErr)
+	}
+
+	// Iteration stops when context is canceled.
+	dl.Reset()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	n = 0
+	for range dl.Iter(ctx) {
+		n++
+		if n == 2 {
+			cancel()
+		}
+	}
+	if n == 100 {
+		t.Errorf("Want iteration stopped after cancel, got all %v samples\n", n)
+	}
+}
@@ -2,7 +2,12 @@ package dutil
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"reflect"
+	"sort"
+
+	ts "github.com/sugarme/gotch/tensor"
 )
 
 // Dataset represents a set of samples and
@@ -14,6 +19,23 @@ type Dataset interface {
 	Len() int
 }
 
+// FreshItemDataset is a Dataset whose `Item` returns new tensors owned by
+// the caller (e.g. `TensorDataset`) rather than tensors held by the dataset.
+//
+// DataLoader only drops tensors of samples it loaded from such datasets
+// (after collating them or when discarding samples not returned). Samples of
+// other datasets are never dropped by DataLoader.
+type FreshItemDataset interface {
+	Dataset
+	FreshItems() bool
+}
+
+// freshItems returns whether Item of ds returns new tensors.
+func freshItems(ds Dataset) bool {
+	fd, ok := ds.(FreshItemDataset)
+	return ok && fd.FreshItems()
+}
+
 type DatasetKind int
 
 const (
@@ -114,5 +136,277 @@ func (ds *MapDataset) DType() reflect.Type {
 	return reflect.TypeOf(ds.data)
 }
 
-// NOTE. To make this package agnostic, we don't add TensorDataset here.
-// A end-user can create a custom dataset by implementing `Item()` method.
+// TensorDataset holds samples along dimension 0 of tensors, e.g. images and
+// labels.
+//
+// It does not take ownership of the tensors. Item returns views of the tensors
+// that share their data and should be dropped by the caller.
+type TensorDataset struct {
+	tensors []*ts.Tensor
+	n       int
+}
+
+// NewTensorDataset creates a new TensorDataset. All tensors must have the same
+// size at dimension 0.
+func NewTensorDataset(tensors ...*ts.Tensor) (*TensorDataset, error) {
+	if len(tensors) == 0 {
+		err := fmt.Errorf("NewTensorDataset: expected at least one tensor.\n")
+		return nil, err
+	}
+
+	var n int64
+	for i, x := range tensors {
+		shape, err := x.Size()
+		if err != nil {
+			return nil, err
+		}
+		if len(shape) == 0 {
+			err := fmt.Errorf("NewTensorDataset: tensor %v is a scalar tensor.\n", i)
+			return nil, err
+		}
+		if i == 0 {
+			n = shape[0]
+		}
+		if shape[0] != n {
+			err := fmt.Errorf("NewTensorDataset: size mismatched at dimension 0 (%v and %v) of tensor %v.\n", n, shape[0], i)
+			return nil, err
+		}
+	}
+
+	return &TensorDataset{
+		tensors: tensors,
+		n:       int(n),
+	}, nil
+}
+
+// Item implements Dataset interface. It returns []*ts.Tensor of the sample at
+// `idx` of each tensor.
+func (ds *TensorDataset) Item(idx int) (interface{}, error) {
+	if idx < 0 || idx >= ds.n {
+		err := fmt.Errorf("Idx is out of range.")
+		return nil, err
+	}
+
+	item := make([]*ts.Tensor, len(ds.tensors))
+	for i, x := range ds.tensors {
+		xi, err := x.Select(0, int64(idx), false)
+		if err != nil {
+			for _, prev := range item[:i] {
+				prev.MustDrop()
+			}
+			return nil, err
+		}
+		item[i] = xi
+	}
+
+	return item, nil
+}
+
+func (ds *TensorDataset) Len() int {
+	return ds.n
+}
+
+func (ds *TensorDataset) DType() reflect.Type {
+	return reflect.TypeOf(ds.tensors)
+}
+
+// FreshItems implements FreshItemDataset interface. Items are new tensors.
+func (ds *TensorDataset) FreshItems() bool {
+	return true
+}
+
+// Tensors returns the tensors of the dataset.
+func (ds *TensorDataset) Tensors() []*ts.Tensor {
+	return ds.tensors
+}
+
+// ConcatDataset concatenates datasets.
+type ConcatDataset struct {
+	datasets []Dataset
+	ends     []int // cumulative lengths of datasets
+}
+
+// NewConcatDataset creates a new ConcatDataset.
+func NewConcatDataset(datasets ...Dataset) (*ConcatDataset, error) {
+	if len(datasets) == 0 {
+		err := fmt.Errorf("NewConcatDataset: expected at least one dataset.\n")
+		return nil, err
+	}
+
+	ends := make([]int, len(datasets))
+	n := 0
+	for i, ds := range datasets {
+		n += ds.Len()
+		ends[i] = n
+	}
+
+	return &ConcatDataset{
+		datasets: datasets,
+		ends:     ends,
+	}, nil
+}
+
+// Item implements Dataset interface.
+func (ds *ConcatDataset) Item(idx int) (interface{}, error) {
+	if idx < 0 || idx >= ds.Len() {
+		err := fmt.Errorf("Idx is out of range.")
+		return nil, err
+	}
+
+	i := sort.SearchInts(ds.ends, idx+1)
+	start := 0
+	if i > 0 {
+		start = ds.ends[i-1]
+	}
+
+	return ds.datasets[i].Item(idx - start)
+}
+
+func (ds *ConcatDataset) Len() int {
+	return ds.ends[len(ds.ends)-1]
+}
+
+// DType returns DType of the datasets if they are all the same. Otherwise, it
+// returns type of []interface{}.
+func (ds *ConcatDataset) DType() reflect.Type {
+	dtype := ds.datasets[0].DType()
+	for _, d := range ds.datasets[1:] {
+		if d.DType() != dtype {
+			return reflect.TypeOf([]interface{}{})
+		}
+	}
+
+	return dtype
+}
+
+// FreshItems implements FreshItemDataset interface. Items are new tensors if
+// items of all datasets are.
+func (ds *ConcatDataset) FreshItems() bool {
+	for _, d := range ds.datasets {
+		if !freshItems(d) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Subset is a subset of a dataset at given indices. It does not copy samples
+// so that folds of KFold can be used without copying data, e.g.:
+//
+//	ds, err := dutil.NewTensorDataset(data.TrainImages, data.TrainLabels)
+//	kf, err := dutil.NewKFold(ds.Len())
+//	for _, fold := range kf.Split() {
+//		trainSet, err := dutil.NewSubset(ds, fold.Train)
+//		testSet, err := dutil.NewSubset(ds, fold.Test)
+//		...
+//	}
+type Subset struct {
+	dataset Dataset
+	indices []int
+}
+
+// NewSubset creates a new Subset of dataset at indices.
+func NewSubset(dataset Dataset, indices []int) (*Subset, error) {
+	n := dataset.Len()
+	for _, idx := range indices {
+		if idx < 0 || idx >= n {
+			err := fmt.Errorf("NewSubset: index %v is out of range [0, %v).\n", idx, n)
+			return nil, err
+		}
+	}
+
+	return &Subset{
+		dataset: dataset,
+		indices: indices,
+	}, nil
+}
+
+// Item implements Dataset interface.
+func (ds *Subset) Item(idx int) (interface{}, error) {
+	if idx < 0 || idx >= len(ds.indices) {
+		err := fmt.Errorf("Idx is out of range.")
+		return nil, err
+	}
+
+	return ds.dataset.Item(ds.indices[idx])
+}
+
+func (ds *Subset) Len() int {
+	return len(ds.indices)
+}
+
+func (ds *Subset) DType() reflect.Type {
+	return ds.dataset.DType()
+}
+
+// FreshItems implements FreshItemDataset interface. Items are new tensors if
+// items of the underlying dataset are.
+func (ds *Subset) FreshItems() bool {
+	return freshItems(ds.dataset)
+}
+
+// Indices returns indices of the subset in the underlying dataset.
+func (ds *Subset) Indices() []int {
+	return ds.indices
+}
+
+// RandomSplit randomly splits dataset into non-overlapping subsets with
+// lengths proportional to fractions which must sum up to 1. Remainder samples
+// are distributed one by one to the subsets with the largest fractional parts
+// of their lengths, never to subsets of zero fraction. Results are the same
+// for the same seed.
+func RandomSplit(dataset Dataset, fractions []float64, seed int64) ([]*Subset, error) {
+	if len(fractions) == 0 {
+		err := fmt.Errorf("RandomSplit: expected at least one fraction.\n")
+		return nil, err
+	}
+
+	var sum float64
+	for _, f := range fractions {
+		if f < 0 {
+			err := fmt.Errorf("RandomSplit: fraction must be non-negative. Got: %v\n", f)
+			return nil, err
+		}
+		sum += f
+	}
+	if math.Abs(sum-1) > 1e-6 {
+		err := fmt.Errorf("RandomSplit: fractions must sum up to 1. Got: %v\n", sum)
+		return nil, err
+	}
+
+	n := dataset.Len()
+	lengths := make([]int, len(fractions))
+	rems := make([]float64, len(fractions))
+	var order []int // subsets of non-zero fractions
+	total := 0
+	for i, f := range fractions {
+		exact := f * float64(n)
+		lengths[i] = int(math.Floor(exact))
+		rems[i] = exact - float64(lengths[i])
+		total += lengths[i]
+		if f > 0 {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return rems[order[i]] > rems[order[j]]
+	})
+	for k := 0; total < n; k++ {
+		lengths[order[k%len(order)]]++
+		total++
+	}
+
+	indices := rand.New(rand.NewSource(seed)).Perm(n)
+	subsets := make([]*Subset, len(lengths))
+	start := 0
+	for i, l := range lengths {
+		subsets[i] = &Subset{
+			dataset: dataset,
+			indices: indices[start : start+l],
+		}
+		start += l
+	}
+
+	return subsets, nil
+}
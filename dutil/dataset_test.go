@@ -4,7 +4,9 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/sugarme/gotch"
 	"github.com/sugarme/gotch/dutil"
+	ts "github.com/sugarme/gotch/tensor"
 )
 
 func TestNewSliceDataset(t *testing.T) {
@@ -118,3 +120,173 @@ func TestMapDataset_Item(t *testing.T) {
 		t.Errorf("Got: %v\n", got)
 	}
 }
+
+func TestTensorDataset(t *testing.T) {
+	images := ts.MustArange(ts.IntScalar(12), gotch.Float, gotch.CPU).MustView([]int64{4, 3}, true)
+	labels := ts.MustOfSlice([]int64{0, 1, 0, 1})
+	defer images.MustDrop()
+	defer labels.MustDrop()
+
+	ds, err := dutil.NewTensorDataset(images, labels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ds.Len() != 4 {
+		t.Errorf("Want data length: %v\n", 4)
+		t.Errorf("Got data length: %v\n", ds.Len())
+	}
+
+	item, err := ds.Item(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sample := item.([]*ts.Tensor)
+	wantImage := []float32{6, 7, 8}
+	if !reflect.DeepEqual(wantImage, sample[0].Vals()) {
+		t.Errorf("Want: %v\n", wantImage)
+		t.Errorf("Got: %v\n", sample[0].Vals())
+	}
+	if got := sample[1].Int64Values(); !reflect.DeepEqual([]int64{0}, got) {
+		t.Errorf("Want: %v\n", []int64{0})
+		t.Errorf("Got: %v\n", got)
+	}
+	sample[0].MustDrop()
+	sample[1].MustDrop()
+
+	invalid := ts.MustOfSlice([]int64{0, 1, 2})
+	defer invalid.MustDrop()
+	if _, err := dutil.NewTensorDataset(images, invalid); err == nil {
+		t.Errorf("Want error for mismatched size at dimension 0\n")
+	}
+}
+
+func TestConcatDataset(t *testing.T) {
+	ds1, err := dutil.NewSliceDataset([]int{0, 1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds2, err := dutil.NewSliceDataset([]int{3, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := dutil.NewConcatDataset(ds1, ds2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []interface{}{0, 1, 2, 3, 4}
+	var got []interface{}
+	for i := 0; i < ds.Len(); i++ {
+		item, err := ds.Item(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+	if _, err := ds.Item(5); err == nil {
+		t.Errorf("Want out of range error\n")
+	}
+}
+
+func TestSubset_KFold(t *testing.T) {
+	ds, err := dutil.NewSliceDataset([]int{10, 11, 12, 13, 14, 15})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kf, err := dutil.NewKFold(ds.Len(), dutil.WithNFolds(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fold := kf.Split()[1]
+	testSet, err := dutil.NewSubset(ds, fold.Test)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []interface{}{12, 13}
+	var got []interface{}
+	for i := 0; i < testSet.Len(); i++ {
+		item, err := testSet.Item(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	if _, err := dutil.NewSubset(ds, []int{6}); err == nil {
+		t.Errorf("Want out of range error\n")
+	}
+}
+
+func TestRandomSplit(t *testing.T) {
+	ds, err := dutil.NewSliceDataset(make([]int, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subsets, err := dutil.RandomSplit(ds, []float64{0.5, 0.25, 0.25}, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLens := []int{5, 3, 2}
+	seen := make(map[int]bool)
+	for i, s := range subsets {
+		if s.Len() != wantLens[i] {
+			t.Errorf("Want length of subset %v: %v\n", i, wantLens[i])
+			t.Errorf("Got length of subset %v: %v\n", i, s.Len())
+		}
+		for _, idx := range s.Indices() {
+			seen[idx] = true
+		}
+	}
+	if len(seen) != ds.Len() {
+		t.Errorf("Want subsets covering %v samples, got %v\n", ds.Len(), len(seen))
+	}
+
+	again, err := dutil.RandomSplit(ds, []float64{0.5, 0.25, 0.25}, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range subsets {
+		if !reflect.DeepEqual(subsets[i].Indices(), again[i].Indices()) {
+			t.Errorf("Want: %v\n", subsets[i].Indices())
+			t.Errorf("Got: %v\n", again[i].Indices())
+		}
+	}
+
+	// Remainder goes to the largest fractional part, never to zero fraction.
+	subsets, err = dutil.RandomSplit(ds, []float64{0.26, 0.74, 0}, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLens = []int{3, 7, 0}
+	for i, s := range subsets {
+		if s.Len() != wantLens[i] {
+			t.Errorf("Want length of subset %v: %v\n", i, wantLens[i])
+			t.Errorf("Got length of subset %v: %v\n", i, s.Len())
+		}
+	}
+	odd, err := dutil.NewSliceDataset(make([]int, 11))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subsets, err = dutil.RandomSplit(odd, []float64{0.5, 0.5, 0}, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subsets[2].Len() != 0 || subsets[0].Len()+subsets[1].Len() != 11 {
+		t.Errorf("Want 11 samples split between non-zero fractions, got lengths %v, %v, %v\n", subsets[0].Len(), subsets[1].Len(), subsets[2].Len())
+	}
+
+	if _, err := dutil.RandomSplit(ds, []float64{0.5, 0.4}, 42); err == nil {
+		t.Errorf("Want error for fractions not summing up to 1\n")
+	}
+}
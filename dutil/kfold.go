@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
+
+	"github.com/sugarme/gotch"
 )
 
 // KFold represents a struct helper to
@@ -12,7 +14,7 @@ type KFold struct {
 	n       int
 	nfolds  int
 	shuffle bool
-	//seed int
+	seed    int64
 }
 
 // Fold represents a partitions with
@@ -23,16 +25,20 @@ type Fold struct {
 }
 
 type KFoldOptions struct {
-	NFolds  int  // number of folds
-	Shuffle bool // whether suffling before splitting
+	NFolds   int   // number of folds
+	Shuffle  bool  // whether suffling before splitting
+	Seed     int64 // seed of shuffling. If 0, `gotch.Rand()` is used.
+	NRepeats int   // number of repeats of RepeatedKFold
 }
 
 type KFoldOption func(*KFoldOptions)
 
 func NewKFoldOptions(options ...KFoldOption) KFoldOptions {
 	opts := KFoldOptions{
-		NFolds:  5,
-		Shuffle: false,
+		NFolds:   5,
+		Shuffle:  false,
+		Seed:     0,
+		NRepeats: 10,
 	}
 
 	for _, o := range options {
@@ -54,6 +60,20 @@ func WithKFoldShuffle(shuffle bool) KFoldOption {
 	}
 }
 
+// WithKFoldSeed sets seed of shuffling so that splits are reproducible.
+func WithKFoldSeed(seed int64) KFoldOption {
+	return func(o *KFoldOptions) {
+		o.Seed = seed
+	}
+}
+
+// WithNRepeats sets number of repeats of RepeatedKFold.
+func WithNRepeats(nrepeats int) KFoldOption {
+	return func(o *KFoldOptions) {
+		o.NRepeats = nrepeats
+	}
+}
+
 // NewKFold creates a new KFold struct.
 func NewKFold(n int, opt ...KFoldOption) (*KFold, error) {
 	opts := NewKFoldOptions(opt...)
@@ -72,16 +92,22 @@ func NewKFold(n int, opt ...KFoldOption) (*KFold, error) {
 		n:       n,
 		nfolds:  opts.NFolds,
 		shuffle: opts.Shuffle,
+		seed:    opts.Seed,
 	}, nil
 }
 
+// Split splits data into folds.
 func (kf *KFold) Split() []Fold {
+	return kf.split(kfoldRand(kf.seed))
+}
+
+func (kf *KFold) split(r *rand.Rand) []Fold {
 	odd := kf.n % kf.nfolds
 	nsamples := kf.n - odd
 	fsize := nsamples / kf.nfolds
 	var indices []int
 
-	allIndices := rand.Perm(kf.n)
+	allIndices := r.Perm(kf.n)
 	// Drop last odd-time elements
 	indices = allIndices[:nsamples]
 
@@ -123,6 +149,352 @@ func (kf *KFold) Split() []Fold {
 	return splits
 }
 
+// kfoldRand returns a random number generator seeded with seed or
+// `gotch.Rand()` if seed is 0.
+func kfoldRand(seed int64) *rand.Rand {
+	if seed == 0 {
+		return gotch.Rand()
+	}
+	return rand.New(rand.NewSource(seed))
+}
+
+// assignedFolds creates folds from fold assignment of samples. Indices of
+// train and test sets are in ascending order.
+func assignedFolds(assign []int, nfolds int) []Fold {
+	splits := make([]Fold, nfolds)
+	for i, f := range assign {
+		for k := range splits {
+			if k == f {
+				splits[k].Test = append(splits[k].Test, i)
+			} else {
+				splits[k].Train = append(splits[k].Train, i)
+			}
+		}
+	}
+
+	return splits
+}
+
+// RepeatedKFold repeats shuffled KFold with different randomization in each
+// repetition.
+type RepeatedKFold struct {
+	kf       *KFold
+	nrepeats int
+}
+
+// NewRepeatedKFold creates a new RepeatedKFold. Splits are always shuffled.
+//
+// n: number of samples
+// nfolds: Optional (default=5). Number of folds.
+// nrepeats: Optional (default=10). Number of repeats.
+// seed: Optional (default=0). Seed of shuffling.
+func NewRepeatedKFold(n int, opt ...KFoldOption) (*RepeatedKFold, error) {
+	opts := NewKFoldOptions(opt...)
+	if opts.NRepeats < 1 {
+		err := fmt.Errorf("nrepeats must be at least 1. Got: %v\n", opts.NRepeats)
+		return nil, err
+	}
+
+	kfOpt := append([]KFoldOption{}, opt...)
+	kf, err := NewKFold(n, append(kfOpt, WithKFoldShuffle(true))...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RepeatedKFold{
+		kf:       kf,
+		nrepeats: opts.NRepeats,
+	}, nil
+}
+
+// Split returns nfolds * nrepeats folds, folds of the first repetition first.
+func (rkf *RepeatedKFold) Split() []Fold {
+	r := kfoldRand(rkf.kf.seed)
+	var splits []Fold
+	for i := 0; i < rkf.nrepeats; i++ {
+		splits = append(splits, rkf.kf.split(r)...)
+	}
+
+	return splits
+}
+
+// StratifiedKFold splits data into folds preserving percentage of samples of
+// each class, i.e. sizes of a class in folds differ by at most 1.
+type StratifiedKFold struct {
+	labels  []int
+	nfolds  int
+	shuffle bool
+	seed    int64
+}
+
+// NewStratifiedKFold creates a new StratifiedKFold.
+//
+// labels: class labels of samples
+// nfolds: Optional (default=5). Number of folds.
+// shuffle: Optional (default=false). Whether shuffling samples of each class before splitting.
+// seed: Optional (default=0). Seed of shuffling.
+func NewStratifiedKFold(labels []int, opt ...KFoldOption) (*StratifiedKFold, error) {
+	opts := NewKFoldOptions(opt...)
+
+	if opts.NFolds < 2 {
+		err := fmt.Errorf("nfolds must be at least 2. Got: %v\n", opts.NFolds)
+		return nil, err
+	}
+
+	if opts.NFolds > len(labels) {
+		err := fmt.Errorf("nfolds cannot be greater than number of samples (%v). Got: %v\n", len(labels), opts.NFolds)
+		return nil, err
+	}
+
+	return &StratifiedKFold{
+		labels:  labels,
+		nfolds:  opts.NFolds,
+		shuffle: opts.Shuffle,
+		seed:    opts.Seed,
+	}, nil
+}
+
+// Split splits data into folds. Samples of each class are dealt to folds in
+// turn, continuing from the fold after the last sample of previous class so
+// that fold sizes also differ by at most 1.
+func (skf *StratifiedKFold) Split() []Fold {
+	classes := make(map[int][]int)
+	for i, l := range skf.labels {
+		classes[l] = append(classes[l], i)
+	}
+	var keys []int
+	for l := range classes {
+		keys = append(keys, l)
+	}
+	sort.Ints(keys)
+
+	r := kfoldRand(skf.seed)
+	assign := make([]int, len(skf.labels))
+	f := 0
+	for _, l := range keys {
+		indices := classes[l]
+		if skf.shuffle {
+			r.Shuffle(len(indices), func(i, j int) {
+				indices[i], indices[j] = indices[j], indices[i]
+			})
+		}
+		for _, idx := range indices {
+			assign[idx] = f
+			f = (f + 1) % skf.nfolds
+		}
+	}
+
+	return assignedFolds(assign, skf.nfolds)
+}
+
+// GroupKFold splits data into folds so that samples of the same group (e.g.
+// the same patient) are never in both train and test sets.
+type GroupKFold struct {
+	groups  []int
+	ngroups int
+	nfolds  int
+	shuffle bool
+	seed    int64
+}
+
+// NewGroupKFold creates a new GroupKFold.
+//
+// groups: group IDs of samples
+// nfolds: Optional (default=5). Number of folds.
+// shuffle: Optional (default=false). Whether shuffling groups of the same size before splitting.
+// seed: Optional (default=0). Seed of shuffling.
+func NewGroupKFold(groups []int, opt ...KFoldOption) (*GroupKFold, error) {
+	opts := NewKFoldOptions(opt...)
+
+	if opts.NFolds < 2 {
+		err := fmt.Errorf("nfolds must be at least 2. Got: %v\n", opts.NFolds)
+		return nil, err
+	}
+
+	ids := make(map[int]bool)
+	for _, g := range groups {
+		ids[g] = true
+	}
+	if opts.NFolds > len(ids) {
+		err := fmt.Errorf("nfolds cannot be greater than number of groups (%v). Got: %v\n", len(ids), opts.NFolds)
+		return nil, err
+	}
+
+	return &GroupKFold{
+		groups:  groups,
+		ngroups: len(ids),
+		nfolds:  opts.NFolds,
+		shuffle: opts.Shuffle,
+		seed:    opts.Seed,
+	}, nil
+}
+
+// Split splits data into folds. Groups are assigned from the largest to the
+// fold with the least samples so that folds have approximately the same size.
+func (gkf *GroupKFold) Split() []Fold {
+	sizes := make(map[int]int, gkf.ngroups)
+	for _, g := range gkf.groups {
+		sizes[g]++
+	}
+	ids := make([]int, 0, len(sizes))
+	for g := range sizes {
+		ids = append(ids, g)
+	}
+	sort.Ints(ids)
+
+	if gkf.shuffle {
+		r := kfoldRand(gkf.seed)
+		r.Shuffle(len(ids), func(i, j int) {
+			ids[i], ids[j] = ids[j], ids[i]
+		})
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		return sizes[ids[i]] > sizes[ids[j]]
+	})
+
+	groupFold := make(map[int]int, len(ids))
+	foldSizes := make([]int, gkf.nfolds)
+	for _, g := range ids {
+		f := 0
+		for k, size := range foldSizes {
+			if size < foldSizes[f] {
+				f = k
+			}
+		}
+		groupFold[g] = f
+		foldSizes[f] += sizes[g]
+	}
+
+	assign := make([]int, len(gkf.groups))
+	for i, g := range gkf.groups {
+		assign[i] = groupFold[g]
+	}
+
+	return assignedFolds(assign, gkf.nfolds)
+}
+
+// TimeSeriesSplit splits time-ordered data so that test set of each fold is
+// after its train set. Train set of a fold includes train and test sets of
+// previous folds (limited by MaxTrainSize), i.e. folds are not partitions.
+type TimeSeriesSplit struct {
+	n            int
+	nsplits      int
+	maxTrainSize int
+	testSize     int
+	gap          int
+}
+
+type TimeSeriesSplitOptions struct {
+	NSplits      int // number of splits
+	MaxTrainSize int // maximum size of train set. If 0, no limit.
+	TestSize     int // size of test set. If 0, n / (NSplits + 1).
+	Gap          int // number of samples excluded between train and test sets
+}
+
+type TimeSeriesSplitOption func(*TimeSeriesSplitOptions)
+
+func NewTimeSeriesSplitOptions(options ...TimeSeriesSplitOption) TimeSeriesSplitOptions {
+	opts := TimeSeriesSplitOptions{
+		NSplits:      5,
+		MaxTrainSize: 0,
+		TestSize:     0,
+		Gap:          0,
+	}
+
+	for _, o := range options {
+		o(&opts)
+	}
+
+	return opts
+}
+
+func WithNSplits(nsplits int) TimeSeriesSplitOption {
+	return func(o *TimeSeriesSplitOptions) {
+		o.NSplits = nsplits
+	}
+}
+
+func WithMaxTrainSize(size int) TimeSeriesSplitOption {
+	return func(o *TimeSeriesSplitOptions) {
+		o.MaxTrainSize = size
+	}
+}
+
+func WithTestSize(size int) TimeSeriesSplitOption {
+	return func(o *TimeSeriesSplitOptions) {
+		o.TestSize = size
+	}
+}
+
+func WithGap(gap int) TimeSeriesSplitOption {
+	return func(o *TimeSeriesSplitOptions) {
+		o.Gap = gap
+	}
+}
+
+// NewTimeSeriesSplit creates a new TimeSeriesSplit.
+//
+// n: number of samples in time order
+// nsplits: Optional (default=5). Number of splits.
+// maxTrainSize: Optional (default=0, no limit). Maximum size of train set.
+// testSize: Optional (default=n / (nsplits + 1)). Size of test set.
+// gap: Optional (default=0). Number of samples excluded before test set.
+func NewTimeSeriesSplit(n int, opt ...TimeSeriesSplitOption) (*TimeSeriesSplit, error) {
+	opts := NewTimeSeriesSplitOptions(opt...)
+
+	if opts.NSplits < 2 {
+		err := fmt.Errorf("nsplits must be at least 2. Got: %v\n", opts.NSplits)
+		return nil, err
+	}
+
+	if opts.NSplits+1 > n {
+		err := fmt.Errorf("nsplits cannot be greater than number of samples (%v) - 1. Got: %v\n", n, opts.NSplits)
+		return nil, err
+	}
+
+	if opts.MaxTrainSize < 0 || opts.TestSize < 0 || opts.Gap < 0 {
+		err := fmt.Errorf("maxTrainSize, testSize and gap must be non-negative. Got: %v, %v, %v\n", opts.MaxTrainSize, opts.TestSize, opts.Gap)
+		return nil, err
+	}
+
+	testSize := opts.TestSize
+	if testSize == 0 {
+		testSize = n / (opts.NSplits + 1)
+	}
+	if n-opts.Gap-testSize*opts.NSplits <= 0 {
+		err := fmt.Errorf("too many splits (%v) of test size %v and gap %v for number of samples (%v).\n", opts.NSplits, testSize, opts.Gap, n)
+		return nil, err
+	}
+
+	return &TimeSeriesSplit{
+		n:            n,
+		nsplits:      opts.NSplits,
+		maxTrainSize: opts.MaxTrainSize,
+		testSize:     testSize,
+		gap:          opts.Gap,
+	}, nil
+}
+
+// Split splits data into folds. Test sets are the last nsplits * testSize
+// samples.
+func (tss *TimeSeriesSplit) Split() []Fold {
+	var splits []Fold
+	for testStart := tss.n - tss.nsplits*tss.testSize; testStart < tss.n; testStart += tss.testSize {
+		trainEnd := testStart - tss.gap
+		trainStart := 0
+		if tss.maxTrainSize > 0 && trainEnd > tss.maxTrainSize {
+			trainStart = trainEnd - tss.maxTrainSize
+		}
+
+		splits = append(splits, Fold{
+			Train: intRange(trainEnd)[trainStart:],
+			Test:  intRange(testStart + tss.testSize)[testStart:],
+		})
+	}
+
+	return splits
+}
+
 func contains(data []int, item int) bool {
 	for _, el := range data {
 		if el == item {
@@ -1,6 +1,7 @@
 package dutil_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/sugarme/gotch/dutil"
@@ -54,3 +55,125 @@ func TestKFold_Split(t *testing.T) {
 		}
 	}
 }
+
+func TestKFold_Seed(t *testing.T) {
+	kf, err := dutil.NewKFold(10, dutil.WithKFoldShuffle(true), dutil.WithKFoldSeed(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := kf.Split()
+	got := kf.Split()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+}
+
+func TestRepeatedKFold_Split(t *testing.T) {
+	rkf, err := dutil.NewRepeatedKFold(10, dutil.WithNFolds(5), dutil.WithNRepeats(3), dutil.WithKFoldSeed(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	splits := rkf.Split()
+	if len(splits) != 15 {
+		t.Errorf("Want number of folds: %v\n", 15)
+		t.Errorf("Got number of folds: %v\n", len(splits))
+	}
+	if reflect.DeepEqual(splits[:5], splits[5:10]) {
+		t.Errorf("Want different folds in each repetition\n")
+	}
+	if !reflect.DeepEqual(splits, rkf.Split()) {
+		t.Errorf("Want the same folds for the same seed\n")
+	}
+}
+
+func TestStratifiedKFold_Split(t *testing.T) {
+	// 8 samples of class 0 and 4 samples of class 1.
+	labels := []int{0, 0, 1, 0, 0, 1, 0, 0, 1, 0, 0, 1}
+	skf, err := dutil.NewStratifiedKFold(labels, dutil.WithNFolds(4), dutil.WithKFoldShuffle(true), dutil.WithKFoldSeed(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	splits := skf.Split()
+	if len(splits) != 4 {
+		t.Fatalf("Want number of folds: %v, got: %v\n", 4, len(splits))
+	}
+	for _, f := range splits {
+		counts := make(map[int]int)
+		for _, idx := range f.Test {
+			counts[labels[idx]]++
+		}
+		want := map[int]int{0: 2, 1: 1}
+		if !reflect.DeepEqual(want, counts) {
+			t.Errorf("Want class counts of test set: %v\n", want)
+			t.Errorf("Got class counts of test set: %v\n", counts)
+		}
+		if len(f.Train)+len(f.Test) != len(labels) {
+			t.Errorf("Want train and test sets covering %v samples, got %v\n", len(labels), len(f.Train)+len(f.Test))
+		}
+	}
+
+	if !reflect.DeepEqual(splits, skf.Split()) {
+		t.Errorf("Want the same folds for the same seed\n")
+	}
+}
+
+func TestGroupKFold_Split(t *testing.T) {
+	groups := []int{1, 1, 1, 2, 2, 3, 3, 4, 5, 5}
+	gkf, err := dutil.NewGroupKFold(groups, dutil.WithNFolds(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	splits := gkf.Split()
+	for _, f := range splits {
+		testGroups := make(map[int]bool)
+		for _, idx := range f.Test {
+			testGroups[groups[idx]] = true
+		}
+		for _, idx := range f.Train {
+			if testGroups[groups[idx]] {
+				t.Errorf("Group %v is in both train and test sets\n", groups[idx])
+			}
+		}
+	}
+
+	wantSizes := []int{3, 4, 3}
+	var gotSizes []int
+	for _, f := range splits {
+		gotSizes = append(gotSizes, len(f.Test))
+	}
+	if !reflect.DeepEqual(wantSizes, gotSizes) {
+		t.Errorf("Want test sizes: %v\n", wantSizes)
+		t.Errorf("Got test sizes: %v\n", gotSizes)
+	}
+
+	if _, err := dutil.NewGroupKFold(groups, dutil.WithNFolds(6)); err == nil {
+		t.Errorf("Expected error: number of folds greater than number of groups. Got nil.")
+	}
+}
+
+func TestTimeSeriesSplit_Split(t *testing.T) {
+	tss, err := dutil.NewTimeSeriesSplit(6, dutil.WithNSplits(3), dutil.WithMaxTrainSize(2), dutil.WithGap(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []dutil.Fold{
+		{Train: []int{0, 1}, Test: []int{3}},
+		{Train: []int{1, 2}, Test: []int{4}},
+		{Train: []int{2, 3}, Test: []int{5}},
+	}
+	got := tss.Split()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	if _, err := dutil.NewTimeSeriesSplit(3, dutil.WithNSplits(3)); err == nil {
+		t.Errorf("Expected error: too many splits. Got nil.")
+	}
+}
@@ -2,8 +2,11 @@ package dutil
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
-	"time"
+	"sort"
+
+	"github.com/sugarme/gotch"
 )
 
 // Sampler represents an interface to draw sample
@@ -112,7 +115,7 @@ func NewRandomSampler(n int, opt ...RandOption) (*RandomSampler, error) {
 
 // Sample implements Sampler interface.
 func (s *RandomSampler) Sample() []int {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	r := gotch.Rand()
 	var indices []int
 
 	if !s.replacement {
@@ -205,8 +208,7 @@ func (s *BatchSampler) Sample() []int {
 		}
 	case true:
 		// random permutation
-		r := rand.New(rand.NewSource(time.Now().UnixNano()))
-		indices = r.Perm(s.n)
+		indices = gotch.Rand().Perm(s.n)
 	}
 
 	for _, i := range indices {
@@ -227,3 +229,194 @@ func (s *BatchSampler) Sample() []int {
 func (s *BatchSampler) BatchSize() int {
 	return s.batchSize
 }
+
+// WeightedRandomSampler draws samples randomly with probabilities
+// proportional to their weights.
+type WeightedRandomSampler struct {
+	weights     []float64
+	cumWeights  []float64 // cumulative sums of weights
+	size        int       // size of sampling
+	replacement bool      // whether a sample can be drawn more than once
+	batchSize   int       // always = 1
+}
+
+// NewWeightedRandomSampler creates a new WeightedRandomSampler.
+//
+// weights: non-negative weights of samples in dataset, not necessarily summing up to 1
+// size: size of sampling. If 0, number of samples.
+// replacement: whether a sample can be drawn more than once. If false,
+// size cannot be greater than number of samples with non-zero weights.
+func NewWeightedRandomSampler(weights []float64, size int, replacement bool) (*WeightedRandomSampler, error) {
+	var (
+		total    float64
+		nonZeros int
+	)
+	cumWeights := make([]float64, len(weights))
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			err := fmt.Errorf("Invalid weight: weights must be non-negative and finite. Got %v at index %v\n", w, i)
+			return nil, err
+		}
+		if w > 0 {
+			nonZeros++
+		}
+		total += w
+		cumWeights[i] = total
+	}
+	if total == 0 {
+		err := fmt.Errorf("Invalid weights: expected at least one positive weight.\n")
+		return nil, err
+	}
+
+	if size == 0 {
+		size = len(weights)
+	}
+	if size < 0 || (!replacement && size > nonZeros) {
+		err := fmt.Errorf("Invalid sampling size: size must be positive and not greater than number of samples with non-zero weights (%v) without replacement. Got %v\n", nonZeros, size)
+		return nil, err
+	}
+
+	return &WeightedRandomSampler{
+		weights:     weights,
+		cumWeights:  cumWeights,
+		size:        size,
+		replacement: replacement,
+		batchSize:   1,
+	}, nil
+}
+
+// Sample implements Sampler interface.
+func (s *WeightedRandomSampler) Sample() []int {
+	r := gotch.Rand()
+	indices := make([]int, s.size)
+
+	if s.replacement {
+		total := s.cumWeights[len(s.cumWeights)-1]
+		for i := range indices {
+			u := r.Float64() * total
+			for u >= total { // rounding
+				u = r.Float64() * total
+			}
+			indices[i] = sort.Search(len(s.cumWeights), func(j int) bool {
+				return s.cumWeights[j] > u
+			})
+		}
+		return indices
+	}
+
+	// Weighted sampling without replacement takes samples with the largest
+	// keys u^(1/w), i.e. log(u)/w, for u uniform in (0, 1).
+	// ref. Efraimidis & Spirakis, "Weighted random sampling with a reservoir"
+	keys := make([]float64, len(s.weights))
+	order := make([]int, len(s.weights))
+	for i, w := range s.weights {
+		order[i] = i
+		if w == 0 {
+			keys[i] = math.Inf(-1)
+			continue
+		}
+		keys[i] = math.Log(1-r.Float64()) / w
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return keys[order[i]] > keys[order[j]]
+	})
+	copy(indices, order)
+
+	return indices
+}
+
+// BatchSize implements Sampler interface.
+// It's always return 1.
+func (s *WeightedRandomSampler) BatchSize() int {
+	return s.batchSize
+}
+
+// NewClassBalancedSampler creates a WeightedRandomSampler that draws samples
+// of each class with the same probability by weighting samples inversely to
+// size of their class.
+//
+// labels: class labels of samples in dataset
+// size: size of sampling. If 0, number of samples.
+// replacement: whether a sample can be drawn more than once. Use true to
+// over-sample minority classes so that classes are balanced over the whole
+// sampling.
+func NewClassBalancedSampler(labels []int, size int, replacement bool) (*WeightedRandomSampler, error) {
+	counts := make(map[int]int)
+	for _, l := range labels {
+		counts[l]++
+	}
+
+	weights := make([]float64, len(labels))
+	for i, l := range labels {
+		weights[i] = 1 / float64(counts[l])
+	}
+
+	return NewWeightedRandomSampler(weights, size, replacement)
+}
+
+// ShardedSampler draws a disjoint shard of shuffled samples for each of
+// `worldSize` processes (e.g. in distributed training). All processes must
+// use the same seed and epoch to get disjoint shards. Shards have the same
+// size n / worldSize, remainder samples of the epoch are dropped.
+type ShardedSampler struct {
+	n         int
+	rank      int
+	worldSize int
+	seed      int64
+	epoch     int
+	batchSize int // always = 1
+}
+
+// NewShardedSampler creates a new ShardedSampler.
+//
+// n: number of samples in dataset
+// rank: rank of the process in [0, worldSize)
+// worldSize: number of processes
+// seed: seed of shuffling shared by all processes
+// epoch: epoch of training. Samples are shuffled differently for each epoch.
+func NewShardedSampler(n, rank, worldSize int, seed int64, epoch int) (*ShardedSampler, error) {
+	if worldSize < 1 || worldSize > n {
+		err := fmt.Errorf("Invalid world size: world size must be equal or greater than 1 and less or equal to number of samples(%v). Got %v\n", n, worldSize)
+		return nil, err
+	}
+
+	if rank < 0 || rank >= worldSize {
+		err := fmt.Errorf("Invalid rank: rank must be in range [0, %v). Got %v\n", worldSize, rank)
+		return nil, err
+	}
+
+	return &ShardedSampler{
+		n:         n,
+		rank:      rank,
+		worldSize: worldSize,
+		seed:      seed,
+		epoch:     epoch,
+		batchSize: 1,
+	}, nil
+}
+
+// SetEpoch sets epoch so that the next Sample shuffles samples for the epoch.
+// A DataLoader draws samples of the new epoch on `Reset`.
+func (s *ShardedSampler) SetEpoch(epoch int) {
+	s.epoch = epoch
+}
+
+// Sample implements Sampler interface.
+func (s *ShardedSampler) Sample() []int {
+	r := rand.New(rand.NewSource(gotch.SeedFor(s.seed, s.epoch)))
+	perm := r.Perm(s.n)
+
+	shardSize := s.n / s.worldSize
+	indices := make([]int, shardSize)
+	for i := range indices {
+		indices[i] = perm[i*s.worldSize+s.rank]
+	}
+
+	return indices
+}
+
+// BatchSize implements Sampler interface.
+// It's always return 1.
+func (s *ShardedSampler) BatchSize() int {
+	return s.batchSize
+}
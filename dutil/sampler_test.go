@@ -142,3 +142,133 @@ func seq(n int) []int {
 	}
 	return s
 }
+
+func TestWeightedRandomSampler(t *testing.T) {
+	weights := []float64{0, 1, 0, 3}
+	s, err := dutil.NewWeightedRandomSampler(weights, 1000, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(map[int]int)
+	for _, idx := range s.Sample() {
+		counts[idx]++
+	}
+	if counts[0] != 0 || counts[2] != 0 {
+		t.Errorf("Unexpected samples with zero weight. Got: %+v\n", counts)
+	}
+	if counts[3] < 2*counts[1] {
+		t.Errorf("Want sample 3 drawn about 3 times as often as sample 1. Got: %+v\n", counts)
+	}
+
+	// Without replacement
+	s, err = dutil.NewWeightedRandomSampler(weights, 2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	indices := s.Sample()
+	if len(indices) != 2 || isDup(indices) || counts[indices[0]] == 0 || counts[indices[1]] == 0 {
+		t.Errorf("Want samples 1 and 3 without duplicates. Got: %+v\n", indices)
+	}
+
+	if _, err := dutil.NewWeightedRandomSampler(weights, 3, false); err == nil {
+		t.Errorf("Expected error: size greater than number of non-zero weights. Got nil.")
+	}
+	if _, err := dutil.NewWeightedRandomSampler([]float64{1, -1}, 0, true); err == nil {
+		t.Errorf("Expected error: negative weight. Got nil.")
+	}
+}
+
+func TestClassBalancedSampler(t *testing.T) {
+	// 9 samples of class 0 and 1 sample of class 1.
+	labels := []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	s, err := dutil.NewClassBalancedSampler(labels, 2000, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var minority int
+	for _, idx := range s.Sample() {
+		if labels[idx] == 1 {
+			minority++
+		}
+	}
+	if minority < 800 || minority > 1200 {
+		t.Errorf("Want about 1000 samples of minority class. Got: %v\n", minority)
+	}
+}
+
+func TestShardedSampler(t *testing.T) {
+	n, worldSize := 10, 3
+	seen := make(map[int]bool)
+	for rank := 0; rank < worldSize; rank++ {
+		s, err := dutil.NewShardedSampler(n, rank, worldSize, 42, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		indices := s.Sample()
+		if len(indices) != n/worldSize {
+			t.Errorf("Want shard size: %v\n", n/worldSize)
+			t.Errorf("Got shard size: %v\n", len(indices))
+		}
+		for _, idx := range indices {
+			if seen[idx] {
+				t.Errorf("Unexpected sample %v in more than one shard\n", idx)
+			}
+			seen[idx] = true
+		}
+
+		if !reflect.DeepEqual(indices, s.Sample()) {
+			t.Errorf("Want the same shard for the same seed and epoch\n")
+		}
+		s.SetEpoch(1)
+		if reflect.DeepEqual(indices, s.Sample()) {
+			t.Errorf("Want different shard for different epoch\n")
+		}
+	}
+
+	// Seed and epoch do not offset each other.
+	s1, err := dutil.NewShardedSampler(n, 0, 1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := dutil.NewShardedSampler(n, 0, 1, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(s1.Sample(), s2.Sample()) {
+		t.Errorf("Want different shards for (seed 1, epoch 0) and (seed 0, epoch 1)\n")
+	}
+
+	// DataLoader draws shards of the new epoch on Reset.
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	data, err := dutil.NewSliceDataset(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dl, err := dutil.NewDataLoader(data, s1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s1.SetEpoch(1)
+	dl.Reset()
+	var got []int
+	for dl.HasNext() {
+		item, err := dl.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item.(int))
+	}
+	if want := s1.Sample(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	if _, err := dutil.NewShardedSampler(n, 3, worldSize, 42, 0); err == nil {
+		t.Errorf("Expected error: invalid rank. Got nil.")
+	}
+}
@@ -24,10 +24,10 @@ func roundTrip() {
 	}
 
 	fmt.Printf("%i", img)
-	fimg := aug.Byte2FloatImage(img)
+	fimg := aug.MustByte2FloatImage(img)
 	fmt.Printf("%i", fimg)
 
-	bimg := aug.Float2ByteImage(fimg)
+	bimg := aug.MustFloat2ByteImage(fimg)
 	fmt.Printf("%i", bimg)
 
 	err = vision.Save(bimg, "./bimg.png")
@@ -86,7 +86,14 @@ func tOne() {
 	// t, err := aug.Compose(aug.WithRandomAffine(aug.WithAffineDegree([]int64{0, 15}), aug.WithAffineShear([]float64{0, 15})))
 	// t, err := aug.Compose(aug.WithRandomAffine(aug.WithAffineDegree([]int64{0, 15}), aug.WithAffineTranslate([]float64{0.0, 0.1})))
 
-	out := t.Transform(imgTs)
+	if err != nil {
+		panic(err)
+	}
+
+	out, err := t.Transform(imgTs)
+	if err != nil {
+		panic(err)
+	}
 	fname := fmt.Sprintf("./bb-transformed.jpg")
 	err = vision.Save(out, fname)
 	if err != nil {
@@ -128,7 +135,10 @@ func tMany() {
 			panic(err)
 		}
 
-		out := t.Transform(imgTs)
+		out, err := t.Transform(imgTs)
+		if err != nil {
+			panic(err)
+		}
 		fname := fmt.Sprintf("./output/bb-%03d.png", i)
 		err = vision.Save(out, fname)
 		if err != nil {
@@ -81,7 +81,7 @@ func fastResnet(p *nn.Path) *nn.SequentialT {
 
 func main() {
 	dir := "../../data/cifar10"
-	ds := vision.CFLoadDir(dir)
+	ds := vision.MustCFLoadDir(dir)
 
 	fmt.Printf("TrainImages shape: %v\n", ds.TrainImages.MustSize())
 	fmt.Printf("TrainLabel shape: %v\n", ds.TrainLabels.MustSize())
@@ -136,7 +136,7 @@ func main() {
 
 			devicedData := item.Data.MustTo(vs.Device(), true)
 			devicedLabel := item.Label.MustTo(vs.Device(), true)
-			bimages := vision.Augmentation(devicedData, true, 4, 8)
+			bimages := vision.MustAugmentation(devicedData, true, 4, 8)
 
 			logits := net.ForwardT(bimages, true)
 
@@ -28,7 +28,7 @@ func init() {
 func main() {
 	flag.Parse()
 
-	ds := vision.LoadMNISTDir("../../data/mnist")
+	ds := vision.MustLoadMNISTDir("../../data/mnist")
 	// dataset := &vision.Dataset{
 	// TestImages:  ds.TestImages.MustView([]int64{-1, 1, 28, 28}, true),
 	// TrainImages: ds.TrainImages.MustView([]int64{-1, 1, 28, 28}, true),
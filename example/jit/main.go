@@ -47,7 +47,7 @@ func main() {
 	// Print the top 5 categories for this image.
 	var top5 []vision.TopItem
 
-	top5 = imageNet.Top(output, int64(5))
+	top5 = imageNet.MustTop(output, int64(5))
 
 	for _, i := range top5 {
 		fmt.Printf("%-80v %5.2f%%\n", i.Label, i.Pvalue*100)
@@ -70,7 +70,7 @@ func (n *Net) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
 func runCNN1() {
 
 	var ds *vision.Dataset
-	ds = vision.LoadMNISTDir(MnistDirNN)
+	ds = vision.MustLoadMNISTDir(MnistDirNN)
 	testImages := ds.TestImages
 	testLabels := ds.TestLabels
 
@@ -18,7 +18,7 @@ const (
 
 func runLinear() {
 	var ds *vision.Dataset
-	ds = vision.LoadMNISTDir(MnistDir)
+	ds = vision.MustLoadMNISTDir(MnistDir)
 
 	device := gotch.CPU
 	dtype := gotch.Float
@@ -57,7 +57,7 @@ func train(trainX, trainY, testX, testY *ts.Tensor, m ts.Module, opt *nn.Optimiz
 func runNN() {
 
 	var ds *vision.Dataset
-	ds = vision.LoadMNISTDir(MnistDirNN)
+	ds = vision.MustLoadMNISTDir(MnistDirNN)
 	vs := nn.NewVarStore(gotch.CPU)
 	net := netInit(vs.Root())
 	opt, err := nn.DefaultAdamConfig().Build(vs, LrNN)
@@ -138,7 +138,7 @@ func main() {
 
 	vs := nn.NewVarStore(device)
 	path := vs.Root()
-	inputVar := path.VarCopy("img", contentImg)
+	inputVar := path.MustVarCopy("img", contentImg)
 	opt, err := nn.DefaultAdamConfig().Build(vs, LearningRate)
 	if err != nil {
 		log.Fatal(err)
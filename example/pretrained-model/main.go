@@ -115,7 +115,7 @@ func main() {
 	pval := logits.MustSoftmax(-1, gotch.Float, true)
 
 	// Print the top 5 categories for this image.
-	top5 := in.Top(pval, int64(5))
+	top5 := in.MustTop(pval, int64(5))
 
 	for _, i := range top5 {
 		fmt.Printf("%-80v %5.2f%%\n", i.Label, i.Pvalue*100)
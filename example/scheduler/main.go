@@ -24,28 +24,28 @@ func main() {
 		log.Fatal(err)
 	}
 
-	ngroup := o.ParamGroupNum()
-	lrs := o.GetLRs()
+	ngroup := o.MustParamGroupNum()
+	lrs := o.MustGetLRs()
 
 	fmt.Printf("Number of param groups: %v\n", ngroup)
 	fmt.Printf("Learning rates: %+v\n", lrs)
 
 	newLRs := []float64{0.005}
 	o.SetLRs(newLRs)
-	fmt.Printf("New LRs: %+v\n", o.GetLRs())
+	fmt.Printf("New LRs: %+v\n", o.MustGetLRs())
 
 	zerosTs := ts.MustZeros([]int64{2, 2}, gotch.Float, device)
 	onesTs := ts.MustOnes([]int64{3, 5}, gotch.Float, device)
 
 	o.AddParamGroup([]ts.Tensor{*zerosTs, *onesTs})
-	fmt.Printf("New num of param groups: %v\n", o.ParamGroupNum())
+	fmt.Printf("New num of param groups: %v\n", o.MustParamGroupNum())
 
-	fmt.Printf("New LRs: %+v\n", o.GetLRs())
+	fmt.Printf("New LRs: %+v\n", o.MustGetLRs())
 
 	// Set new lrs
 	newLRs = []float64{0.0003, 0.0006}
 	o.SetLRs(newLRs)
-	fmt.Printf("New LRs: %+v\n", o.GetLRs())
+	fmt.Printf("New LRs: %+v\n", o.MustGetLRs())
 
 	log.Print(model)
 }
@@ -409,6 +409,19 @@ module Func = struct
     match List.partition_tf t.args ~f:self_tensor with _, args_list ->
       Printf.sprintf "%s" (to_string args_list)
 
+  (* NOTE. input tensors (`ts` for method) to report in `TorchError` *)
+  let go_tensor_args t =
+    let self_args = if is_method t then ["ts"] else [] in
+    match List.partition_tf t.args ~f:self_tensor with _, args_list ->
+      let tensor_args =
+        List.filter_map args_list ~f:(fun arg ->
+            match arg.arg_type with
+            | Tensor | TensorOption -> Some (go_variable arg.arg_name)
+            | _ -> None )
+      in
+      List.map (self_args @ tensor_args) ~f:(fun name -> ", " ^ name)
+      |> String.concat ~sep:""
+
   let go_notype_args_list t =
     let to_string args =
       let args_list =
@@ -771,13 +784,14 @@ let write_wrapper funcs filename =
                 pm "  \n" ;
                 pm "  %s" (Func.go_binding_body func) ;
                 pm "%s(ptr, %s)\n" cfunc_name (Func.go_binding_args func) ;
-                pm "  if err = TorchErr(); err != nil {\n" ;
+                pm "  if err = torchErrOp(\"%s\"%s); err != nil {\n" gofunc_name
+                  (Func.go_tensor_args func) ;
                 pm "    return %s\n"
                   (Func.go_return_notype func ~fallible:true) ;
                 pm "  }\n" ;
                 (* NOTE. if in_place method, no retVal return *)
                 if not (Func.is_inplace func) then
-                  pm "  retVal = &Tensor{ctensor: *ptr}\n" ;
+                  pm "  retVal = newTensor(*ptr)\n" ;
                 pm "  \n" ;
                 pm "  return %s\n" (Func.go_return_notype func ~fallible:true) ;
                 pm "} \n"
@@ -793,13 +807,14 @@ let write_wrapper funcs filename =
                 pm "  \n" ;
                 pm "  %s" (Func.go_binding_body func) ;
                 pm "%s(ptr, %s)\n" cfunc_name (Func.go_binding_args func) ;
-                pm "  if err = TorchErr(); err != nil {\n" ;
+                pm "  if err = torchErrOp(\"%s\"%s); err != nil {\n" gofunc_name
+                  (Func.go_tensor_args func) ;
                 pm "    return %s\n"
                   (Func.go_return_notype func ~fallible:true) ;
                 pm "  }\n" ;
                 (* NOTE. if in_place method, no retVal return *)
                 if not (Func.is_inplace func) then
-                  pm "  retVal = &Tensor{ctensor: *ptr}\n" ;
+                  pm "  retVal = newTensor(*ptr)\n" ;
                 pm "  \n" ;
                 pm "  return %s\n" (Func.go_return_notype func ~fallible:true) ;
                 pm "} \n"
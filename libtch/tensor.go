@@ -6,6 +6,16 @@ package libtch
 //#include "stdlib.h"
 //void callback_fn(void *, char *, tensor);
 //typedef void (*f)(void *, char *, tensor);
+//char *hook_callback(void *, tensor, tensor *);
+//void hook_free(void *);
+//typedef char *(*hook_f)(void *, tensor, tensor *);
+//typedef void (*hook_free_f)(void *);
+//char *function_forward(void *, void *, tensor *, int, tensor **, int *);
+//char *function_backward(void *, void *, tensor *, int, tensor **, int *);
+//void function_free(void *);
+//typedef void (*function_free_f)(void *);
+//void blob_release(void *);
+//typedef void (*blob_release_f)(void *);
 import "C"
 
 import (
@@ -69,7 +79,11 @@ func AtDevice(ts Ctensor) int {
 func AtTensorOfData(vs unsafe.Pointer, dims []int64, ndims uint, elt_size_in_bytes uint, kind int) Ctensor {
 
 	// just get pointer of the first element of shape
-	c_dims := (*C.int64_t)(unsafe.Pointer(&dims[0]))
+	// NOTE. scalar tensor has empty shape.
+	var c_dims *C.int64_t
+	if len(dims) > 0 {
+		c_dims = (*C.int64_t)(unsafe.Pointer(&dims[0]))
+	}
 	c_ndims := *(*C.size_t)(unsafe.Pointer(&ndims))
 	c_elt_size_in_bytes := *(*C.size_t)(unsafe.Pointer(&elt_size_in_bytes))
 	c_kind := *(*C.int)(unsafe.Pointer(&kind))
@@ -110,6 +124,18 @@ func GetAndResetLastErr() *C.char {
 	return C.get_and_reset_last_err()
 }
 
+// GetAndResetLastErrString returns last error message of current OS thread
+// (empty if none), clears it and frees C memory of the message.
+func GetAndResetLastErrString() string {
+	cptr := C.get_and_reset_last_err()
+	if cptr == nil {
+		return ""
+	}
+	defer C.free(unsafe.Pointer(cptr))
+
+	return C.GoString(cptr)
+}
+
 // int atc_cuda_device_count();
 func AtcCudaDeviceCount() int {
 	result := C.atc_cuda_device_count()
@@ -170,6 +196,25 @@ func AtIsSparse(ts Ctensor) bool {
 	return *(*bool)(unsafe.Pointer(&retVal))
 }
 
+// int at_is_contiguous(tensor);
+func AtIsContiguous(ts Ctensor) bool {
+	return C.at_is_contiguous(ts) == 1
+}
+
+// void at_set_anomaly_enabled(int b);
+func AtSetAnomalyEnabled(b bool) {
+	cb := C.int(0)
+	if b {
+		cb = 1
+	}
+	C.at_set_anomaly_enabled(cb)
+}
+
+// int at_anomaly_enabled();
+func AtAnomalyEnabled() bool {
+	return C.at_anomaly_enabled() != 0
+}
+
 // void at_backward(tensor, int, int);
 func AtBackward(ts Ctensor, keepGraph int, createGraph int) {
 	ckeepGraph := *(*C.int)(unsafe.Pointer(&keepGraph))
@@ -178,6 +223,160 @@ func AtBackward(ts Ctensor, keepGraph int, createGraph int) {
 	C.at_backward(ts, ckeepGraph, ccreateGraph)
 }
 
+// HookFunc is a gradient hook called with gradient of a tensor in backward
+// pass. It returns a new gradient to replace the input one or nil to keep it.
+// Returning an error aborts the backward pass.
+//
+// NOTE. the input gradient and the returned tensor are owned by the callee
+// and the caller respectively.
+type HookFunc func(grad Ctensor) (Ctensor, error)
+
+// int at_register_hook(tensor, void *, char *(*)(void *, tensor, tensor *), void (*)(void *));
+func AtRegisterHook(ts Ctensor, hook HookFunc) int {
+	hookPtr := PStore.Set(hook)
+	pos := C.at_register_hook(ts, hookPtr, C.hook_f(C.hook_callback), C.hook_free_f(C.hook_free))
+	return int(pos)
+}
+
+// void at_remove_hook(tensor, int);
+func AtRemoveHook(ts Ctensor, pos int) {
+	cpos := *(*C.int)(unsafe.Pointer(&pos))
+	C.at_remove_hook(ts, cpos)
+}
+
+//export hook_callback
+func hook_callback(hookPtr unsafe.Pointer, grad C.tensor, out *C.tensor) *C.char {
+	hook := PStore.Get(hookPtr).(HookFunc)
+	ctensor, err := hook(grad)
+	if err != nil {
+		return C.CString(err.Error())
+	}
+	*out = ctensor
+
+	return nil
+}
+
+//export hook_free
+func hook_free(hookPtr unsafe.Pointer) {
+	PStore.Free(hookPtr)
+}
+
+// FunctionCallback is a callback of a custom autograd function. It is called
+// with a context and input tensors (forward) or gradients of outputs
+// (backward, a nil element is an undefined gradient) and returns output
+// tensors (forward) or gradients of inputs (backward, nil for no gradient).
+// Returning an error aborts the call.
+//
+// NOTE. the input tensors and the returned tensors are owned by the callee
+// and the caller respectively.
+type FunctionCallback func(ctx unsafe.Pointer, tensors []Ctensor) ([]Ctensor, error)
+
+// FunctionCallbacks are forward and backward callbacks of a custom autograd
+// function.
+type FunctionCallbacks struct {
+	Forward  FunctionCallback
+	Backward FunctionCallback
+}
+
+// tensor *at_function_apply(char *name, void *data, tensor *inputs, int ninputs, function_cb forward, function_cb backward, void (*free_data)(void *), int *noutputs);
+func AtFunctionApply(name string, fn *FunctionCallbacks, inputs []Ctensor) []Ctensor {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	ninputs := len(inputs)
+	var cinputs *C.tensor
+	if ninputs > 0 {
+		cinputs = &inputs[0]
+	}
+	cninputs := *(*C.int)(unsafe.Pointer(&ninputs))
+
+	// NOTE. fn is freed by libtorch when the function is destroyed.
+	fnPtr := PStore.Set(fn)
+	var cnoutputs C.int
+	coutputs := C.at_function_apply(cname, fnPtr, cinputs, cninputs, C.function_cb(C.function_forward), C.function_cb(C.function_backward), C.function_free_f(C.function_free), &cnoutputs)
+
+	return ctensorsFromC(coutputs, cnoutputs)
+}
+
+// void at_function_ctx_save(void *ctx, tensor *tensors, int ntensors);
+func AtFunctionCtxSave(ctx unsafe.Pointer, tensors []Ctensor) {
+	ntensors := len(tensors)
+	if ntensors == 0 {
+		return
+	}
+	cntensors := *(*C.int)(unsafe.Pointer(&ntensors))
+
+	C.at_function_ctx_save(ctx, &tensors[0], cntensors)
+}
+
+// tensor *at_function_ctx_saved(void *ctx, int *ntensors);
+func AtFunctionCtxSaved(ctx unsafe.Pointer) []Ctensor {
+	var cntensors C.int
+	ctensors := C.at_function_ctx_saved(ctx, &cntensors)
+
+	return ctensorsFromC(ctensors, cntensors)
+}
+
+// ctensorsFromC copies a C array of tensors allocated with malloc to a slice
+// and frees the array.
+func ctensorsFromC(ctensors *C.tensor, n C.int) []Ctensor {
+	if ctensors == nil {
+		return nil
+	}
+	defer C.free(unsafe.Pointer(ctensors))
+
+	tensors := make([]Ctensor, int(n))
+	copy(tensors, (*[1 << 28]C.tensor)(unsafe.Pointer(ctensors))[:int(n):int(n)])
+
+	return tensors
+}
+
+func callFunctionCallback(f FunctionCallback, ctx unsafe.Pointer, tensors *C.tensor, ntensors C.int, outputs **C.tensor, noutputs *C.int) *C.char {
+	var inputs []Ctensor
+	if ntensors > 0 {
+		inputs = make([]Ctensor, int(ntensors))
+		copy(inputs, (*[1 << 28]C.tensor)(unsafe.Pointer(tensors))[:int(ntensors):int(ntensors)])
+	}
+
+	results, err := f(ctx, inputs)
+	if err != nil {
+		return C.CString(err.Error())
+	}
+
+	n := len(results)
+	*outputs = nil
+	*noutputs = *(*C.int)(unsafe.Pointer(&n))
+	if n == 0 {
+		return nil
+	}
+
+	nbytes := C.size_t(n) * C.size_t(unsafe.Sizeof(uintptr(0)))
+	coutputsPtr := (*[1 << 28]C.tensor)(C.malloc(nbytes))
+	for i := 0; i < n; i++ {
+		coutputsPtr[i] = results[i]
+	}
+	*outputs = &coutputsPtr[0]
+
+	return nil
+}
+
+//export function_forward
+func function_forward(fnPtr unsafe.Pointer, ctx unsafe.Pointer, tensors *C.tensor, ntensors C.int, outputs **C.tensor, noutputs *C.int) *C.char {
+	fn := PStore.Get(fnPtr).(*FunctionCallbacks)
+	return callFunctionCallback(fn.Forward, ctx, tensors, ntensors, outputs, noutputs)
+}
+
+//export function_backward
+func function_backward(fnPtr unsafe.Pointer, ctx unsafe.Pointer, tensors *C.tensor, ntensors C.int, outputs **C.tensor, noutputs *C.int) *C.char {
+	fn := PStore.Get(fnPtr).(*FunctionCallbacks)
+	return callFunctionCallback(fn.Backward, ctx, tensors, ntensors, outputs, noutputs)
+}
+
+//export function_free
+func function_free(fnPtr unsafe.Pointer) {
+	PStore.Free(fnPtr)
+}
+
 /*
  * void at_run_backward(tensor *tensors,
  *                       int ntensors,
@@ -195,6 +394,35 @@ func AtRunBackward(tensorsPtr *Ctensor, ntensors int, inputsPtr *Ctensor, ninput
 	C.at_run_backward(tensorsPtr, cntensors, inputsPtr, cninputs, outputsPtr, ckeepGraph, ccreateGraph)
 }
 
+// tensor at_tensor_of_blob_with_deleter(void *data, int64_t *dims, size_t ndims, int64_t *strides, size_t nstrides, int type, int device, void *ctx, void (*deleter)(void *));
+func AtTensorOfBlobWithDeleter(data unsafe.Pointer, dims []int64, strides []int64, kind int, device int, release func()) Ctensor {
+	var cdims, cstrides *C.int64_t
+	if len(dims) > 0 {
+		cdims = (*C.int64_t)(unsafe.Pointer(&dims[0]))
+	}
+	if len(strides) > 0 {
+		cstrides = (*C.int64_t)(unsafe.Pointer(&strides[0]))
+	}
+	cndims := C.size_t(len(dims))
+	cnstrides := C.size_t(len(strides))
+	ckind := *(*C.int)(unsafe.Pointer(&kind))
+	cdevice := *(*C.int)(unsafe.Pointer(&device))
+
+	// NOTE. release is freed by libtorch when data is no longer used.
+	releasePtr := PStore.Set(blobRelease(release))
+	return C.at_tensor_of_blob_with_deleter(data, cdims, cndims, cstrides, cnstrides, ckind, cdevice, releasePtr, C.blob_release_f(C.blob_release))
+}
+
+type blobRelease func()
+
+//export blob_release
+func blob_release(releasePtr unsafe.Pointer) {
+	if release := PStore.Get(releasePtr).(blobRelease); release != nil {
+		release()
+	}
+	PStore.Free(releasePtr)
+}
+
 // void at_copy_data(tensor tensor, void *vs, size_t numel, size_t element_size_in_bytes);
 func AtCopyData(ts Ctensor, vs unsafe.Pointer, numel uint, element_size_in_bytes uint) {
 	cnumel := *(*C.size_t)(unsafe.Pointer(&numel))
@@ -359,6 +587,22 @@ func AtToString(ts Ctensor, lineSize int64) string {
 	return goString
 }
 
+// void at_manual_seed(int64_t);
+func AtManualSeed(seed int64) {
+	cseed := *(*C.int64_t)(unsafe.Pointer(&seed))
+	C.at_manual_seed(cseed)
+}
+
+// tensor at_get_rng_state();
+func AtGetRngState() Ctensor {
+	return C.at_get_rng_state()
+}
+
+// void at_set_rng_state(tensor);
+func AtSetRngState(state Ctensor) {
+	C.at_set_rng_state(state)
+}
+
 // void at_free(tensor);
 func AtFree(ts Ctensor) {
 	C.at_free(ts)
@@ -566,6 +810,30 @@ func AtResizeImage(ts Ctensor, w, h int64) Ctensor {
 	return C.at_resize_image(ts, cw, ch)
 }
 
+// int at_get_num_interop_threads();
+func AtGetNumInteropThreads() int32 {
+	cretVal := C.at_get_num_interop_threads()
+	return *(*int32)(unsafe.Pointer(&cretVal))
+}
+
+// int at_get_num_threads();
+func AtGetNumThreads() int32 {
+	cretVal := C.at_get_num_threads()
+	return *(*int32)(unsafe.Pointer(&cretVal))
+}
+
+// void at_set_num_interop_threads(int n_threads);
+func AtSetNumInteropThreads(nthreads int32) {
+	cnthreads := *(*C.int)(unsafe.Pointer(&nthreads))
+	C.at_set_num_interop_threads(cnthreads)
+}
+
+// void at_set_num_threads(int n_threads);
+func AtSetNumThreads(nthreads int32) {
+	cnthreads := *(*C.int)(unsafe.Pointer(&nthreads))
+	C.at_set_num_threads(cnthreads)
+}
+
 // ivalue ati_none();
 func AtiNone() Civalue {
 	return C.ati_none()
@@ -1,9 +1,12 @@
 #include<torch/csrc/autograd/engine.h>
+#include<torch/csrc/autograd/functions/utils.h>
 #include<torch/csrc/jit/runtime/graph_executor.h>
 #include<torch/torch.h>
 #include<ATen/autocast_mode.h>
 #include<torch/script.h>
+#include<atomic>
 #include<stdexcept>
+#include<unordered_set>
 #include<vector>
 #include "torch_api.h"
 
@@ -28,6 +31,23 @@ void at_manual_seed(int64_t seed) {
   torch::manual_seed(seed);
 }
 
+tensor at_get_rng_state() {
+  PROTECT(
+    auto gen = at::detail::getDefaultCPUGenerator();
+    std::lock_guard<std::mutex> lock(gen.mutex());
+    return new torch::Tensor(gen.get_state());
+  )
+  return nullptr;
+}
+
+void at_set_rng_state(tensor state) {
+  PROTECT(
+    auto gen = at::detail::getDefaultCPUGenerator();
+    std::lock_guard<std::mutex> lock(gen.mutex());
+    gen.set_state(*state);
+  )
+}
+
 vector<torch::Tensor> of_carray_tensor(torch::Tensor **vs, int len) {
   vector<torch::Tensor> result;
   for (int i = 0; i < len; ++i) result.push_back(*(vs[i]));
@@ -54,6 +74,24 @@ tensor at_tensor_of_blob(void *data, int64_t *dims, size_t ndims, int64_t *strid
   return nullptr;
 }
 
+tensor at_tensor_of_blob_with_deleter(void *data, int64_t *dims, size_t ndims,
+                                      int64_t *strides, size_t nstrides,
+                                      int type, int device, void *ctx,
+                                      void (*deleter)(void *)) {
+  // ctx is released once the last copy of release is destroyed: when the
+  // storage is freed or when creating the tensor fails.
+  auto release = std::shared_ptr<void>(ctx, deleter);
+  PROTECT(
+    at::TensorOptions blobOptions = at::TensorOptions().device(device_of_int(device)).dtype(torch::ScalarType(type));
+    auto blobDeleter = [release](void *) {};
+    if (nstrides == 0)
+      return new torch::Tensor(torch::from_blob(data, torch::IntArrayRef(dims, ndims), blobDeleter, blobOptions));
+    return new torch::Tensor(torch::from_blob(data, torch::IntArrayRef(dims, ndims), torch::IntArrayRef(strides, nstrides), blobDeleter, blobOptions));
+  )
+
+  return nullptr;
+}
+
 tensor at_tensor_of_data(void *vs, int64_t *dims, size_t ndims, size_t element_size_in_bytes, int type) {
   PROTECT(
     torch::Tensor tensor = torch::zeros(torch::IntArrayRef(dims, ndims), torch::ScalarType(type));
@@ -111,6 +149,11 @@ int at_is_sparse(tensor t) {
   return -1;
 }
 
+int at_is_contiguous(tensor t) {
+  PROTECT(return t->is_contiguous();)
+  return -1;
+}
+
 size_t at_dim(tensor t) {
   PROTECT(return t->dim();)
   return -1;
@@ -186,8 +229,79 @@ int at_device(tensor t) {
   return -2;
 }
 
+static std::atomic<bool> anomaly_enabled(false);
+
+// AnomalyCheckHook checks gradients returned by a node in backward pass when
+// anomaly detection is enabled.
+struct AnomalyCheckHook : public torch::autograd::FunctionPostHook {
+  std::string fn_name;
+
+  AnomalyCheckHook(std::string name) : fn_name(std::move(name)) {}
+
+  torch::autograd::variable_list
+  operator()(const torch::autograd::variable_list &outputs,
+             const torch::autograd::variable_list &inputs) override {
+    if (!anomaly_enabled)
+      return outputs;
+    torch::autograd::AutoGradMode grad_mode(false);
+    for (size_t i = 0; i < outputs.size(); ++i) {
+      auto &out = outputs[i];
+      if (out.defined() && out.is_floating_point() &&
+          !torch::isfinite(out).all().item<bool>()) {
+        throw std::runtime_error("Function '" + fn_name +
+                                 "' returned nan or inf values in its " +
+                                 std::to_string(i) + "th output.");
+      }
+    }
+    return outputs;
+  }
+};
+
+// adds anomaly check hooks to nodes of graph of tensors if anomaly detection
+// is enabled. A node is hooked once.
+static void add_anomaly_hooks(const vector<torch::Tensor> &tensors) {
+  if (!anomaly_enabled)
+    return;
+  vector<torch::autograd::Node *> stack;
+  std::unordered_set<torch::autograd::Node *> seen;
+  for (auto &t : tensors) {
+    if (t.grad_fn())
+      stack.push_back(t.grad_fn().get());
+  }
+  while (!stack.empty()) {
+    auto fn = stack.back();
+    stack.pop_back();
+    if (!seen.insert(fn).second)
+      continue;
+    bool hooked = false;
+    for (auto &h : fn->post_hooks()) {
+      if (dynamic_cast<AnomalyCheckHook *>(h.get())) {
+        hooked = true;
+        break;
+      }
+    }
+    if (!hooked)
+      fn->add_post_hook(std::make_unique<AnomalyCheckHook>(fn->name()));
+    for (auto &e : fn->next_edges()) {
+      if (e.function)
+        stack.push_back(e.function.get());
+    }
+  }
+}
+
+void at_set_anomaly_enabled(int b) {
+  anomaly_enabled = b;
+}
+
+int at_anomaly_enabled() {
+  return anomaly_enabled;
+}
+
 void at_backward(tensor t, int keep_graph, int create_graph) {
-  PROTECT(t->backward({}, keep_graph, create_graph);)
+  PROTECT(
+    add_anomaly_hooks({*t});
+    t->backward({}, keep_graph, create_graph);
+  )
 }
 
 int at_requires_grad(tensor t) {
@@ -195,6 +309,189 @@ int at_requires_grad(tensor t) {
   return -1;
 }
 
+int at_register_hook(tensor t, void *data, char *(*f)(void *, tensor, tensor *),
+                     void (*free_data)(void *)) {
+  PROTECT(
+    // data is freed when the last copy of hook is destroyed.
+    std::shared_ptr<void> guard(data, free_data);
+    return t->register_hook([guard, f](torch::Tensor grad) -> torch::Tensor {
+      tensor out = nullptr;
+      char *err = f(guard.get(), new torch::Tensor(grad), &out);
+      if (err != nullptr) {
+        std::string msg(err);
+        free(err);
+        throw std::runtime_error(msg);
+      }
+      // undefined tensor keeps the gradient unchanged.
+      if (out == nullptr)
+        return torch::Tensor();
+      torch::Tensor result = *out;
+      delete out;
+      return result;
+    });
+  )
+  return -1;
+}
+
+void at_remove_hook(tensor t, int pos) {
+  PROTECT(t->remove_hook(pos);)
+}
+
+// GoFunctionData holds callbacks of a custom autograd function and frees
+// their data when destroyed.
+struct GoFunctionData {
+  void *data;
+  function_cb forward;
+  function_cb backward;
+  void (*free_data)(void *);
+
+  ~GoFunctionData() { free_data(data); }
+};
+
+// GoFunctionCtx is context passed to callbacks: tensors to save in forward
+// and saved tensors in backward.
+struct GoFunctionCtx {
+  torch::autograd::variable_list saved;
+};
+
+static torch::autograd::variable_list
+call_function_cb(function_cb f, void *data, GoFunctionCtx *ctx,
+                 const torch::autograd::variable_list &vars) {
+  std::vector<tensor> ts;
+  for (auto &v : vars)
+    ts.push_back(v.defined() ? new torch::Tensor(v) : nullptr);
+  tensor *outputs = nullptr;
+  int noutputs = 0;
+  char *err = f(data, ctx, ts.data(), ts.size(), &outputs, &noutputs);
+  if (err != nullptr) {
+    std::string msg(err);
+    free(err);
+    throw std::runtime_error(msg);
+  }
+  torch::autograd::variable_list result;
+  for (int i = 0; i < noutputs; ++i) {
+    if (outputs[i] == nullptr) {
+      result.push_back(torch::Tensor());
+    } else {
+      result.push_back(*outputs[i]);
+      delete outputs[i];
+    }
+  }
+  free(outputs);
+  return result;
+}
+
+// GoFunctionNode is autograd node of a custom autograd function.
+struct GoFunctionNode : public torch::autograd::Node {
+  std::string fn_name;
+  std::shared_ptr<GoFunctionData> fn;
+  std::vector<torch::autograd::SavedVariable> saved;
+
+  GoFunctionNode(std::string name, std::shared_ptr<GoFunctionData> fn)
+      : fn_name(std::move(name)), fn(std::move(fn)) {}
+
+  std::string name() const override { return fn_name; }
+
+  torch::autograd::variable_list
+  apply(torch::autograd::variable_list &&grads) override {
+    GoFunctionCtx ctx;
+    for (auto &v : saved)
+      ctx.saved.push_back(v.unpack(shared_from_this()));
+    auto result = call_function_cb(fn->backward, fn->data, &ctx, grads);
+    if (result.size() != num_outputs()) {
+      throw std::runtime_error(
+          fn_name + " backward returned " + std::to_string(result.size()) +
+          " gradients, expected " + std::to_string(num_outputs()));
+    }
+    return result;
+  }
+
+  void release_variables() override { saved.clear(); }
+};
+
+tensor *at_function_apply(char *name, void *data, tensor *inputs, int ninputs,
+                          function_cb forward, function_cb backward,
+                          void (*free_data)(void *), int *noutputs) {
+  PROTECT(
+    // data is freed when the function is destroyed.
+    auto fn = std::shared_ptr<GoFunctionData>(
+        new GoFunctionData{data, forward, backward, free_data});
+    torch::autograd::variable_list vars;
+    bool requires_grad = false;
+    for (int i = 0; i < ninputs; ++i) {
+      vars.push_back(*inputs[i]);
+      requires_grad = requires_grad || inputs[i]->requires_grad();
+    }
+
+    GoFunctionCtx ctx;
+    torch::autograd::variable_list outputs;
+    {
+      torch::autograd::AutoGradMode grad_mode(false);
+      outputs = call_function_cb(forward, data, &ctx, vars);
+      // outputs sharing memory with inputs are copied so that they get their
+      // own autograd history.
+      for (auto &out : outputs) {
+        if (!out.defined())
+          continue;
+        for (auto &v : vars) {
+          if (out.is_alias_of(v)) {
+            out = out.clone();
+            break;
+          }
+        }
+      }
+    }
+
+    if (requires_grad && torch::autograd::GradMode::is_enabled()) {
+      auto node = std::shared_ptr<GoFunctionNode>(
+          new GoFunctionNode(name, fn), torch::autograd::deleteNode);
+      torch::autograd::edge_list edges;
+      for (auto &v : vars)
+        edges.push_back(torch::autograd::impl::gradient_edge(v));
+      node->set_next_edges(std::move(edges));
+      for (auto &out : outputs)
+        torch::autograd::set_history(out, node);
+      for (auto &v : ctx.saved) {
+        bool is_output = false;
+        for (auto &out : outputs) {
+          if (out.defined() && v.is_same(out)) {
+            is_output = true;
+            break;
+          }
+        }
+        node->saved.emplace_back(v, is_output);
+      }
+    }
+
+    tensor *result = (tensor *)malloc(outputs.size() * sizeof(tensor));
+    for (size_t i = 0; i < outputs.size(); ++i)
+      result[i] = outputs[i].defined() ? new torch::Tensor(outputs[i]) : nullptr;
+    *noutputs = outputs.size();
+    return result;
+  )
+  return nullptr;
+}
+
+void at_function_ctx_save(void *ctx, tensor *tensors, int ntensors) {
+  PROTECT(
+    auto c = (GoFunctionCtx *)ctx;
+    for (int i = 0; i < ntensors; ++i)
+      c->saved.push_back(*tensors[i]);
+  )
+}
+
+tensor *at_function_ctx_saved(void *ctx, int *ntensors) {
+  PROTECT(
+    auto c = (GoFunctionCtx *)ctx;
+    tensor *result = (tensor *)malloc(c->saved.size() * sizeof(tensor));
+    for (size_t i = 0; i < c->saved.size(); ++i)
+      result[i] = new torch::Tensor(c->saved[i]);
+    *ntensors = c->saved.size();
+    return result;
+  )
+  return nullptr;
+}
+
 int at_grad_set_enabled(int b) {
   PROTECT(
     bool is_enabled = torch::autograd::GradMode::is_enabled();
@@ -459,6 +756,11 @@ void at_run_backward(tensor *tensors,
     for (int i = 0; i < ntensors; ++i)
       grads.push_back(torch::ones_like(*tensors[i]));
 
+    vector<torch::Tensor> roots_;
+    for (int i = 0; i < ntensors; ++i)
+      roots_.push_back(*tensors[i]);
+    add_anomaly_hooks(roots_);
+
     auto vl = torch::autograd::Engine::get_default_engine().execute(roots, grads, keep_graph, create_graph, inputs_);
     for (int i = 0; i < ninputs; ++i) {
       outputs[i] = static_cast<tensor>(new torch::autograd::Variable(vl[i]));
@@ -27,10 +27,20 @@ typedef void *ivalue;
 
 char *get_and_reset_last_err(); // thread-local
 void at_manual_seed(int64_t);
+// state of default CPU generator as a uint8 tensor
+tensor at_get_rng_state();
+void at_set_rng_state(tensor);
 tensor at_new_tensor();
 tensor at_tensor_of_blob(void *data, int64_t *dims, size_t ndims,
                          int64_t *strides, size_t nstrides, int type,
                          int device);
+// like at_tensor_of_blob, `deleter(ctx)` is called when data is no longer
+// used by the tensor or if creating the tensor fails. Strides are contiguous
+// if nstrides is 0.
+tensor at_tensor_of_blob_with_deleter(void *data, int64_t *dims, size_t ndims,
+                                      int64_t *strides, size_t nstrides,
+                                      int type, int device, void *ctx,
+                                      void (*deleter)(void *));
 tensor at_tensor_of_data(void *vs, int64_t *dims, size_t ndims,
                          size_t element_size_in_bytes, int type);
 void at_copy_data(tensor tensor, void *vs, size_t numel,
@@ -41,6 +51,7 @@ void *at_data_ptr(tensor);
 int at_defined(tensor);
 int at_is_mkldnn(tensor);
 int at_is_sparse(tensor);
+int at_is_contiguous(tensor);
 int at_device(tensor);
 size_t at_dim(tensor);
 void at_shape(tensor, int64_t *);
@@ -55,8 +66,35 @@ int at_autocast_increment_nesting();
 bool at_autocast_is_enabled();
 bool at_autocast_set_enabled(bool b);
 
+// sets whether backward fails when a node returns nan or inf gradients.
+void at_set_anomaly_enabled(int b);
+int at_anomaly_enabled();
 void at_backward(tensor, int, int);
 int at_requires_grad(tensor);
+// registers a hook called with gradient of tensor in backward pass.
+// `f(data, grad, &out)` returns an error message (freed by callee) or NULL
+// and sets `out` to replace the gradient. `free_data(data)` is called when
+// the hook is destroyed. It returns position of the hook.
+int at_register_hook(tensor, void *data, char *(*f)(void *, tensor, tensor *),
+                     void (*free_data)(void *));
+void at_remove_hook(tensor, int pos);
+// custom autograd function implemented by callbacks. A callback is called
+// with `data`, a context and input tensors (gradients of outputs in backward,
+// NULL for undefined gradients). It returns an error message (freed by callee)
+// or NULL and sets `outputs` to an array allocated with malloc (freed by
+// callee) where NULL is an undefined tensor. `free_data(data)` is called when
+// the function is destroyed.
+typedef char *(*function_cb)(void *data, void *ctx, tensor *tensors,
+                             int ntensors, tensor **outputs, int *noutputs);
+// returns outputs in an array allocated with malloc.
+tensor *at_function_apply(char *name, void *data, tensor *inputs, int ninputs,
+                          function_cb forward, function_cb backward,
+                          void (*free_data)(void *), int *noutputs);
+// saves tensors for backward. Only valid in forward callback.
+void at_function_ctx_save(void *ctx, tensor *tensors, int ntensors);
+// returns saved tensors in an array allocated with malloc. Only valid in
+// backward callback.
+tensor *at_function_ctx_saved(void *ctx, int *ntensors);
 int at_grad_set_enabled(int);
 
 tensor at_get(tensor, int index);
@@ -3,7 +3,7 @@ package nn
 // A batch-normalization layer.
 
 import (
-	"log"
+	"fmt"
 
 	ts "github.com/sugarme/gotch/tensor"
 )
@@ -41,10 +41,10 @@ type BatchNorm struct {
 func NewBatchNorm(vs *Path, nd uint, outDim int64, config *BatchNormConfig) *BatchNorm {
 	return &BatchNorm{
 		config:      config,
-		RunningMean: vs.ZerosNoTrain("running_mean", []int64{outDim}),
-		RunningVar:  vs.OnesNoTrain("running_var", []int64{outDim}),
-		Ws:          vs.NewVar("weight", []int64{outDim}, config.WsInit),
-		Bs:          vs.NewVar("bias", []int64{outDim}, config.BsInit),
+		RunningMean: vs.MustZerosNoTrain("running_mean", []int64{outDim}),
+		RunningVar:  vs.MustOnesNoTrain("running_var", []int64{outDim}),
+		Ws:          vs.MustNewVar("weight", []int64{outDim}, config.WsInit),
+		Bs:          vs.MustNewVar("bias", []int64{outDim}, config.BsInit),
 	}
 }
 
@@ -75,18 +75,35 @@ func BatchNorm3D(vs *Path, outDim int64, config *BatchNormConfig) *BatchNorm {
 // Implement ModuleT interface for BatchNorm:
 // ==========================================
 
-func (bn *BatchNorm) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
-
-	dim := xs.Dim()
+// Normalize applies batch normalization to xs. It returns an error if xs
+// does not have the number of dimensions expected by the layer.
+func (bn *BatchNorm) Normalize(xs *ts.Tensor, train bool) (*ts.Tensor, error) {
+	dim, err := xs.DimE()
+	if err != nil {
+		return nil, err
+	}
 
 	if bn.Nd == 1 && dim != 2 && dim != 3 {
-		log.Fatalf("Expected an input tensor with 2 or 3 dims, got %v\n", xs.MustSize())
+		err := fmt.Errorf("Expected an input tensor with 2 or 3 dims, got %v\n", xs.MustSize())
+		return nil, err
 	}
 
 	if bn.Nd > 1 && int(dim) != int(bn.Nd)+2 {
-		log.Fatalf("Expected an input tensor with %v dims, got %v\n", bn.Nd+2, xs.MustSize())
+		err := fmt.Errorf("Expected an input tensor with %v dims, got %v\n", bn.Nd+2, xs.MustSize())
+		return nil, err
 	}
 
-	return ts.MustBatchNorm(xs, bn.Ws, bn.Bs, bn.RunningMean, bn.RunningVar, train, bn.config.Momentum, bn.config.Eps, bn.config.CudnnEnable)
+	return ts.BatchNorm(xs, bn.Ws, bn.Bs, bn.RunningMean, bn.RunningVar, train, bn.config.Momentum, bn.config.Eps, bn.config.CudnnEnable)
+}
+
+// ForwardT implements ModuleT interface. As ModuleT cannot return an error,
+// it panics if xs has wrong number of dimensions; use Normalize to get the
+// error instead.
+func (bn *BatchNorm) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	retVal, err := bn.Normalize(xs, train)
+	if err != nil {
+		panic(err)
+	}
 
+	return retVal
 }
@@ -3,6 +3,7 @@ package nn
 // A two dimension transposed convolution layer.
 
 import (
+	"fmt"
 	"log"
 
 	ts "github.com/sugarme/gotch/tensor"
@@ -61,29 +62,47 @@ type ConvTranspose1D struct {
 	Config *ConvTranspose1DConfig
 }
 
-func NewConvTranspose1D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *ConvTranspose1DConfig) *ConvTranspose1D {
+func NewConvTranspose1D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *ConvTranspose1DConfig) (*ConvTranspose1D, error) {
 	if len(ksizes) != 1 {
-		log.Fatalf("NewConvTranspose1D method call: Kernel size should be 1. Got %v\n", len(ksizes))
+		err := fmt.Errorf("NewConvTranspose1D method call: Kernel size should be 1. Got %v\n", len(ksizes))
+		return nil, err
 	}
 
 	var (
-		ws *ts.Tensor
-		bs *ts.Tensor = ts.NewTensor()
+		ws  *ts.Tensor
+		bs  *ts.Tensor = ts.Untrack(ts.NewTensor())
+		err error
 	)
 
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, ksizes...)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws, err = vs.NewVar("weight", weightSize, cfg.WsInit)
+	if err != nil {
+		return nil, err
+	}
 
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs, err = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &ConvTranspose1D{
 		Ws:     ws,
 		Bs:     bs,
 		Config: cfg,
+	}, nil
+}
+
+// MustNewConvTranspose1D creates a new ConvTranspose1D. It panics if error occurred.
+func MustNewConvTranspose1D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *ConvTranspose1DConfig) *ConvTranspose1D {
+	c, err := NewConvTranspose1D(vs, inDim, outDim, ksizes, cfg)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return c
 }
 
 type ConvTranspose2D struct {
@@ -92,29 +111,46 @@ type ConvTranspose2D struct {
 	Config *ConvTranspose2DConfig
 }
 
-func NewConvTranspose2D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *ConvTranspose2DConfig) *ConvTranspose2D {
-
+func NewConvTranspose2D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *ConvTranspose2DConfig) (*ConvTranspose2D, error) {
 	if len(ksizes) != 2 {
-		log.Fatalf("NewConvTranspose2D method call: Kernel size should be 2. Got %v\n", len(ksizes))
+		err := fmt.Errorf("NewConvTranspose2D method call: Kernel size should be 2. Got %v\n", len(ksizes))
+		return nil, err
 	}
 
 	var (
-		ws *ts.Tensor
-		bs *ts.Tensor = ts.NewTensor()
+		ws  *ts.Tensor
+		bs  *ts.Tensor = ts.Untrack(ts.NewTensor())
+		err error
 	)
 
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs, err = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		if err != nil {
+			return nil, err
+		}
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, ksizes...)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws, err = vs.NewVar("weight", weightSize, cfg.WsInit)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ConvTranspose2D{
 		Ws:     ws,
 		Bs:     bs,
 		Config: cfg,
+	}, nil
+}
+
+// MustNewConvTranspose2D creates a new ConvTranspose2D. It panics if error occurred.
+func MustNewConvTranspose2D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *ConvTranspose2DConfig) *ConvTranspose2D {
+	c, err := NewConvTranspose2D(vs, inDim, outDim, ksizes, cfg)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return c
 }
 
 type ConvTranspose3D struct {
@@ -123,28 +159,46 @@ type ConvTranspose3D struct {
 	Config *ConvTranspose3DConfig
 }
 
-func NewConvTranspose3D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *ConvTranspose3DConfig) *ConvTranspose3D {
+func NewConvTranspose3D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *ConvTranspose3DConfig) (*ConvTranspose3D, error) {
 	if len(ksizes) != 3 {
-		log.Fatalf("NewConvTranspose3D method call: Kernel size should be 3. Got %v\n", len(ksizes))
+		err := fmt.Errorf("NewConvTranspose3D method call: Kernel size should be 3. Got %v\n", len(ksizes))
+		return nil, err
 	}
 
 	var (
-		ws *ts.Tensor
-		bs *ts.Tensor = ts.NewTensor()
+		ws  *ts.Tensor
+		bs  *ts.Tensor = ts.Untrack(ts.NewTensor())
+		err error
 	)
 
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs, err = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		if err != nil {
+			return nil, err
+		}
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, ksizes...)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws, err = vs.NewVar("weight", weightSize, cfg.WsInit)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ConvTranspose3D{
 		Ws:     ws,
 		Bs:     bs,
 		Config: cfg,
+	}, nil
+}
+
+// MustNewConvTranspose3D creates a new ConvTranspose3D. It panics if error occurred.
+func MustNewConvTranspose3D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *ConvTranspose3DConfig) *ConvTranspose3D {
+	c, err := NewConvTranspose3D(vs, inDim, outDim, ksizes, cfg)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return c
 }
 
 // Implement Module for Conv1D, Conv2D, Conv3D:
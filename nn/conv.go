@@ -74,14 +74,14 @@ type Conv1D struct {
 func NewConv1D(vs *Path, inDim, outDim, k int64, cfg *Conv1DConfig) *Conv1D {
 	var (
 		ws *ts.Tensor
-		bs *ts.Tensor = ts.NewTensor()
+		bs *ts.Tensor = ts.Untrack(ts.NewTensor())
 	)
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, k)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 
 	return &Conv1D{
 		Ws:     ws,
@@ -99,14 +99,14 @@ type Conv2D struct {
 func NewConv2D(vs *Path, inDim, outDim int64, k int64, cfg *Conv2DConfig) *Conv2D {
 	var (
 		ws *ts.Tensor
-		bs *ts.Tensor = ts.NewTensor()
+		bs *ts.Tensor = ts.Untrack(ts.NewTensor())
 	)
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, k, k)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 
 	return &Conv2D{
 		Ws:     ws,
@@ -124,14 +124,14 @@ type Conv3D struct {
 func NewConv3D(vs *Path, inDim, outDim, k int64, cfg *Conv3DConfig) *Conv3D {
 	var (
 		ws *ts.Tensor
-		bs *ts.Tensor = ts.NewTensor()
+		bs *ts.Tensor = ts.Untrack(ts.NewTensor())
 	)
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, k, k, k)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 
 	return &Conv3D{
 		Ws:     ws,
@@ -195,18 +195,18 @@ func NewConv(vs *Path, inDim, outDim int64, ksizes []int64, config interface{})
 	configT := reflect.TypeOf(config)
 	var (
 		ws *ts.Tensor
-		bs *ts.Tensor = ts.NewTensor()
+		bs *ts.Tensor = ts.Untrack(ts.NewTensor())
 	)
 
 	switch {
 	case len(ksizes) == 1 && configT.String() == "*nn.Conv1DConfig":
 		cfg := config.(*Conv1DConfig)
 		if cfg.Bias {
-			bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 		}
 		weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 		weightSize = append(weightSize, ksizes...)
-		ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+		ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 		return &Conv1D{
 			Ws:     ws,
 			Bs:     bs,
@@ -215,11 +215,11 @@ func NewConv(vs *Path, inDim, outDim int64, ksizes []int64, config interface{})
 	case len(ksizes) == 2 && configT.String() == "*nn.Conv2DConfig":
 		cfg := config.(*Conv2DConfig)
 		if cfg.Bias {
-			bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 		}
 		weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 		weightSize = append(weightSize, ksizes...)
-		ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+		ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 		return &Conv2D{
 			Ws:     ws,
 			Bs:     bs,
@@ -228,11 +228,11 @@ func NewConv(vs *Path, inDim, outDim int64, ksizes []int64, config interface{})
 	case len(ksizes) == 3 && configT.String() == "*nn.Conv3DConfig":
 		cfg := config.(*Conv3DConfig)
 		if cfg.Bias {
-			bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 		}
 		weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 		weightSize = append(weightSize, ksizes...)
-		ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+		ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 		return &Conv3D{
 			Ws:     ws,
 			Bs:     bs,
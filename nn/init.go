@@ -1,7 +1,7 @@
 package nn
 
 import (
-	"log"
+	"fmt"
 	"math"
 	"math/rand"
 
@@ -11,10 +11,10 @@ import (
 
 type Init interface {
 	// creates a new tensor with specified initiation
-	InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor)
+	InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error)
 
 	// re-initializes (in-place) an existing tensor with the specified initiation
-	Set(tensor *ts.Tensor)
+	Set(tensor *ts.Tensor) error
 }
 
 // constInit:
@@ -28,36 +28,37 @@ func NewConstInit(v float64) constInit {
 	return constInit{v}
 }
 
-func (c constInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
-	var err error
+func (c constInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
 	kind := gotch.Float
 	switch {
 	case c.value == 0.0:
-		retVal = ts.MustZeros(dims, kind, device)
+		retVal, err = ts.Zeros(dims, kind, device)
 	case c.value == 1.0:
-		retVal = ts.MustOnes(dims, kind, device)
+		retVal, err = ts.Ones(dims, kind, device)
 	default:
 		data := make([]float64, ts.FlattenDim(dims))
 		for i := range data {
 			data[i] = c.value
 		}
 		retVal, err = ts.NewTensorFromData(data, dims)
-		if err != nil {
-			log.Fatalf("constInit - InitTensor method call error: %v\n", err)
-		}
+	}
+	if err != nil {
+		err = fmt.Errorf("constInit - InitTensor method call error: %w\n", err)
+		return nil, err
 	}
 
-	return retVal
+	return retVal, nil
 }
 
-func (c constInit) Set(tensor *ts.Tensor) {
-	var err error
+func (c constInit) Set(tensor *ts.Tensor) error {
 	scalarVal := ts.FloatScalar(c.value)
-	if err != nil {
-		log.Fatalf("constInit - Set method call error: %v\n", err)
+	defer scalarVal.MustDrop()
+	if err := tensor.Fill_(scalarVal); err != nil {
+		err = fmt.Errorf("constInit - Set method call error: %w\n", err)
+		return err
 	}
 
-	tensor.Fill_(scalarVal)
+	return nil
 }
 
 // randnInit :
@@ -71,8 +72,7 @@ func NewRandnInit(mean, stdev float64) randnInit {
 	return randnInit{mean, stdev}
 }
 
-func (r randnInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
-	var err error
+func (r randnInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
 	rand.Seed(86)
 
 	data := make([]float32, ts.FlattenDim(dims))
@@ -83,24 +83,24 @@ func (r randnInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Ten
 
 	newTs, err := ts.NewTensorFromData(data, dims)
 	if err != nil {
-		log.Fatalf("randInit - InitTensor method call error: %v\n", err)
+		err = fmt.Errorf("randInit - InitTensor method call error: %w\n", err)
+		return nil, err
 	}
 
-	retVal = newTs.MustTo(device, true)
-
-	return retVal
+	retVal, err = newTs.To(device, true)
+	if err != nil {
+		err = fmt.Errorf("randInit - InitTensor method call error: %w\n", err)
+		return nil, err
+	}
 
+	return retVal, nil
 }
 
-func (r randnInit) Set(tensor *ts.Tensor) {
-	var (
-		randnTs *ts.Tensor
-		err     error
-	)
-
+func (r randnInit) Set(tensor *ts.Tensor) error {
 	dims, err := tensor.Size()
 	if err != nil {
-		log.Fatalf("randInit - Set method call error: %v\n", err)
+		err = fmt.Errorf("randInit - Set method call error: %w\n", err)
+		return err
 	}
 
 	rand.Seed(86)
@@ -108,12 +108,14 @@ func (r randnInit) Set(tensor *ts.Tensor) {
 	for i := range data {
 		data[i] = rand.NormFloat64()*r.mean + r.stdev
 	}
-	randnTs, err = ts.NewTensorFromData(data, dims)
+	randnTs, err := ts.NewTensorFromData(data, dims)
 	if err != nil {
-		log.Fatalf("randInit - InitTensor method call error: %v\n", err)
+		err = fmt.Errorf("randInit - Set method call error: %w\n", err)
+		return err
 	}
+	defer randnTs.MustDrop()
 
-	tensor.Copy_(randnTs)
+	return tensor.Copy_(randnTs)
 }
 
 // uniformInit :
@@ -128,19 +130,24 @@ func NewUniformInit(lo, up float64) uniformInit {
 	return uniformInit{lo, up}
 }
 
-func (u uniformInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
-	var err error
+func (u uniformInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
 	kind := gotch.Float
-	retVal = ts.MustZeros(dims, kind, device)
-	retVal.Uniform_(u.lo, u.up)
+	retVal, err = ts.Zeros(dims, kind, device)
 	if err != nil {
-		log.Fatalf("uniformInit - InitTensor method call error: %v\n", err)
+		err = fmt.Errorf("uniformInit - InitTensor method call error: %w\n", err)
+		return nil, err
 	}
-	return retVal
+	if err = retVal.Uniform_(u.lo, u.up); err != nil {
+		retVal.MustDrop()
+		err = fmt.Errorf("uniformInit - InitTensor method call error: %w\n", err)
+		return nil, err
+	}
+
+	return retVal, nil
 }
 
-func (u uniformInit) Set(tensor *ts.Tensor) {
-	tensor.Uniform_(u.lo, u.up)
+func (u uniformInit) Set(tensor *ts.Tensor) error {
+	return tensor.Uniform_(u.lo, u.up)
 }
 
 // kaiminguniformInit :
@@ -152,22 +159,37 @@ func NewKaimingUniformInit() kaimingUniformInit {
 	return kaimingUniformInit{}
 }
 
-func (k kaimingUniformInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
-	var fanIn int64
-	if len(dims) == 0 {
-		log.Fatalf("KaimingUniformInit method call: dims (%v) should have length >= 1", dims)
-	} else if len(dims) == 1 {
-		fanIn = factorial(dims[0])
-	} else {
-		fanIn = product(dims[1:])
+// fanIn returns fan-in of a weight of shape dims.
+func (k kaimingUniformInit) fanIn(dims []int64) (int64, error) {
+	switch len(dims) {
+	case 0:
+		err := fmt.Errorf("KaimingUniformInit method call: dims (%v) should have length >= 1\n", dims)
+		return 0, err
+	case 1:
+		return factorial(dims[0]), nil
+	default:
+		return product(dims[1:]), nil
+	}
+}
+
+func (k kaimingUniformInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	fanIn, err := k.fanIn(dims)
+	if err != nil {
+		return nil, err
 	}
 
 	bound := math.Sqrt(1.0 / float64(fanIn))
 	kind := gotch.Float
-	retVal = ts.MustZeros(dims, kind, device)
-	retVal.Uniform_(-bound, bound)
+	retVal, err = ts.Zeros(dims, kind, device)
+	if err != nil {
+		return nil, err
+	}
+	if err = retVal.Uniform_(-bound, bound); err != nil {
+		retVal.MustDrop()
+		return nil, err
+	}
 
-	return retVal
+	return retVal, nil
 }
 
 // product calculates product by multiplying elements
@@ -191,23 +213,20 @@ func factorial(n int64) (result int64) {
 	return 1
 }
 
-func (k kaimingUniformInit) Set(tensor *ts.Tensor) {
+func (k kaimingUniformInit) Set(tensor *ts.Tensor) error {
 	dims, err := tensor.Size()
 	if err != nil {
-		log.Fatalf("uniformInit - Set method call error: %v\n", err)
+		err = fmt.Errorf("uniformInit - Set method call error: %w\n", err)
+		return err
 	}
 
-	var fanIn int64
-	if len(dims) == 0 {
-		log.Fatalf("KaimingUniformInit Set method call: Tensor (%v) should have length >= 1", tensor.MustSize())
-	} else if len(dims) == 1 {
-		fanIn = factorial(dims[0])
-	} else {
-		fanIn = product(dims[1:])
+	fanIn, err := k.fanIn(dims)
+	if err != nil {
+		return err
 	}
 
 	bound := math.Sqrt(1.0 / float64(fanIn))
-	tensor.Uniform_(-bound, bound)
+	return tensor.Uniform_(-bound, bound)
 }
 
 // glorotInit :
@@ -218,12 +237,14 @@ func NewGlorotNInit() glorotNInit {
 	return glorotNInit{}
 }
 
-func (gl glorotNInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
+func (gl glorotNInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
 	// TODO: implement
 
 	return
 }
 
-func (gl glorotNInit) Set(tensor *ts.Tensor) {
+func (gl glorotNInit) Set(tensor *ts.Tensor) error {
 	// TODO: implement
+
+	return nil
 }
@@ -2,7 +2,6 @@ package nn
 
 import (
 	"io"
-	"log"
 	"strings"
 
 	ts "github.com/sugarme/gotch/tensor"
@@ -36,7 +35,10 @@ func TrainableCModuleLoad(p *Path, file string) (*TrainableCModule, error) {
 		// NOTE: return is a newly created and added tensor in varstore.
 		// This tensor is different from input named tensor.
 		// If not using, just ignore it. Drop it, will drop tensor at varstore.
-		_ = p.Add(name, namedTensor.Tensor, requiresGrad)
+		_, err = p.Add(name, namedTensor.Tensor, requiresGrad)
+		if err != nil {
+			return nil, err
+		}
 
 		// Clean-up named tensors.
 		namedTensor.Tensor.MustDrop()
@@ -62,7 +64,10 @@ func TrainableCModuleLoadData(p *Path, stream io.Reader) (*TrainableCModule, err
 		// NOTE: return is a newly created and added tensor in varstore.
 		// This tensor is different from input named tensor.
 		// If not using, just ignore it. Drop it, will drop tensor at varstore.
-		_ = p.Add(name, namedTensor.Tensor, requiresGrad)
+		_, err = p.Add(name, namedTensor.Tensor, requiresGrad)
+		if err != nil {
+			return nil, err
+		}
 
 		// Clean-up named tensors.
 		namedTensor.Tensor.MustDrop()
@@ -81,18 +86,18 @@ func (m *TrainableCModule) Save(file string) error {
 func (m *TrainableCModule) ForwardT(x *ts.Tensor, train bool) *ts.Tensor {
 	retVal, err := m.Inner.ForwardTs([]ts.Tensor{*x})
 	if err != nil {
-		log.Fatal(err)
+		panic(err)
 	}
 
 	return retVal
 }
 
 // SetTrain set TrainableCModule to train mode
-func (m *TrainableCModule) SetTrain() {
-	m.Inner.SetTrain()
+func (m *TrainableCModule) SetTrain() error {
+	return m.Inner.SetTrain()
 }
 
 // SetEval set TrainableCModule to inference mode
-func (m *TrainableCModule) SetEval() {
-	m.Inner.SetEval()
+func (m *TrainableCModule) SetEval() error {
+	return m.Inner.SetEval()
 }
@@ -43,20 +43,20 @@ func NewLinear(vs *Path, inDim, outDim int64, c *LinearConfig) *Linear {
 	// bs has size of output dimension
 	switch c.Bias {
 	case false:
-		bs = ts.MustZeros([]int64{outDim}, gotch.Float, vs.Device())
+		bs = ts.Untrack(ts.MustZeros([]int64{outDim}, gotch.Float, vs.Device()))
 	case true:
 		switch {
 		case c.BsInit == nil:
 			bound := 1.0 / math.Sqrt(float64(inDim))
 			bsInit := NewUniformInit(-bound, bound)
-			bs = vs.NewVar("bias", []int64{outDim}, bsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, bsInit)
 		case c.BsInit != nil:
-			bs = vs.NewVar("bias", []int64{outDim}, c.BsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, c.BsInit)
 		}
 	}
 
 	return &Linear{
-		Ws: vs.NewVar("weight", []int64{outDim, inDim}, c.WsInit).MustT(false),
+		Ws: ts.Untrack(vs.MustNewVar("weight", []int64{outDim, inDim}, c.WsInit).MustT(false)),
 		Bs: bs,
 	}
 }
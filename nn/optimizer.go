@@ -9,9 +9,23 @@ import (
 	ts "github.com/sugarme/gotch/tensor"
 )
 
+// optimizer is the optimizer run by Optimizer. It is implemented by
+// `ts.COptimizer` (libtorch optimizers) and optimizers written in Go.
+type optimizer interface {
+	AddParameter(param *ts.Tensor, group uint) error
+	SetLearningRate(lr float64) error
+	GetLearningRates() ([]float64, error)
+	SetLearningRates(lrs []float64) error
+	ParamGroupNum() (int64, error)
+	AddParamGroup(tensors []ts.Tensor) error
+	SetMomentum(m float64) error
+	ZeroGrad() error
+	Step() error
+}
+
 // Optimizer is a struct object to run gradient descent.
 type Optimizer struct {
-	opt *ts.COptimizer
+	opt optimizer
 	// variables            Variables // having embedded sync.Mutex
 	variablesInOptimizer uint8
 	config               interface{}
@@ -20,7 +34,7 @@ type Optimizer struct {
 
 // OptimizerConfig defines Optimizer configurations. These configs can be used to build optimizer.
 type OptimizerConfig interface {
-	buildCOpt(lr float64) (*ts.COptimizer, error)
+	buildOpt(lr float64) (optimizer, error)
 
 	// Build builds an optimizer with the specified learning rate handling variables stored in `vs`.
 	//
@@ -37,7 +51,7 @@ type OptimizerConfig interface {
 // defaultBuild is `default` Build method for OptimizerConfig interface
 func defaultBuild(config OptimizerConfig, vs *VarStore, lr float64) (retVal *Optimizer, err error) {
 
-	opt, err := config.buildCOpt(lr)
+	opt, err := config.buildOpt(lr)
 	if err != nil {
 		return retVal, err
 	}
@@ -92,8 +106,13 @@ func NewSGDConfig(momentum, dampening, wd float64, nesterov bool) *SGDConfig {
 }
 
 // Implement OptimizerConfig interface for SGDConfig
-func (c *SGDConfig) buildCOpt(lr float64) (*ts.COptimizer, error) {
-	return ts.Sgd(lr, c.Momentum, c.Dampening, c.Wd, c.Nesterov)
+func (c *SGDConfig) buildOpt(lr float64) (optimizer, error) {
+	opt, err := ts.Sgd(lr, c.Momentum, c.Dampening, c.Wd, c.Nesterov)
+	if err != nil {
+		return nil, err
+	}
+
+	return opt, nil
 }
 
 func (c *SGDConfig) Build(vs *VarStore, lr float64) (*Optimizer, error) {
@@ -128,8 +147,13 @@ func NewAdamConfig(beta1, beta2, wd float64) *AdamConfig {
 }
 
 // Implement OptimizerConfig interface for AdamConfig
-func (c *AdamConfig) buildCOpt(lr float64) (*ts.COptimizer, error) {
-	return ts.Adam(lr, c.Beta1, c.Beta2, c.Wd)
+func (c *AdamConfig) buildOpt(lr float64) (optimizer, error) {
+	opt, err := ts.Adam(lr, c.Beta1, c.Beta2, c.Wd)
+	if err != nil {
+		return nil, err
+	}
+
+	return opt, nil
 }
 
 func (c *AdamConfig) Build(vs *VarStore, lr float64) (*Optimizer, error) {
@@ -164,8 +188,13 @@ func NewAdamWConfig(beta1, beta2, wd float64) *AdamWConfig {
 }
 
 // Implement OptimizerConfig interface for AdamConfig
-func (c *AdamWConfig) buildCOpt(lr float64) (*ts.COptimizer, error) {
-	return ts.AdamW(lr, c.Beta1, c.Beta2, c.Wd)
+func (c *AdamWConfig) buildOpt(lr float64) (optimizer, error) {
+	opt, err := ts.AdamW(lr, c.Beta1, c.Beta2, c.Wd)
+	if err != nil {
+		return nil, err
+	}
+
+	return opt, nil
 }
 
 func (c *AdamWConfig) Build(vs *VarStore, lr float64) (*Optimizer, error) {
@@ -206,14 +235,62 @@ func NewRMSPropConfig(alpha, eps, wd, momentum float64, centered bool) *RMSPropC
 }
 
 // Implement OptimizerConfig interface for RMSPropConfig
-func (c *RMSPropConfig) buildCOpt(lr float64) (*ts.COptimizer, error) {
-	return ts.RmsProp(lr, c.Alpha, c.Eps, c.Wd, c.Momentum, c.Centered)
+func (c *RMSPropConfig) buildOpt(lr float64) (optimizer, error) {
+	opt, err := ts.RmsProp(lr, c.Alpha, c.Eps, c.Wd, c.Momentum, c.Centered)
+	if err != nil {
+		return nil, err
+	}
+
+	return opt, nil
 }
 
 func (c *RMSPropConfig) Build(vs *VarStore, lr float64) (*Optimizer, error) {
 	return defaultBuild(c, vs, lr)
 }
 
+// SparseAdam optimizer:
+// =====================
+
+// SparseAdamConfig holds parameters for building the SparseAdam optimizer, an
+// Adam optimizer which supports sparse gradients, e.g. of `Embedding` with
+// `Sparse` config, besides dense ones.
+//
+// For a sparse gradient, only embeddings looked up in the batch (and their
+// moments) are updated. It makes training embeddings of huge vocabularies
+// practical on CPU.
+type SparseAdamConfig struct {
+	Beta1 float64
+	Beta2 float64
+	Eps   float64
+}
+
+// DefaultSparseAdamConfig creates SparseAdamConfig with default values.
+func DefaultSparseAdamConfig() *SparseAdamConfig {
+	return &SparseAdamConfig{
+		Beta1: 0.9,
+		Beta2: 0.999,
+		Eps:   1e-8,
+	}
+}
+
+// NewSparseAdamConfig creates SparseAdamConfig with specified values.
+func NewSparseAdamConfig(beta1, beta2, eps float64) *SparseAdamConfig {
+	return &SparseAdamConfig{
+		Beta1: beta1,
+		Beta2: beta2,
+		Eps:   eps,
+	}
+}
+
+// Implement OptimizerConfig interface for SparseAdamConfig
+func (c *SparseAdamConfig) buildOpt(lr float64) (optimizer, error) {
+	return newSparseAdam(lr, c.Beta1, c.Beta2, c.Eps), nil
+}
+
+func (c *SparseAdamConfig) Build(vs *VarStore, lr float64) (*Optimizer, error) {
+	return defaultBuild(c, vs, lr)
+}
+
 // Optimizer methods:
 // ==================
 func (opt *Optimizer) addMissingVariables() {
@@ -235,11 +312,14 @@ func (opt *Optimizer) addMissingVariables() {
 }
 
 // ZeroGrad zeroes the gradient for the tensors tracked by this optimizer.
-func (opt *Optimizer) ZeroGrad() {
+func (opt *Optimizer) ZeroGrad() error {
 	opt.addMissingVariables()
 	if err := opt.opt.ZeroGrad(); err != nil {
-		log.Fatalf("Optimizer - ZeroGrad method call error: %v\n", err)
+		err = fmt.Errorf("Optimizer - ZeroGrad method call error: %w\n", err)
+		return err
 	}
+
+	return nil
 }
 
 // Clips gradient value at some specified maximum value.
@@ -254,13 +334,16 @@ func (opt *Optimizer) ClipGradValue(max float64) {
 }
 
 // Step performs an optimization step, updating the tracked tensors based on their gradients.
-func (opt *Optimizer) Step() {
+func (opt *Optimizer) Step() error {
 	opt.addMissingVariables()
 	err := opt.opt.Step()
 	if err != nil {
-		log.Fatalf("Optimizer - Step method call error: %v\n", err)
+		err = fmt.Errorf("Optimizer - Step method call error: %w\n", err)
+		return err
 	}
 	opt.stepCount += 1
+
+	return nil
 }
 
 // ResetStepCount set step count to zero.
@@ -274,101 +357,158 @@ func (opt *Optimizer) StepCount() int {
 }
 
 // BackwardStep applies a backward step pass, update the gradients, and performs an optimization step.
-func (opt *Optimizer) BackwardStep(loss *ts.Tensor) {
+//
+// If anomaly detection is enabled (see `ts.AnomalyModeSetEnabled`), it fails
+// without updating variables when a gradient becomes NaN or Inf.
+func (opt *Optimizer) BackwardStep(loss *ts.Tensor) error {
 	opt.addMissingVariables()
 	err := opt.opt.ZeroGrad()
 	if err != nil {
-		log.Fatalf("Optimizer - BackwardStep method call - ZeroGrad error: %v\n", err)
+		err = fmt.Errorf("Optimizer - BackwardStep method call - ZeroGrad error: %w\n", err)
+		return err
+	}
+	if err = loss.Backward(); err != nil {
+		err = fmt.Errorf("Optimizer - BackwardStep method call - Backward error: %w\n", err)
+		return err
 	}
-	loss.MustBackward()
 	err = opt.opt.Step()
 	if err != nil {
-		log.Fatalf("Optimizer - BackwardStep  method call - Step() error: %v\n", err)
+		err = fmt.Errorf("Optimizer - BackwardStep  method call - Step() error: %w\n", err)
+		return err
 	}
+
+	return nil
 }
 
 // BackwardStepClip applies a backward step pass, update the gradients, and performs an optimization step.
 //
-// The gradients are clipped based on `max` before being applied.
-func (opt *Optimizer) BackwardStepClip(loss *ts.Tensor, max float64) {
+// The gradients are clipped based on `max` before being applied. Like
+// `BackwardStep`, it fails on NaN or Inf gradients if anomaly detection is
+// enabled.
+func (opt *Optimizer) BackwardStepClip(loss *ts.Tensor, max float64) error {
 	opt.addMissingVariables()
 	err := opt.opt.ZeroGrad()
 	if err != nil {
-		log.Fatalf("Optimizer - BackwardStepClip method call - ZeroGrad error: %v\n", err)
+		err = fmt.Errorf("Optimizer - BackwardStepClip method call - ZeroGrad error: %w\n", err)
+		return err
+	}
+	if err = loss.Backward(); err != nil {
+		err = fmt.Errorf("Optimizer - BackwardStepClip method call - Backward error: %w\n", err)
+		return err
 	}
-	loss.MustBackward()
 	opt.ClipGradValue(max)
 	err = opt.opt.Step()
 	if err != nil {
-		log.Fatalf("Optimizer - BackwardStepClip  method call - Step() error: %v\n", err)
+		err = fmt.Errorf("Optimizer - BackwardStepClip  method call - Step() error: %w\n", err)
+		return err
 	}
+
+	return nil
 }
 
 /// TODO. Clips gradient L2 norm over all trainable parameters.
 //
 // The norm is computed over all gradients together, as if they were
 // concatenated into a single vector.
-func (opt *Optimizer) ClipGradNorm(max float64) {
+func (opt *Optimizer) ClipGradNorm(max float64) error {
 	// TODO.
-	log.Fatalf("Not implemented yet!")
+	err := fmt.Errorf("Optimizer - ClipGradNorm method call error: not implemented yet.\n")
+	return err
 }
 
 // TODO. Applies a backward step pass, update the gradients, and performs an optimization step.
 //
 // The gradients L2 norm is clipped based on `max`.
-func (opt *Optimizer) BackwardStepClipNorm(loss *ts.Tensor, max float64) {
+func (opt *Optimizer) BackwardStepClipNorm(loss *ts.Tensor, max float64) error {
 	// TODO.
-	log.Fatalf("Not implemented yet!")
+	err := fmt.Errorf("Optimizer - BackwardStepClipNorm method call error: not implemented yet.\n")
+	return err
 }
 
 // SetLR sets the optimizer learning rate.
 //
 // NOTE. it sets a SINGLE value of learning rate for all parameter groups.
 // Most of the time, there's one parameter group.
-func (opt *Optimizer) SetLR(lr float64) {
+func (opt *Optimizer) SetLR(lr float64) error {
 	err := opt.opt.SetLearningRate(lr)
 	if err != nil {
-		log.Fatalf("Optimizer - SetLR  method call error: %v\n", err)
+		err = fmt.Errorf("Optimizer - SetLR  method call error: %w\n", err)
+		return err
 	}
+
+	return nil
 }
 
-func (opt *Optimizer) GetLRs() []float64 {
+// GetLRs returns learning rates of ALL parameter groups.
+func (opt *Optimizer) GetLRs() ([]float64, error) {
 	lrs, err := opt.opt.GetLearningRates()
 	if err != nil {
-		log.Fatalf("Optimizer - GetLRs  method call error: %v\n", err)
+		err = fmt.Errorf("Optimizer - GetLRs  method call error: %w\n", err)
+		return nil, err
+	}
+
+	return lrs, nil
+}
+
+// MustGetLRs returns learning rates of ALL parameter groups. It panics if error occurred.
+func (opt *Optimizer) MustGetLRs() []float64 {
+	lrs, err := opt.GetLRs()
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	return lrs
 }
 
 // SetLRs sets learning rates for ALL parameter groups respectively.
-func (opt *Optimizer) SetLRs(lrs []float64) {
+func (opt *Optimizer) SetLRs(lrs []float64) error {
 	err := opt.opt.SetLearningRates(lrs)
 	if err != nil {
-		log.Fatalf("Optimizer - SetLRs  method call error: %v\n", err)
+		err = fmt.Errorf("Optimizer - SetLRs  method call error: %w\n", err)
+		return err
 	}
+
+	return nil
 }
 
 // SetMomentum sets the optimizer momentum.
-func (opt *Optimizer) SetMomentum(m float64) {
+func (opt *Optimizer) SetMomentum(m float64) error {
 	err := opt.opt.SetMomentum(m)
 	if err != nil {
-		log.Fatalf("Optimizer - SetMomentum  method call error: %v\n", err)
+		err = fmt.Errorf("Optimizer - SetMomentum  method call error: %w\n", err)
+		return err
 	}
+
+	return nil
 }
 
-func (opt *Optimizer) ParamGroupNum() int {
+// ParamGroupNum returns number of parameter groups.
+func (opt *Optimizer) ParamGroupNum() (int, error) {
 	ngroup, err := opt.opt.ParamGroupNum()
 	if err != nil {
-		log.Fatalf("Optimizer - ParamGroupNum  method call error: %v\n", err)
+		err = fmt.Errorf("Optimizer - ParamGroupNum  method call error: %w\n", err)
+		return 0, err
 	}
 
-	return int(ngroup)
+	return int(ngroup), nil
 }
 
-func (opt *Optimizer) AddParamGroup(tensors []ts.Tensor) {
+// MustParamGroupNum returns number of parameter groups. It panics if error occurred.
+func (opt *Optimizer) MustParamGroupNum() int {
+	ngroup, err := opt.ParamGroupNum()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return ngroup
+}
+
+func (opt *Optimizer) AddParamGroup(tensors []ts.Tensor) error {
 	err := opt.opt.AddParamGroup(tensors)
 	if err != nil {
-		log.Fatalf("Optimizer - ParamGroupNum  method call error: %v\n", err)
+		err = fmt.Errorf("Optimizer - AddParamGroup  method call error: %w\n", err)
+		return err
 	}
+
+	return nil
 }
@@ -95,10 +95,10 @@ func NewLSTM(vs *Path, inDim, hiddenDim int64, cfg *RNNConfig) *LSTM {
 				inDim = hiddenDim * numDirections
 			}
 
-			wIh := vs.KaimingUniform("w_ih", []int64{gateDim, inDim})
-			wHh := vs.KaimingUniform("w_hh", []int64{gateDim, hiddenDim})
-			bIh := vs.Zeros("b_ih", []int64{gateDim})
-			bHh := vs.Zeros("b_hh", []int64{gateDim})
+			wIh := vs.MustKaimingUniform("w_ih", []int64{gateDim, inDim})
+			wHh := vs.MustKaimingUniform("w_hh", []int64{gateDim, hiddenDim})
+			bIh := vs.MustZeros("b_ih", []int64{gateDim})
+			bHh := vs.MustZeros("b_hh", []int64{gateDim})
 
 			flatWeights = append(flatWeights, *wIh, *wHh, *bIh, *bHh)
 		}
@@ -217,10 +217,10 @@ func NewGRU(vs *Path, inDim, hiddenDim int64, cfg *RNNConfig) (retVal *GRU) {
 				inputDim = hiddenDim * numDirections
 			}
 
-			wIh := vs.KaimingUniform("w_ih", []int64{gateDim, inputDim})
-			wHh := vs.KaimingUniform("w_hh", []int64{gateDim, hiddenDim})
-			bIh := vs.Zeros("b_ih", []int64{gateDim})
-			bHh := vs.Zeros("b_hh", []int64{gateDim})
+			wIh := vs.MustKaimingUniform("w_ih", []int64{gateDim, inputDim})
+			wHh := vs.MustKaimingUniform("w_hh", []int64{gateDim, hiddenDim})
+			bIh := vs.MustZeros("b_ih", []int64{gateDim})
+			bHh := vs.MustZeros("b_hh", []int64{gateDim})
 
 			flatWeights = append(flatWeights, *wIh, *wHh, *bIh, *bHh)
 		}
@@ -35,8 +35,8 @@ func WithLoss(loss float64) SchedulerOption {
 }
 
 type scheduler interface {
-	SetLRs(opts ...SchedulerOption)
-	Build() *LRScheduler
+	SetLRs(opts ...SchedulerOption) error
+	Build() (*LRScheduler, error)
 }
 
 // LRScheduler is a scheduler to update optimizer learning rates.
@@ -45,8 +45,15 @@ type LRScheduler struct {
 }
 
 // Step updates optimizer learning rate.
-func (s *LRScheduler) Step(opts ...SchedulerOption) {
-	s.scheduler.SetLRs(opts...)
+func (s *LRScheduler) Step(opts ...SchedulerOption) error {
+	return s.scheduler.SetLRs(opts...)
+}
+
+// MustStep updates optimizer learning rate. It panics if error occurred.
+func (s *LRScheduler) MustStep(opts ...SchedulerOption) {
+	if err := s.Step(opts...); err != nil {
+		log.Fatal(err)
+	}
 }
 
 type LambdaFn func(in interface{}) float64
@@ -62,9 +69,15 @@ type LambdaLR struct {
 }
 
 // NewLambdaLRS creates a new LambdaLRS.
-func NewLambdaLR(opt *Optimizer, ldFns []LambdaFn) *LambdaLR {
-	ngroup := opt.ParamGroupNum()
-	initialLRs := opt.GetLRs()
+func NewLambdaLR(opt *Optimizer, ldFns []LambdaFn) (*LambdaLR, error) {
+	ngroup, err := opt.ParamGroupNum()
+	if err != nil {
+		return nil, err
+	}
+	initialLRs, err := opt.GetLRs()
+	if err != nil {
+		return nil, err
+	}
 	var funcs []LambdaFn = make([]LambdaFn, ngroup)
 	switch len(ldFns) {
 	case 1:
@@ -75,7 +88,8 @@ func NewLambdaLR(opt *Optimizer, ldFns []LambdaFn) *LambdaLR {
 	case ngroup:
 		funcs = ldFns
 	default:
-		log.Fatalf("Number of lambda functions (%d) is not equal to number of optimizer groups (%d)", len(ldFns), ngroup)
+		err := fmt.Errorf("Number of lambda functions (%d) is not equal to number of optimizer groups (%d)", len(ldFns), ngroup)
+		return nil, err
 	}
 
 	return &LambdaLR{
@@ -84,18 +98,41 @@ func NewLambdaLR(opt *Optimizer, ldFns []LambdaFn) *LambdaLR {
 		initialLRs: initialLRs,
 		stepCount:  0,
 		lastEpoch:  -1,
+	}, nil
+}
+
+// MustNewLambdaLR is like NewLambdaLR but panics if error occurred.
+func MustNewLambdaLR(opt *Optimizer, ldFns []LambdaFn) *LambdaLR {
+	s, err := NewLambdaLR(opt, ldFns)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return s
 }
 
 // Build implements scheduler interface.
-func (l *LambdaLR) Build() *LRScheduler {
+func (l *LambdaLR) Build() (*LRScheduler, error) {
 	s := &LRScheduler{l}
-	s.Step()
+	if err := s.Step(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (l *LambdaLR) MustBuild() *LRScheduler {
+	s, err := l.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return s
 }
 
 // SetLRs implements scheduler interface.
-func (l *LambdaLR) SetLRs(opts ...SchedulerOption) {
+func (l *LambdaLR) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -119,8 +156,12 @@ func (l *LambdaLR) SetLRs(opts ...SchedulerOption) {
 		}
 	}
 
-	l.opt.SetLRs(newLRs)
+	if err := l.opt.SetLRs(newLRs); err != nil {
+		return err
+	}
 	l.stepCount += 1
+
+	return nil
 }
 
 // MultiplicativeLR calculates new learning rates for each optimizer para groups
@@ -134,9 +175,15 @@ type MultiplicativeLR struct {
 }
 
 // NewMultiplicativeLR creates a new MultiplicativeLR.
-func NewMultiplicativeLR(opt *Optimizer, ldFns []LambdaFn) *MultiplicativeLR {
-	ngroup := opt.ParamGroupNum()
-	initialLRs := opt.GetLRs()
+func NewMultiplicativeLR(opt *Optimizer, ldFns []LambdaFn) (*MultiplicativeLR, error) {
+	ngroup, err := opt.ParamGroupNum()
+	if err != nil {
+		return nil, err
+	}
+	initialLRs, err := opt.GetLRs()
+	if err != nil {
+		return nil, err
+	}
 
 	var funcs []LambdaFn = make([]LambdaFn, ngroup)
 	switch len(ldFns) {
@@ -148,7 +195,8 @@ func NewMultiplicativeLR(opt *Optimizer, ldFns []LambdaFn) *MultiplicativeLR {
 	case ngroup:
 		funcs = ldFns
 	default:
-		log.Fatalf("Number of lambda functions (%d) is not equal to number of optimizer groups (%d)", len(ldFns), ngroup)
+		err := fmt.Errorf("Number of lambda functions (%d) is not equal to number of optimizer groups (%d)", len(ldFns), ngroup)
+		return nil, err
 	}
 	return &MultiplicativeLR{
 		opt:        opt,
@@ -156,18 +204,41 @@ func NewMultiplicativeLR(opt *Optimizer, ldFns []LambdaFn) *MultiplicativeLR {
 		initialLRs: initialLRs,
 		stepCount:  0,
 		lastEpoch:  -1,
+	}, nil
+}
+
+// MustNewMultiplicativeLR is like NewMultiplicativeLR but panics if error occurred.
+func MustNewMultiplicativeLR(opt *Optimizer, ldFns []LambdaFn) *MultiplicativeLR {
+	s, err := NewMultiplicativeLR(opt, ldFns)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return s
 }
 
 // Build implements scheduler interface.
-func (m *MultiplicativeLR) Build() *LRScheduler {
+func (m *MultiplicativeLR) Build() (*LRScheduler, error) {
 	s := &LRScheduler{m}
-	s.Step()
+	if err := s.Step(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (m *MultiplicativeLR) MustBuild() *LRScheduler {
+	s, err := m.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return s
 }
 
 // SetLRs implements scheduler interface.
-func (m *MultiplicativeLR) SetLRs(opts ...SchedulerOption) {
+func (m *MultiplicativeLR) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -182,7 +253,7 @@ func (m *MultiplicativeLR) SetLRs(opts ...SchedulerOption) {
 	var newLRs []float64
 	lrs, err := m.opt.opt.GetLearningRates()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	switch m.lastEpoch {
@@ -196,7 +267,11 @@ func (m *MultiplicativeLR) SetLRs(opts ...SchedulerOption) {
 		}
 	}
 
-	m.opt.SetLRs(newLRs)
+	if err := m.opt.SetLRs(newLRs); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // StepLR decays the learning rates of each optimizer parameter group by gamma every
@@ -214,8 +289,11 @@ type StepLR struct {
 }
 
 // NewStepLR creates a new StepLR.
-func NewStepLR(opt *Optimizer, stepSize int, gamma float64) *StepLR {
-	initialLRs := opt.GetLRs()
+func NewStepLR(opt *Optimizer, stepSize int, gamma float64) (*StepLR, error) {
+	initialLRs, err := opt.GetLRs()
+	if err != nil {
+		return nil, err
+	}
 	return &StepLR{
 		opt:        opt,
 		stepSize:   stepSize,
@@ -223,18 +301,41 @@ func NewStepLR(opt *Optimizer, stepSize int, gamma float64) *StepLR {
 		initialLRs: initialLRs,
 		stepCount:  0,
 		lastEpoch:  -1,
+	}, nil
+}
+
+// MustNewStepLR is like NewStepLR but panics if error occurred.
+func MustNewStepLR(opt *Optimizer, stepSize int, gamma float64) *StepLR {
+	s, err := NewStepLR(opt, stepSize, gamma)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return s
 }
 
 // Build implements scheduler interface.
-func (s *StepLR) Build() *LRScheduler {
+func (s *StepLR) Build() (*LRScheduler, error) {
 	sc := &LRScheduler{s}
-	sc.Step()
+	if err := sc.Step(); err != nil {
+		return nil, err
+	}
+
+	return sc, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (s *StepLR) MustBuild() *LRScheduler {
+	sc, err := s.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return sc
 }
 
 // SetLRs implements scheduler interface.
-func (s *StepLR) SetLRs(opts ...SchedulerOption) {
+func (s *StepLR) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -249,7 +350,7 @@ func (s *StepLR) SetLRs(opts ...SchedulerOption) {
 	var newLRs []float64
 	lrs, err := s.opt.opt.GetLearningRates()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	switch {
@@ -262,7 +363,11 @@ func (s *StepLR) SetLRs(opts ...SchedulerOption) {
 		}
 	}
 
-	s.opt.SetLRs(newLRs)
+	if err := s.opt.SetLRs(newLRs); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // floatRound rounds float64 value to a specified precision.
@@ -290,8 +395,11 @@ type MultiStepLR struct {
 }
 
 // NewStepLR creates a new StepLR.
-func NewMultiStepLR(opt *Optimizer, milestones []int, gamma float64) *MultiStepLR {
-	initialLRs := opt.GetLRs()
+func NewMultiStepLR(opt *Optimizer, milestones []int, gamma float64) (*MultiStepLR, error) {
+	initialLRs, err := opt.GetLRs()
+	if err != nil {
+		return nil, err
+	}
 	return &MultiStepLR{
 		opt:        opt,
 		milestones: milestones,
@@ -299,18 +407,41 @@ func NewMultiStepLR(opt *Optimizer, milestones []int, gamma float64) *MultiStepL
 		initialLRs: initialLRs,
 		stepCount:  0,
 		lastEpoch:  -1,
+	}, nil
+}
+
+// MustNewMultiStepLR is like NewMultiStepLR but panics if error occurred.
+func MustNewMultiStepLR(opt *Optimizer, milestones []int, gamma float64) *MultiStepLR {
+	s, err := NewMultiStepLR(opt, milestones, gamma)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return s
 }
 
 // Build implements scheduler interface.
-func (ms *MultiStepLR) Build() *LRScheduler {
+func (ms *MultiStepLR) Build() (*LRScheduler, error) {
 	s := &LRScheduler{ms}
-	s.Step()
+	if err := s.Step(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (ms *MultiStepLR) MustBuild() *LRScheduler {
+	s, err := ms.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return s
 }
 
 // SetLRs implements scheduler interface.
-func (ms *MultiStepLR) SetLRs(opts ...SchedulerOption) {
+func (ms *MultiStepLR) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -325,7 +456,7 @@ func (ms *MultiStepLR) SetLRs(opts ...SchedulerOption) {
 	var newLRs []float64
 	lrs, err := ms.opt.opt.GetLearningRates()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	switch {
@@ -338,7 +469,11 @@ func (ms *MultiStepLR) SetLRs(opts ...SchedulerOption) {
 		}
 	}
 
-	ms.opt.SetLRs(newLRs)
+	if err := ms.opt.SetLRs(newLRs); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func contain(item int, list []int) bool {
@@ -362,26 +497,52 @@ type ExponentialLR struct {
 }
 
 // NewExponentialLR creates a new ExponentialLR.
-func NewExponentialLR(opt *Optimizer, gamma float64) *ExponentialLR {
-	initialLRs := opt.GetLRs()
+func NewExponentialLR(opt *Optimizer, gamma float64) (*ExponentialLR, error) {
+	initialLRs, err := opt.GetLRs()
+	if err != nil {
+		return nil, err
+	}
 	return &ExponentialLR{
 		opt:        opt,
 		gamma:      gamma,
 		initialLRs: initialLRs,
 		stepCount:  0,
 		lastEpoch:  -1,
+	}, nil
+}
+
+// MustNewExponentialLR is like NewExponentialLR but panics if error occurred.
+func MustNewExponentialLR(opt *Optimizer, gamma float64) *ExponentialLR {
+	s, err := NewExponentialLR(opt, gamma)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return s
 }
 
 // Build implements scheduler interface.
-func (e *ExponentialLR) Build() *LRScheduler {
+func (e *ExponentialLR) Build() (*LRScheduler, error) {
 	s := &LRScheduler{e}
-	s.Step()
+	if err := s.Step(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (e *ExponentialLR) MustBuild() *LRScheduler {
+	s, err := e.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return s
 }
 
 // SetLRs implements scheduler interface.
-func (e *ExponentialLR) SetLRs(opts ...SchedulerOption) {
+func (e *ExponentialLR) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -396,7 +557,7 @@ func (e *ExponentialLR) SetLRs(opts ...SchedulerOption) {
 	var newLRs []float64
 	lrs, err := e.opt.opt.GetLearningRates()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	switch {
@@ -409,7 +570,11 @@ func (e *ExponentialLR) SetLRs(opts ...SchedulerOption) {
 		}
 	}
 
-	e.opt.SetLRs(newLRs)
+	if err := e.opt.SetLRs(newLRs); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // CosineAnnealingLR set the learning rates of each optimizer parameter group by using
@@ -430,9 +595,12 @@ type CosineAnnealingLR struct {
 }
 
 // NewConsineAnnealingLR creates a new ConsineAnnealingLR.
-func NewCosineAnnealingLR(opt *Optimizer, tmax int, etaMin float64) *CosineAnnealingLR {
+func NewCosineAnnealingLR(opt *Optimizer, tmax int, etaMin float64) (*CosineAnnealingLR, error) {
 	opt.ResetStepCount()
-	initialLRs := opt.GetLRs()
+	initialLRs, err := opt.GetLRs()
+	if err != nil {
+		return nil, err
+	}
 	return &CosineAnnealingLR{
 		opt:        opt,
 		tmax:       tmax,
@@ -440,18 +608,41 @@ func NewCosineAnnealingLR(opt *Optimizer, tmax int, etaMin float64) *CosineAnnea
 		initialLRs: initialLRs,
 		stepCount:  0,
 		lastEpoch:  -1,
+	}, nil
+}
+
+// MustNewCosineAnnealingLR is like NewCosineAnnealingLR but panics if error occurred.
+func MustNewCosineAnnealingLR(opt *Optimizer, tmax int, etaMin float64) *CosineAnnealingLR {
+	s, err := NewCosineAnnealingLR(opt, tmax, etaMin)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return s
 }
 
 // Build implements scheduler interface.
-func (ca *CosineAnnealingLR) Build() *LRScheduler {
+func (ca *CosineAnnealingLR) Build() (*LRScheduler, error) {
 	s := &LRScheduler{ca}
-	s.Step()
+	if err := s.Step(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (ca *CosineAnnealingLR) MustBuild() *LRScheduler {
+	s, err := ca.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return s
 }
 
 // SetLRs implements scheduler interface.
-func (ca *CosineAnnealingLR) SetLRs(opts ...SchedulerOption) {
+func (ca *CosineAnnealingLR) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -466,7 +657,7 @@ func (ca *CosineAnnealingLR) SetLRs(opts ...SchedulerOption) {
 	var newLRs []float64
 	lrs, err := ca.opt.opt.GetLearningRates()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	switch {
@@ -490,8 +681,12 @@ func (ca *CosineAnnealingLR) SetLRs(opts ...SchedulerOption) {
 		}
 	}
 
-	ca.opt.SetLRs(newLRs)
+	if err := ca.opt.SetLRs(newLRs); err != nil {
+		return err
+	}
 	ca.stepCount += 1
+
+	return nil
 }
 
 // ReduceLROnPlateau reduces learning rate when a metric has stopped improving.
@@ -651,7 +846,7 @@ func WithReduceOnPlateauCooldown(cooldown int) ReduceLROnPlateauOption {
 	}
 }
 
-func NewReduceLROnPlateau(opt *Optimizer, opts ...ReduceLROnPlateauOption) *ReduceLROnPlateau {
+func NewReduceLROnPlateau(opt *Optimizer, opts ...ReduceLROnPlateauOption) (*ReduceLROnPlateau, error) {
 	options := defaultReduceLROnPlateauOptions()
 	for _, o := range opts {
 		o(options)
@@ -659,14 +854,17 @@ func NewReduceLROnPlateau(opt *Optimizer, opts ...ReduceLROnPlateauOption) *Redu
 
 	// Validate input parameters
 	if options.Mode != "min" && options.Mode != "max" {
-		log.Fatalf("Invalid 'mode'. Mode should be either 'min' or 'max', got %v\n", options.Mode)
+		err := fmt.Errorf("Invalid 'mode'. Mode should be either 'min' or 'max', got %v\n", options.Mode)
+		return nil, err
 	}
 	if options.Factor >= 1.0 {
-		log.Fatalf("Factor should be < 1.0. Got %v\n", options.Factor)
+		err := fmt.Errorf("Factor should be < 1.0. Got %v\n", options.Factor)
+		return nil, err
 	}
 
 	if options.ThresholdMode != "rel" && options.ThresholdMode != "abs" {
-		log.Fatalf("Invalide threshold mode. Should be 'rel' or 'abs'. Got %v\n", options.ThresholdMode)
+		err := fmt.Errorf("Invalide threshold mode. Should be 'rel' or 'abs'. Got %v\n", options.ThresholdMode)
+		return nil, err
 	}
 
 	var modeWorse float64
@@ -677,7 +875,10 @@ func NewReduceLROnPlateau(opt *Optimizer, opts ...ReduceLROnPlateauOption) *Redu
 		modeWorse = math.Inf(-1) // -inf
 	}
 
-	ngroup := opt.ParamGroupNum()
+	ngroup, err := opt.ParamGroupNum()
+	if err != nil {
+		return nil, err
+	}
 	var minLRs []float64 = make([]float64, ngroup)
 	switch len(options.MinLRs) {
 	case 1:
@@ -687,7 +888,8 @@ func NewReduceLROnPlateau(opt *Optimizer, opts ...ReduceLROnPlateauOption) *Redu
 	case ngroup:
 		minLRs = options.MinLRs
 	default:
-		log.Fatalf("MinLRs should have length of 1 or the same length as optimizer param groups. Got %v\n", len(options.MinLRs))
+		err := fmt.Errorf("MinLRs should have length of 1 or the same length as optimizer param groups. Got %v\n", len(options.MinLRs))
+		return nil, err
 	}
 
 	return &ReduceLROnPlateau{
@@ -707,7 +909,17 @@ func NewReduceLROnPlateau(opt *Optimizer, opts ...ReduceLROnPlateauOption) *Redu
 		numBadEpochs: 0,
 		modeWorse:    modeWorse,
 		lastEpoch:    0,
+	}, nil
+}
+
+// MustNewReduceLROnPlateau is like NewReduceLROnPlateau but panics if error occurred.
+func MustNewReduceLROnPlateau(opt *Optimizer, opts ...ReduceLROnPlateauOption) *ReduceLROnPlateau {
+	s, err := NewReduceLROnPlateau(opt, opts...)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return s
 }
 
 // Reset number of bad epochs counter and cooldown counter
@@ -739,8 +951,11 @@ func (s *ReduceLROnPlateau) isBetter(a, best float64) bool {
 	}
 }
 
-func (s *ReduceLROnPlateau) reduceLRs(epoch int) {
-	oldLRs := s.opt.GetLRs()
+func (s *ReduceLROnPlateau) reduceLRs(epoch int) error {
+	oldLRs, err := s.opt.GetLRs()
+	if err != nil {
+		return err
+	}
 
 	var newLRs []float64 = oldLRs
 	for i, oldLR := range oldLRs {
@@ -753,11 +968,11 @@ func (s *ReduceLROnPlateau) reduceLRs(epoch int) {
 		}
 	}
 
-	s.opt.SetLRs(newLRs)
+	return s.opt.SetLRs(newLRs)
 }
 
 // SetLRs implements scheduler interface.
-func (s *ReduceLROnPlateau) SetLRs(opts ...SchedulerOption) {
+func (s *ReduceLROnPlateau) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -784,15 +999,29 @@ func (s *ReduceLROnPlateau) SetLRs(opts ...SchedulerOption) {
 	}
 
 	if s.numBadEpochs > s.patience {
-		s.reduceLRs(s.lastEpoch)
+		if err := s.reduceLRs(s.lastEpoch); err != nil {
+			return err
+		}
 		s.cooldownCounter = s.cooldown
 		s.numBadEpochs = 0
 	}
+
+	return nil
 }
 
 // Build implements scheduler interface.
-func (s *ReduceLROnPlateau) Build() *LRScheduler {
-	return &LRScheduler{s}
+func (s *ReduceLROnPlateau) Build() (*LRScheduler, error) {
+	return &LRScheduler{s}, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (s *ReduceLROnPlateau) MustBuild() *LRScheduler {
+	sc, err := s.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return sc
 }
 
 func floatMax(v1, v2 float64) float64 {
@@ -999,7 +1228,7 @@ func WithCyclicLastEpoch(v int) CyclicOption {
 	}
 }
 
-func NewCyclicLR(opt *Optimizer, baseLRs, maxLRs []float64, opts ...CyclicOption) *CyclicLR {
+func NewCyclicLR(opt *Optimizer, baseLRs, maxLRs []float64, opts ...CyclicOption) (*CyclicLR, error) {
 	options := defaultCyclicOptions()
 	for _, o := range opts {
 		o(options)
@@ -1007,14 +1236,22 @@ func NewCyclicLR(opt *Optimizer, baseLRs, maxLRs []float64, opts ...CyclicOption
 
 	var cyc *CyclicLR = new(CyclicLR)
 
-	initialLRs := formatParam(opt, baseLRs, "baseLRs")
+	initialLRs, err := formatParam(opt, baseLRs, "baseLRs")
+	if err != nil {
+		return nil, err
+	}
 	if options.LastEpoch == -1 {
-		opt.SetLRs(initialLRs)
+		if err := opt.SetLRs(initialLRs); err != nil {
+			return nil, err
+		}
 	}
 	cyc.initialLRs = initialLRs
 
 	cyc.opt = opt
-	cyc.maxLRs = formatParam(opt, maxLRs, "maxLRs")
+	cyc.maxLRs, err = formatParam(opt, maxLRs, "maxLRs")
+	if err != nil {
+		return nil, err
+	}
 
 	var stepSizeDown int
 	switch options.StepSizeDown {
@@ -1032,7 +1269,8 @@ func NewCyclicLR(opt *Optimizer, baseLRs, maxLRs []float64, opts ...CyclicOption
 	cyc.stepRatio = stepRatio
 
 	if !strContain([]string{"triangular", "triangular2", "exp_range"}, options.Mode) && options.ScaleFn == nil {
-		log.Fatalf("Invalide 'mode': %v and scale function is nil\n", options.Mode)
+		err := fmt.Errorf("Invalide 'mode': %v and scale function is nil\n", options.Mode)
+		return nil, err
 	}
 	cyc.mode = options.Mode
 	cyc.gamma = options.Gamma
@@ -1067,14 +1305,32 @@ func NewCyclicLR(opt *Optimizer, baseLRs, maxLRs []float64, opts ...CyclicOption
 	if cyc.cycleMomentum {
 		// if optimizer doesn't have momentum, throw error
 		// TODO. type casting optimizer.config and check
-		cyc.baseMomentums = formatParam(opt, []float64{options.BaseMomentum}, "baseMomentum")
+		cyc.baseMomentums, err = formatParam(opt, []float64{options.BaseMomentum}, "baseMomentum")
+		if err != nil {
+			return nil, err
+		}
 		if options.LastEpoch == -1 {
-			opt.SetMomentum(options.BaseMomentum)
+			if err := opt.SetMomentum(options.BaseMomentum); err != nil {
+				return nil, err
+			}
+		}
+		cyc.maxMomentums, err = formatParam(opt, []float64{options.MaxMomentum}, "maxMomentum")
+		if err != nil {
+			return nil, err
 		}
-		cyc.maxMomentums = formatParam(opt, []float64{options.MaxMomentum}, "maxMomentum")
 	}
 
-	return cyc
+	return cyc, nil
+}
+
+// MustNewCyclicLR is like NewCyclicLR but panics if error occurred.
+func MustNewCyclicLR(opt *Optimizer, baseLRs, maxLRs []float64, opts ...CyclicOption) *CyclicLR {
+	s, err := NewCyclicLR(opt, baseLRs, maxLRs, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return s
 }
 
 func strContain(items []string, item string) bool {
@@ -1087,8 +1343,11 @@ func strContain(items []string, item string) bool {
 	return false
 }
 
-func formatParam(opt *Optimizer, param []float64, paramName string) []float64 {
-	ngroup := opt.ParamGroupNum()
+func formatParam(opt *Optimizer, param []float64, paramName string) ([]float64, error) {
+	ngroup, err := opt.ParamGroupNum()
+	if err != nil {
+		return nil, err
+	}
 	var paramOut []float64 = make([]float64, ngroup)
 	switch len(param) {
 	case 1:
@@ -1098,10 +1357,11 @@ func formatParam(opt *Optimizer, param []float64, paramName string) []float64 {
 	case ngroup:
 		paramOut = param
 	default:
-		log.Fatalf("Length of %s should be either 1 or equal to number of param groups. Got %v\n", paramName, len(param))
+		err := fmt.Errorf("Length of %s should be either 1 or equal to number of param groups. Got %v\n", paramName, len(param))
+		return nil, err
 	}
 
-	return paramOut
+	return paramOut, nil
 }
 
 // SetLRs implements scheduler interface.
@@ -1110,7 +1370,7 @@ func formatParam(opt *Optimizer, param []float64, paramName string) []float64 {
 // `lastEpoch` as the last batch index.
 // NOTE. If `cycleMomentum` is ``true``, this function has a side effect of
 // updating the optimizer's momentum.
-func (cyc *CyclicLR) SetLRs(opts ...SchedulerOption) {
+func (cyc *CyclicLR) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -1133,7 +1393,10 @@ func (cyc *CyclicLR) SetLRs(opts ...SchedulerOption) {
 		scaleFactor = (x - 1.0) / (cyc.stepRatio - 1.0)
 	}
 
-	ngroup := cyc.opt.ParamGroupNum()
+	ngroup, err := cyc.opt.ParamGroupNum()
+	if err != nil {
+		return err
+	}
 	var newLRs []float64 = make([]float64, ngroup)
 	for i := 0; i < ngroup; i++ {
 		baseLR := cyc.initialLRs[i]
@@ -1151,7 +1414,9 @@ func (cyc *CyclicLR) SetLRs(opts ...SchedulerOption) {
 	}
 
 	// Update optimizer learning rates.
-	cyc.opt.SetLRs(newLRs)
+	if err := cyc.opt.SetLRs(newLRs); err != nil {
+		return err
+	}
 
 	// Update optimizer momentum.
 	// NOTE. for now, we just assuming there's 1 param group and will update momentum for such param group.
@@ -1165,13 +1430,27 @@ func (cyc *CyclicLR) SetLRs(opts ...SchedulerOption) {
 		default:
 			momentum = maxMomentum - baseHeight*cyc.scaleFn(float64(cyc.lastEpoch))
 		}
-		cyc.opt.SetMomentum(momentum)
+		if err := cyc.opt.SetMomentum(momentum); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 // Build implements scheduler interface.
-func (cyc *CyclicLR) Build() *LRScheduler {
-	return &LRScheduler{cyc}
+func (cyc *CyclicLR) Build() (*LRScheduler, error) {
+	return &LRScheduler{cyc}, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (cyc *CyclicLR) MustBuild() *LRScheduler {
+	s, err := cyc.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return s
 }
 
 // CosineAnnealingWarmRestart sets the learning rate of each parameter group
@@ -1237,21 +1516,26 @@ func WithCosineAnnealingLastEpoch(v int) CosineAnnealingWarmRestartsOption {
 	}
 }
 
-func NewCosineAnnealingWarmRestarts(opt *Optimizer, t0 int, opts ...CosineAnnealingWarmRestartsOption) *CosineAnnealingWarmRestarts {
+func NewCosineAnnealingWarmRestarts(opt *Optimizer, t0 int, opts ...CosineAnnealingWarmRestartsOption) (*CosineAnnealingWarmRestarts, error) {
 	options := defaultCosineAnnealingWarmRestartsOptions()
 	for _, o := range opts {
 		o(options)
 	}
 
 	if t0 <= 0 {
-		log.Fatalf("T0 expected to be positive. Got %v\n", t0)
+		err := fmt.Errorf("T0 expected to be positive. Got %v\n", t0)
+		return nil, err
 	}
 
 	if options.TMult < 1 {
-		log.Fatalf("Expected TMult >= 1. Got %v\n", options.TMult)
+		err := fmt.Errorf("Expected TMult >= 1. Got %v\n", options.TMult)
+		return nil, err
 	}
 
-	initialLRs := opt.GetLRs()
+	initialLRs, err := opt.GetLRs()
+	if err != nil {
+		return nil, err
+	}
 
 	return &CosineAnnealingWarmRestarts{
 		opt:        opt,
@@ -1263,13 +1547,23 @@ func NewCosineAnnealingWarmRestarts(opt *Optimizer, t0 int, opts ...CosineAnneal
 		lastEpoch:  options.LastEpoch,
 		stepCount:  0,
 		initialLRs: initialLRs,
+	}, nil
+}
+
+// MustNewCosineAnnealingWarmRestarts is like NewCosineAnnealingWarmRestarts but panics if error occurred.
+func MustNewCosineAnnealingWarmRestarts(opt *Optimizer, t0 int, opts ...CosineAnnealingWarmRestartsOption) *CosineAnnealingWarmRestarts {
+	s, err := NewCosineAnnealingWarmRestarts(opt, t0, opts...)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return s
 }
 
 // SetLRs implements scheduler interface.
 //
 // NOTE. scheduler.Step(epoch) could be called after every batch update
-func (s *CosineAnnealingWarmRestarts) SetLRs(opts ...SchedulerOption) {
+func (s *CosineAnnealingWarmRestarts) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -1290,7 +1584,8 @@ func (s *CosineAnnealingWarmRestarts) SetLRs(opts ...SchedulerOption) {
 		}
 
 	case epoch < 0:
-		log.Fatalf("Expected non-negative epoch, got %v\n", epoch)
+		err := fmt.Errorf("Expected non-negative epoch, got %v\n", epoch)
+		return err
 
 	case epoch >= s.t0:
 		switch s.tMult {
@@ -1322,14 +1617,31 @@ func (s *CosineAnnealingWarmRestarts) SetLRs(opts ...SchedulerOption) {
 		newLRs = append(newLRs, newLR)
 	}
 
-	s.opt.SetLRs(newLRs)
+	if err := s.opt.SetLRs(newLRs); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // Build implement scheduler interface
-func (s *CosineAnnealingWarmRestarts) Build() *LRScheduler {
+func (s *CosineAnnealingWarmRestarts) Build() (*LRScheduler, error) {
 	scheduler := &LRScheduler{s}
-	scheduler.Step()
-	return scheduler
+	if err := scheduler.Step(); err != nil {
+		return nil, err
+	}
+
+	return scheduler, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (s *CosineAnnealingWarmRestarts) MustBuild() *LRScheduler {
+	sc, err := s.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return sc
 }
 
 // OneCycleLR sets the learning rate of each parameter group according to the
@@ -1542,7 +1854,7 @@ func WithOneCycleLastEpoch(v int) OneCycleOption {
 	}
 }
 
-func NewOneCycleLR(opt *Optimizer, maxLR float64, opts ...OneCycleOption) *OneCycleLR {
+func NewOneCycleLR(opt *Optimizer, maxLR float64, opts ...OneCycleOption) (*OneCycleLR, error) {
 	options := defaultOneCycleOptions()
 	for _, o := range opts {
 		o(options)
@@ -1554,25 +1866,30 @@ func NewOneCycleLR(opt *Optimizer, maxLR float64, opts ...OneCycleOption) *OneCy
 
 	// validate  pctStart
 	if options.PctStart < 0 || options.PctStart > 1 {
-		log.Fatalf("Expected float between 0 and 1 pct_start, but got %v\n", options.PctStart)
+		err := fmt.Errorf("Expected float between 0 and 1 pct_start, but got %v\n", options.PctStart)
+		return nil, err
 	}
 
 	// validate totalSteps
 	switch {
 	case options.TotalSteps == -1 && options.Epochs == -1 && options.StepsPerEpoch == -1:
-		log.Fatal("You must define either total_steps OR (epochs AND steps_per_epoch)")
+		err := fmt.Errorf("You must define either total_steps OR (epochs AND steps_per_epoch)\n")
+		return nil, err
 	case options.TotalSteps != -1:
 		if options.TotalSteps <= 0 {
-			log.Fatalf("Expected non-negative integer totalSteps, but got %v", options.TotalSteps)
+			err := fmt.Errorf("Expected non-negative integer totalSteps, but got %v", options.TotalSteps)
+			return nil, err
 		}
 
 		oc.totalSteps = options.TotalSteps
 	default:
 		switch {
 		case options.Epochs <= 0:
-			log.Fatalf("Expected non-negative integer epochs, but got %v\n", options.Epochs)
+			err := fmt.Errorf("Expected non-negative integer epochs, but got %v\n", options.Epochs)
+			return nil, err
 		case options.StepsPerEpoch <= 0:
-			log.Fatalf("Expected non-negative integer stepsPerEpoch, but got %v\n", options.StepsPerEpoch)
+			err := fmt.Errorf("Expected non-negative integer stepsPerEpoch, but got %v\n", options.StepsPerEpoch)
+			return nil, err
 		default:
 			oc.totalSteps = options.Epochs * options.StepsPerEpoch
 		}
@@ -1584,7 +1901,8 @@ func NewOneCycleLR(opt *Optimizer, maxLR float64, opts ...OneCycleOption) *OneCy
 	// validate annealStrategy
 	switch {
 	case !strContain([]string{"cos", "linear"}, options.AnnealStrategy):
-		log.Fatalf("anneal_strategy must by one of 'cos' or 'linear', instead got %v\n", options.AnnealStrategy)
+		err := fmt.Errorf("anneal_strategy must by one of 'cos' or 'linear', instead got %v\n", options.AnnealStrategy)
+		return nil, err
 	case options.AnnealStrategy == "cos":
 		oc.annealFn = func(start, end, pct float64) float64 {
 			// "Cosine anneal from `start` to `end` as pct goes from 0.0 to 1.0."
@@ -1598,8 +1916,14 @@ func NewOneCycleLR(opt *Optimizer, maxLR float64, opts ...OneCycleOption) *OneCy
 	}
 
 	// Initialize learning rate variables
-	maxLRs := formatParam(opt, []float64{maxLR}, "maxLR")
-	ngroup := opt.ParamGroupNum()
+	maxLRs, err := formatParam(opt, []float64{maxLR}, "maxLR")
+	if err != nil {
+		return nil, err
+	}
+	ngroup, err := opt.ParamGroupNum()
+	if err != nil {
+		return nil, err
+	}
 	var initialLRs []float64 = make([]float64, ngroup)
 	var minLRs []float64 = make([]float64, ngroup)
 	if options.LastEpoch == -1 {
@@ -1610,7 +1934,9 @@ func NewOneCycleLR(opt *Optimizer, maxLR float64, opts ...OneCycleOption) *OneCy
 		}
 
 		// Set initial learning rate for optimizer
-		opt.SetLRs(initialLRs)
+		if err := opt.SetLRs(initialLRs); err != nil {
+			return nil, err
+		}
 
 		// Keep maxLRs and minLRs in scheduler as we don't have these fields in optimizer as Python.
 		oc.maxLRs = maxLRs
@@ -1641,17 +1967,35 @@ func NewOneCycleLR(opt *Optimizer, maxLR float64, opts ...OneCycleOption) *OneCy
 		*/
 
 		// TODO. work on Optimizer to fully implement
-		oc.maxMomentums = formatParam(opt, []float64{options.MaxMomentum}, "maxMomentum")
-		oc.baseMomentums = formatParam(opt, []float64{options.BaseMomentum}, "baseMomentum")
+		oc.maxMomentums, err = formatParam(opt, []float64{options.MaxMomentum}, "maxMomentum")
+		if err != nil {
+			return nil, err
+		}
+		oc.baseMomentums, err = formatParam(opt, []float64{options.BaseMomentum}, "baseMomentum")
+		if err != nil {
+			return nil, err
+		}
 		if options.LastEpoch == -1 {
-			opt.SetMomentum(options.MaxMomentum)
+			if err := opt.SetMomentum(options.MaxMomentum); err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	return oc
+	return oc, nil
+}
+
+// MustNewOneCycleLR is like NewOneCycleLR but panics if error occurred.
+func MustNewOneCycleLR(opt *Optimizer, maxLR float64, opts ...OneCycleOption) *OneCycleLR {
+	s, err := NewOneCycleLR(opt, maxLR, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return s
 }
 
-func (oc *OneCycleLR) SetLRs(opts ...SchedulerOption) {
+func (oc *OneCycleLR) SetLRs(opts ...SchedulerOption) error {
 	options := defaultSchedulerOptions()
 	for _, o := range opts {
 		o(options)
@@ -1667,9 +2011,13 @@ func (oc *OneCycleLR) SetLRs(opts ...SchedulerOption) {
 	var newMomentums []float64
 	stepNum := oc.lastEpoch
 	if stepNum > oc.totalSteps {
-		log.Fatalf("Tried to step %v times. The specified number of total steps is %v", stepNum, oc.totalSteps)
+		err := fmt.Errorf("Tried to step %v times. The specified number of total steps is %v", stepNum, oc.totalSteps)
+		return err
+	}
+	ngroup, err := oc.opt.ParamGroupNum()
+	if err != nil {
+		return err
 	}
-	ngroup := oc.opt.ParamGroupNum()
 	for i := 0; i < ngroup; i++ {
 		var computedLR float64
 		var computedMomentum float64
@@ -1697,13 +2045,32 @@ func (oc *OneCycleLR) SetLRs(opts ...SchedulerOption) {
 		newMomentums = append(newMomentums, computedMomentum)
 	}
 
-	oc.opt.SetLRs(newLRs)
+	if err := oc.opt.SetLRs(newLRs); err != nil {
+		return err
+	}
 	// For now, just use first momentum.
-	oc.opt.SetMomentum(newMomentums[0])
+	if err := oc.opt.SetMomentum(newMomentums[0]); err != nil {
+		return err
+	}
+
+	return nil
 }
 
-func (oc *OneCycleLR) Build() *LRScheduler {
+func (oc *OneCycleLR) Build() (*LRScheduler, error) {
 	s := &LRScheduler{oc}
-	s.Step()
+	if err := s.Step(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// MustBuild is like Build but panics if error occurred.
+func (oc *OneCycleLR) MustBuild() *LRScheduler {
+	s, err := oc.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return s
 }
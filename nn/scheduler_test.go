@@ -22,7 +22,7 @@ func TestLambdaLR(t *testing.T) {
 	}
 
 	var s *nn.LRScheduler
-	s = nn.NewLambdaLR(opt, []nn.LambdaFn{ld1}).Build()
+	s = nn.MustNewLambdaLR(opt, []nn.LambdaFn{ld1}).MustBuild()
 
 	wants := []float64{
 		0.0,   // epoch < 30 -> 0 * lr = 0 * 0.0
@@ -36,9 +36,9 @@ func TestLambdaLR(t *testing.T) {
 			i += 1
 		}
 
-		s.Step()
+		s.MustStep()
 		want := wants[i]
-		got := opt.GetLRs()[0]
+		got := opt.MustGetLRs()[0]
 		if got != want {
 			t.Errorf("Epoch %d: Want %v - Got %v", epoch, want, got)
 		}
@@ -58,7 +58,7 @@ func TestMultiplicativeLR(t *testing.T) {
 	}
 
 	var s *nn.LRScheduler
-	s = nn.NewMultiplicativeLR(opt, []nn.LambdaFn{ld1}).Build()
+	s = nn.MustNewMultiplicativeLR(opt, []nn.LambdaFn{ld1}).MustBuild()
 
 	wants := []float64{
 		2,     // 2^1 * 1 = 2
@@ -68,9 +68,9 @@ func TestMultiplicativeLR(t *testing.T) {
 		32768, // 2^5 *1024 = 32768
 	}
 	for epoch := 0; epoch < 5; epoch++ {
-		s.Step()
+		s.MustStep()
 		want := wants[epoch]
-		got := opt.GetLRs()[0]
+		got := opt.MustGetLRs()[0]
 		if got != want {
 			t.Errorf("Epoch %d: Want %v - Got %v", epoch, want, got)
 		}
@@ -85,7 +85,7 @@ func TestStepLR(t *testing.T) {
 	}
 
 	var s *nn.LRScheduler
-	s = nn.NewStepLR(opt, 30, 0.1).Build()
+	s = nn.MustNewStepLR(opt, 30, 0.1).MustBuild()
 
 	wants := []float64{
 		0.05,    // initial LR -> 0.05
@@ -95,12 +95,12 @@ func TestStepLR(t *testing.T) {
 	}
 	i := 0
 	for epoch := 0; epoch < 100; epoch++ {
-		s.Step()
+		s.MustStep()
 		if (epoch+1)%30 == 0 && epoch > 0 {
 			i += 1
 		}
 		want := wants[i]
-		got := opt.GetLRs()[0]
+		got := opt.MustGetLRs()[0]
 		if got != want {
 			t.Errorf("Epoch %d: Want %v - Got %v", epoch, want, got)
 		}
@@ -115,7 +115,7 @@ func TestMultiStepLR(t *testing.T) {
 	}
 
 	var s *nn.LRScheduler
-	s = nn.NewMultiStepLR(opt, []int{31, 81}, 0.1).Build()
+	s = nn.MustNewMultiStepLR(opt, []int{31, 81}, 0.1).MustBuild()
 
 	wants := []float64{
 		0.05,   // initial LR -> 0.05
@@ -124,12 +124,12 @@ func TestMultiStepLR(t *testing.T) {
 	}
 	i := 0
 	for epoch := 0; epoch < 100; epoch++ {
-		s.Step()
+		s.MustStep()
 		if contain(epoch, []int{30, 80}) {
 			i += 1
 		}
 		want := wants[i]
-		got := opt.GetLRs()[0]
+		got := opt.MustGetLRs()[0]
 		if got != want {
 			t.Errorf("Epoch %d: Want %v - Got %v", epoch, want, got)
 		}
@@ -144,7 +144,7 @@ func TestExponentialLR(t *testing.T) {
 	}
 
 	var s *nn.LRScheduler
-	s = nn.NewStepLR(opt, 30, 0.1).Build()
+	s = nn.MustNewStepLR(opt, 30, 0.1).MustBuild()
 
 	wants := []float64{
 		0.05,    // initial LR -> 0.05
@@ -154,9 +154,9 @@ func TestExponentialLR(t *testing.T) {
 	}
 	i := 0
 	for epoch := 0; epoch < 3; epoch++ {
-		s.Step()
+		s.MustStep()
 		want := wants[i]
-		got := opt.GetLRs()[0]
+		got := opt.MustGetLRs()[0]
 		if got != want {
 			t.Errorf("Epoch %d: Want %v - Got %v", epoch, want, got)
 		}
@@ -173,19 +173,19 @@ func TestCosineAnnealingLR(t *testing.T) {
 
 	var s *nn.LRScheduler
 	steps := 10
-	s = nn.NewCosineAnnealingLR(opt, steps, 0.0).Build()
+	s = nn.MustNewCosineAnnealingLR(opt, steps, 0.0).MustBuild()
 
 	for epoch := 0; epoch < 5; epoch++ {
 		opt.SetLRs([]float64{1.0})
 		// s := NewCosineAnnealingLR(opt, steps, 0.0).Build()
 		for idx := 0; idx < steps; idx++ {
-			s.Step()
-			t.Logf("LR: %0.10f\n", opt.GetLRs())
+			s.MustStep()
+			t.Logf("LR: %0.10f\n", opt.MustGetLRs())
 		}
 
 		t.Logf("Reset scheduler. \n")
 		opt.ResetStepCount()
-		s = nn.NewCosineAnnealingLR(opt, steps, 0.0).Build()
+		s = nn.MustNewCosineAnnealingLR(opt, steps, 0.0).MustBuild()
 	}
 
 	// t.Log(model)
@@ -212,15 +212,15 @@ func TestCyclicLR(t *testing.T) {
 	var s *nn.LRScheduler
 	baseLRs := []float64{0.001}
 	maxLRs := []float64{0.1}
-	s = nn.NewCyclicLR(opt, baseLRs, maxLRs, nn.WithCyclicStepSizeUp(5), nn.WithCyclicMode("triangular")).Build()
+	s = nn.MustNewCyclicLR(opt, baseLRs, maxLRs, nn.WithCyclicStepSizeUp(5), nn.WithCyclicMode("triangular")).MustBuild()
 
 	var lrs []float64
 	for i := 0; i < 100; i++ {
 		opt.Step()
-		lr := opt.GetLRs()[0]
+		lr := opt.MustGetLRs()[0]
 		lrs = append(lrs, lr)
 		t.Logf("batch %2d: lr %0.4f\n", i, lr)
-		s.Step()
+		s.MustStep()
 	}
 	// t.Logf("Lrs: %+v\n", lrs)
 	t.Log(model)
@@ -239,12 +239,12 @@ func TestCosineAnnealingWarmRestarts(t *testing.T) {
 	tMult := 1
 	etaMin := 0.001
 	lastEpoch := -1
-	s = nn.NewCosineAnnealingWarmRestarts(opt, t0, nn.WithTMult(tMult), nn.WithEtaMin(etaMin), nn.WithCosineAnnealingLastEpoch(lastEpoch)).Build()
+	s = nn.MustNewCosineAnnealingWarmRestarts(opt, t0, nn.WithTMult(tMult), nn.WithEtaMin(etaMin), nn.WithCosineAnnealingLastEpoch(lastEpoch)).MustBuild()
 
 	var lrs []float64
 	for i := 0; i < 100; i++ {
-		s.Step()
-		lr := opt.GetLRs()[0]
+		s.MustStep()
+		lr := opt.MustGetLRs()[0]
 		lrs = append(lrs, lr)
 		t.Logf("batch %2d: lr %0.4f\n", i, lr)
 	}
@@ -266,15 +266,15 @@ func TestOneCycleLR(t *testing.T) {
 	epochs := 10
 	annealStrategy := "linear"
 
-	s = nn.NewOneCycleLR(opt, maxLR, nn.WithOneCycleStepsPerEpoch(stepsPerEpoch), nn.WithOneCycleEpochs(epochs), nn.WithOneCycleAnnealStrategy(annealStrategy)).Build()
+	s = nn.MustNewOneCycleLR(opt, maxLR, nn.WithOneCycleStepsPerEpoch(stepsPerEpoch), nn.WithOneCycleEpochs(epochs), nn.WithOneCycleAnnealStrategy(annealStrategy)).MustBuild()
 
 	var lrs []float64
 	for i := 0; i < 100; i++ {
 		opt.Step()
-		lr := opt.GetLRs()[0]
+		lr := opt.MustGetLRs()[0]
 		lrs = append(lrs, lr)
 		t.Logf("batch %2d: lr %0.4f\n", i, lr)
-		s.Step()
+		s.MustStep()
 	}
 	// t.Logf("Lrs: %+v\n", lrs)
 	t.Log(model)
@@ -0,0 +1,82 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestScope_Layers(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	path := vs.Root()
+
+	// Variables created inside a scope outlive it.
+	var (
+		conv   *nn.Conv2D
+		conv1  *nn.Conv2D
+		convT  *nn.ConvTranspose2D
+		bn     *nn.BatchNorm
+		linear *nn.Linear
+	)
+	ts.WithScope(func() {
+		conv = nn.NewConv2D(path.Sub("conv"), 3, 4, 3, nn.DefaultConv2DConfig())
+
+		// Layers without bias hold an undefined Bs which must outlive the scope too.
+		convCfg := nn.DefaultConv2DConfig()
+		convCfg.Bias = false
+		conv1 = nn.NewConv2D(path.Sub("conv1"), 4, 4, 3, convCfg)
+		convTCfg := &nn.ConvTranspose2DConfig{
+			Stride:        []int64{1, 1},
+			Padding:       []int64{0, 0},
+			OutputPadding: []int64{0, 0},
+			Dilation:      []int64{1, 1},
+			Groups:        1,
+			Bias:          false,
+			WsInit:        nn.NewKaimingUniformInit(),
+		}
+		convT = nn.MustNewConvTranspose2D(path.Sub("convT"), 4, 4, []int64{3, 3}, convTCfg)
+
+		bn = nn.BatchNorm2D(path.Sub("bn"), 4, nn.DefaultBatchNormConfig())
+		linear = nn.NewLinear(path.Sub("linear"), 4, 2, nn.DefaultLinearConfig())
+	})
+
+	ts.CheckLeaks(t, func() {
+		ts.WithScope(func() {
+			x := ts.MustRandn([]int64{2, 3, 8, 8}, gotch.Float, gotch.CPU)
+			y := conv.Forward(x)
+			y = conv1.Forward(y)
+			y = convT.Forward(y)
+			want := []int64{2, 4, 6, 6}
+			if got := y.MustSize(); !reflect.DeepEqual(want, got) {
+				t.Errorf("Want: %v\n", want)
+				t.Errorf("Got: %v\n", got)
+			}
+			y = bn.ForwardT(y, true).MustRelu(false)
+			y = y.MustMean1([]int64{2, 3}, false, gotch.Float, false)
+			logits := linear.Forward(y)
+
+			want = []int64{2, 2}
+			if got := logits.MustSize(); !reflect.DeepEqual(want, got) {
+				t.Errorf("Want: %v\n", want)
+				t.Errorf("Got: %v\n", got)
+			}
+		})
+	})
+
+	want := []int64{4, 2}
+	if got := linear.Ws.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+	vars := vs.TrainableVariables()
+	if got := len(vars); got != 8 {
+		t.Errorf("Want: %v\n", 8)
+		t.Errorf("Got: %v\n", got)
+	}
+	for _, v := range vars {
+		v.MustDrop()
+	}
+}
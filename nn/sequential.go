@@ -225,9 +225,9 @@ func (fw ForwardTWith) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
 // it sets a global flag that is checked by the backend whenever an op is done on a variable.
 // The guard itself saved the current status and set it to false in the constructor.
 // And restore the saved status in it’s destructor. That way it is similar to a with torch.no_grad(): block in python.
-// This seems not working in Go.
-// There 2 ways to get around. One is freeze VarStore, the other is
-// set manually set AutoGrad at `loss` tensor. I.e., `loss = loss.MustSetRequiresGrad(true)`
+// `ts.NoGradGuard` locks the goroutine to its OS thread so that the flag is
+// kept for the whole evaluation without freezing the VarStore, which could be
+// trained by other goroutines at the same time.
 func BatchAccuracyForLogits(vs *VarStore, m ts.ModuleT, xs, ys *ts.Tensor, d gotch.Device, batchSize int) (retVal float64) {
 
 	var (
@@ -235,8 +235,8 @@ func BatchAccuracyForLogits(vs *VarStore, m ts.ModuleT, xs, ys *ts.Tensor, d got
 		sampleCount float64 = 0.0
 	)
 
-	vs.Freeze()
-	defer vs.Unfreeze()
+	guard := ts.NewNoGradGuard()
+	defer guard.Drop()
 
 	iter2 := ts.MustNewIter2(xs, ys, int64(batchSize))
 	for {
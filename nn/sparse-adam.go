@@ -0,0 +1,397 @@
+package nn
+
+// Adam optimizer supporting sparse gradients, implemented in Go as libtorch
+// Adam rejects sparse gradients.
+
+import (
+	"fmt"
+	"math"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// sparseAdam implements Adam algorithm for sparse and dense gradients.
+//
+// With a sparse gradient (e.g. of an `Embedding` weight with `Sparse` config),
+// only moments and elements of the parameter at indices of the gradient are
+// updated (lazy Adam as in PyTorch `SparseAdam`), so that a step costs
+// proportionally to number of looked-up embeddings rather than vocabulary
+// size. With a dense gradient, it updates the whole parameter as Adam.
+type sparseAdam struct {
+	lr     float64 // learning rate of new parameter groups
+	beta1  float64
+	beta2  float64
+	eps    float64
+	groups []*sparseAdamGroup
+}
+
+type sparseAdamGroup struct {
+	lr     float64
+	params []*sparseAdamParam
+}
+
+// sparseAdamParam holds a parameter and its state.
+type sparseAdamParam struct {
+	tensor   *ts.Tensor // shallow clone of the parameter
+	step     int
+	expAvg   *ts.Tensor // first moment, created at the first step
+	expAvgSq *ts.Tensor // second moment, created at the first step
+}
+
+func newSparseAdam(lr, beta1, beta2, eps float64) *sparseAdam {
+	return &sparseAdam{
+		lr:     lr,
+		beta1:  beta1,
+		beta2:  beta2,
+		eps:    eps,
+		groups: []*sparseAdamGroup{{lr: lr}},
+	}
+}
+
+func newSparseAdamParam(param *ts.Tensor) (*sparseAdamParam, error) {
+	tensor, err := param.ShallowClone()
+	if err != nil {
+		return nil, err
+	}
+
+	return &sparseAdamParam{tensor: ts.Untrack(tensor)}, nil
+}
+
+// Implement optimizer interface for sparseAdam:
+// =============================================
+
+func (o *sparseAdam) AddParameter(param *ts.Tensor, group uint) error {
+	p, err := newSparseAdamParam(param)
+	if err != nil {
+		return err
+	}
+	for uint(len(o.groups)) <= group {
+		o.groups = append(o.groups, &sparseAdamGroup{lr: o.lr})
+	}
+	o.groups[group].params = append(o.groups[group].params, p)
+
+	return nil
+}
+
+func (o *sparseAdam) SetLearningRate(lr float64) error {
+	o.lr = lr
+	for _, g := range o.groups {
+		g.lr = lr
+	}
+
+	return nil
+}
+
+func (o *sparseAdam) GetLearningRates() ([]float64, error) {
+	lrs := make([]float64, len(o.groups))
+	for i, g := range o.groups {
+		lrs[i] = g.lr
+	}
+
+	return lrs, nil
+}
+
+func (o *sparseAdam) SetLearningRates(lrs []float64) error {
+	if len(lrs) != len(o.groups) {
+		err := fmt.Errorf("Size of input learning rates (%v) is unequal to number of parameter groups (%v).\n", len(lrs), len(o.groups))
+		return err
+	}
+	for i, g := range o.groups {
+		g.lr = lrs[i]
+	}
+
+	return nil
+}
+
+func (o *sparseAdam) ParamGroupNum() (int64, error) {
+	return int64(len(o.groups)), nil
+}
+
+func (o *sparseAdam) AddParamGroup(tensors []ts.Tensor) error {
+	g := &sparseAdamGroup{lr: o.lr}
+	for i := range tensors {
+		p, err := newSparseAdamParam(&tensors[i])
+		if err != nil {
+			for _, p := range g.params {
+				p.tensor.MustDrop()
+			}
+			return err
+		}
+		g.params = append(g.params, p)
+	}
+	o.groups = append(o.groups, g)
+
+	return nil
+}
+
+// SetMomentum sets beta1.
+func (o *sparseAdam) SetMomentum(m float64) error {
+	o.beta1 = m
+
+	return nil
+}
+
+func (o *sparseAdam) ZeroGrad() error {
+	for _, g := range o.groups {
+		for _, p := range g.params {
+			grad, err := p.tensor.Grad(false)
+			if err != nil {
+				return err
+			}
+			defined, err := grad.Defined()
+			if err == nil && defined {
+				err = grad.Detach_()
+				if err == nil {
+					err = grad.Zero_()
+				}
+			}
+			grad.MustDrop()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o *sparseAdam) Step() error {
+	guard := ts.NewNoGradGuard()
+	defer guard.Drop()
+
+	for _, g := range o.groups {
+		for _, p := range g.params {
+			if err := o.update(p, g.lr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// update updates a parameter which has a gradient.
+func (o *sparseAdam) update(p *sparseAdamParam, lr float64) error {
+	grad, err := p.tensor.Grad(false)
+	if err != nil {
+		return err
+	}
+	defer grad.MustDrop()
+	defined, err := grad.Defined()
+	if err != nil || !defined {
+		return err
+	}
+	sparse, err := grad.IsSparse()
+	if err != nil {
+		return err
+	}
+
+	if p.expAvg == nil {
+		expAvg, err := p.tensor.ZerosLike(false)
+		if err != nil {
+			return err
+		}
+		expAvgSq, err := p.tensor.ZerosLike(false)
+		if err != nil {
+			expAvg.MustDrop()
+			return err
+		}
+		p.expAvg, p.expAvgSq = ts.Untrack(expAvg), ts.Untrack(expAvgSq)
+	}
+
+	p.step += 1
+	bias1 := 1 - math.Pow(o.beta1, float64(p.step))
+	bias2 := 1 - math.Pow(o.beta2, float64(p.step))
+	stepSize := lr * math.Sqrt(bias2) / bias1
+
+	if sparse {
+		return o.sparseUpdate(p, grad, stepSize)
+	}
+
+	return o.denseUpdate(p, grad, stepSize)
+}
+
+// denseUpdate updates the whole parameter:
+//
+//	m = m + (1 - beta1) * (g - m)
+//	v = v + (1 - beta2) * (g^2 - v)
+//	p = p - stepSize * m / (sqrt(v) + eps)
+func (o *sparseAdam) denseUpdate(p *sparseAdamParam, grad *ts.Tensor, stepSize float64) error {
+	beta1 := ts.FloatScalar(1 - o.beta1)
+	beta2 := ts.FloatScalar(1 - o.beta2)
+	eps := ts.FloatScalar(o.eps)
+	negStepSize := ts.FloatScalar(-stepSize)
+	defer func() {
+		for _, sc := range []*ts.Scalar{beta1, beta2, eps, negStepSize} {
+			sc.MustDrop()
+		}
+	}()
+
+	mUpdate, err := grad.Sub(p.expAvg, false)
+	if err != nil {
+		return err
+	}
+	err = mUpdate.Mul1_(beta1)
+	if err == nil {
+		err = p.expAvg.Add_(mUpdate)
+	}
+	mUpdate.MustDrop()
+	if err != nil {
+		return err
+	}
+
+	vUpdate, err := grad.Square(false)
+	if err != nil {
+		return err
+	}
+	err = vUpdate.Sub_(p.expAvgSq)
+	if err == nil {
+		err = vUpdate.Mul1_(beta2)
+	}
+	if err == nil {
+		err = p.expAvgSq.Add_(vUpdate)
+	}
+	vUpdate.MustDrop()
+	if err != nil {
+		return err
+	}
+
+	denom, err := p.expAvgSq.Sqrt(false)
+	if err != nil {
+		return err
+	}
+	defer denom.MustDrop()
+	if err = denom.Add1_(eps); err != nil {
+		return err
+	}
+	update, err := p.expAvg.Div(denom, false)
+	if err != nil {
+		return err
+	}
+	defer update.MustDrop()
+	if err = update.Mul1_(negStepSize); err != nil {
+		return err
+	}
+
+	return p.tensor.Add_(update)
+}
+
+// sparseUpdate updates moments and the parameter at indices of a sparse
+// gradient only, with the same formulas as `denseUpdate`.
+func (o *sparseAdam) sparseUpdate(p *sparseAdamParam, grad *ts.Tensor, stepSize float64) error {
+	beta1 := ts.FloatScalar(1 - o.beta1)
+	beta2 := ts.FloatScalar(1 - o.beta2)
+	eps := ts.FloatScalar(o.eps)
+	negStepSize := ts.FloatScalar(-stepSize)
+	defer func() {
+		for _, sc := range []*ts.Scalar{beta1, beta2, eps, negStepSize} {
+			sc.MustDrop()
+		}
+	}()
+
+	// Duplicated indices (e.g. an embedding looked up several times) are
+	// summed up.
+	g, err := grad.Coalesce(false)
+	if err != nil {
+		return err
+	}
+	defer g.MustDrop()
+	indices, err := g.Indices(false)
+	if err != nil {
+		return err
+	}
+	defer indices.MustDrop()
+	values, err := g.Values(false)
+	if err != nil {
+		return err
+	}
+	defer values.MustDrop()
+	size, err := g.Size()
+	if err != nil {
+		return err
+	}
+
+	// addSparse adds values at the gradient indices to x.
+	addSparse := func(x, values *ts.Tensor) error {
+		s, err := ts.SparseCOO(indices, values, size)
+		if err != nil {
+			return err
+		}
+		defer s.MustDrop()
+
+		return x.Add_(s)
+	}
+
+	oldExpAvg, err := maskedValues(p.expAvg, g)
+	if err != nil {
+		return err
+	}
+	defer oldExpAvg.MustDrop()
+	mUpdate, err := values.Sub(oldExpAvg, false)
+	if err != nil {
+		return err
+	}
+	defer mUpdate.MustDrop()
+	if err = mUpdate.Mul1_(beta1); err != nil {
+		return err
+	}
+	if err = addSparse(p.expAvg, mUpdate); err != nil {
+		return err
+	}
+
+	oldExpAvgSq, err := maskedValues(p.expAvgSq, g)
+	if err != nil {
+		return err
+	}
+	defer oldExpAvgSq.MustDrop()
+	vUpdate, err := values.Square(false)
+	if err != nil {
+		return err
+	}
+	defer vUpdate.MustDrop()
+	err = vUpdate.Sub_(oldExpAvgSq)
+	if err == nil {
+		err = vUpdate.Mul1_(beta2)
+	}
+	if err == nil {
+		err = addSparse(p.expAvgSq, vUpdate)
+	}
+	if err != nil {
+		return err
+	}
+
+	// New moments at the indices are old ones plus updates. vUpdate becomes
+	// the denominator and mUpdate the parameter update.
+	err = mUpdate.Add_(oldExpAvg)
+	if err == nil {
+		err = vUpdate.Add_(oldExpAvgSq)
+	}
+	if err == nil {
+		err = vUpdate.Sqrt_()
+	}
+	if err == nil {
+		err = vUpdate.Add1_(eps)
+	}
+	if err == nil {
+		err = mUpdate.Div_(vUpdate)
+	}
+	if err == nil {
+		err = mUpdate.Mul1_(negStepSize)
+	}
+	if err != nil {
+		return err
+	}
+
+	return addSparse(p.tensor, mUpdate)
+}
+
+// maskedValues returns values of dense tensor x at indices of coalesced
+// sparse tensor mask.
+func maskedValues(x, mask *ts.Tensor) (*ts.Tensor, error) {
+	masked, err := x.SparseMask(mask, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return masked.Values(true)
+}
@@ -8,6 +8,9 @@ import (
 
 // Configuration option for an embedding layer.
 type EmbeddingConfig struct {
+	// Sparse makes gradient of the embedding weight a sparse tensor holding
+	// rows of looked-up embeddings only. Use an optimizer supporting sparse
+	// gradients (`SGDConfig` or `SparseAdamConfig`) to train it.
 	Sparse          bool
 	ScaleGradByFreq bool
 	WsInit          Init
@@ -35,7 +38,7 @@ type Embedding struct {
 // NewEmbedding creates a new Embedding
 func NewEmbedding(vs *Path, numEmbeddings int64, embeddingDim int64, config *EmbeddingConfig) *Embedding {
 	return &Embedding{
-		Ws:     vs.NewVar("weight", []int64{numEmbeddings, embeddingDim}, config.WsInit),
+		Ws:     vs.MustNewVar("weight", []int64{numEmbeddings, embeddingDim}, config.WsInit),
 		config: config,
 	}
 }
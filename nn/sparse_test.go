@@ -1,6 +1,7 @@
 package nn_test
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -62,3 +63,51 @@ func TestEmbedding(t *testing.T) {
 	cfg.PaddingIdx = 0
 	embeddingTest(cfg, t)
 }
+
+func TestEmbeddingSparseAdam(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	cfg := nn.DefaultEmbeddingConfig()
+	cfg.Sparse = true
+	embeddings := nn.NewEmbedding(vs.Root(), 10, 4, cfg)
+	bias := vs.Root().MustZeros("bias", []int64{4})
+
+	opt, err := nn.DefaultSparseAdamConfig().Build(vs, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := embeddings.Ws.Float64Values()
+	input := ts.MustOfSlice([]int64{1, 3, 3})
+	output := embeddings.Forward(input).MustAdd(bias, true)
+	loss := output.MustSum(gotch.Float, true)
+	if err := opt.BackwardStep(loss); err != nil {
+		t.Fatal(err)
+	}
+
+	grad := embeddings.Ws.MustGrad(false)
+	if sparse, err := grad.IsSparse(); err != nil || !sparse {
+		t.Errorf("Want sparse gradient, got error: %v\n", err)
+	}
+
+	// First Adam step moves each element with non-zero gradient by lr.
+	after := embeddings.Ws.Float64Values()
+	for i := range after {
+		row := i / 4
+		want := before[i]
+		if row == 1 || row == 3 {
+			want -= 0.1
+		}
+		if math.Abs(after[i]-want) > 1e-5 {
+			t.Errorf("Want %v at %v - Got %v\n", want, i, after[i])
+		}
+	}
+	for i, got := range bias.Float64Values() {
+		if math.Abs(got+0.1) > 1e-5 {
+			t.Errorf("Want bias %v at %v - Got %v\n", -0.1, i, got)
+		}
+	}
+
+	grad.MustDrop()
+	loss.MustDrop()
+	input.MustDrop()
+}
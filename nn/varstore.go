@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 
@@ -195,8 +196,11 @@ func (vs *VarStore) Load(filepath string) error {
 		}
 
 		ts.NoGrad(func() {
-			vs.Vars.NamedVariables[tsName].Copy_(currTs)
+			err = vs.Vars.NamedVariables[tsName].Copy_(currTs)
 		})
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -250,42 +254,188 @@ func (vs *VarStore) LoadPartial(filepath string) ([]string, error) {
 		}
 
 		ts.NoGrad(func() {
-			vs.Vars.NamedVariables[tsName].Copy_(currTs)
+			err = vs.Vars.NamedVariables[tsName].Copy_(currTs)
 		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return missingVariables, nil
 }
 
+// SaveSafetensors saves the var-store variable values to a file in
+// safetensors format.
+func (vs *VarStore) SaveSafetensors(filepath string) error {
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	var names []string
+	for k := range vs.Vars.NamedVariables {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var namedTensors []ts.NamedTensor
+	for _, k := range names {
+		namedTensors = append(namedTensors, ts.NamedTensor{
+			Name:   k,
+			Tensor: vs.Vars.NamedVariables[k],
+		})
+	}
+
+	return ts.WriteSafetensors(namedTensors, nil, filepath)
+}
+
+// LoadSafetensors loads the var-store variable values from a safetensors file.
+//
+// As `Load`, it will throw error if any variable in the var-store can not be
+// found in the file or has mismatched shape. Values are converted to dtype
+// and device of the var-store variables.
+func (vs *VarStore) LoadSafetensors(filepath string) error {
+	namedTensors, _, err := ts.ReadSafetensors(filepath)
+	if err != nil {
+		return err
+	}
+
+	var namedTensorsMap map[string]*ts.Tensor = make(map[string]*ts.Tensor, 0)
+	for _, namedTensor := range namedTensors {
+		namedTensorsMap[namedTensor.Name] = namedTensor.Tensor
+	}
+	defer func() {
+		for _, namedTensor := range namedTensors {
+			namedTensor.Tensor.MustDrop()
+		}
+	}()
+
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	for tsName := range vs.Vars.NamedVariables {
+		// missing variable
+		currTs, ok := namedTensorsMap[tsName]
+		if !ok {
+			err = fmt.Errorf("Cannot find tensor with name: %v in variable store. \n", tsName)
+			return err
+		}
+
+		// mismatched shape
+		sourceShape := currTs.MustSize()
+		destShape := vs.Vars.NamedVariables[tsName].MustSize()
+		if !reflect.DeepEqual(destShape, sourceShape) {
+			err = fmt.Errorf("Mismatched shape error for variable name: %v - At store: %v - At source %v\n", tsName, destShape, sourceShape)
+			return err
+		}
+
+		ts.NoGrad(func() {
+			err = vs.Vars.NamedVariables[tsName].Copy_(currTs)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadLazy loads the var-store variable values from a .npz or safetensors
+// file which is memory-mapped. Unlike `Load`, tensors are read from the file
+// and copied to the var-store one at a time so that peak memory is about the
+// size of the var-store plus its largest variable.
+//
+// If `names` are specified, only these variables are loaded. Otherwise, all
+// variables in the var-store are loaded. It will throw error if a variable
+// can not be found in the var-store or the file, or has mismatched shape.
+func (vs *VarStore) LoadLazy(filepath string, names ...string) error {
+	lazy, err := ts.OpenLazy(filepath)
+	if err != nil {
+		return err
+	}
+	defer lazy.Close()
+
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	if len(names) == 0 {
+		for tsName := range vs.Vars.NamedVariables {
+			names = append(names, tsName)
+		}
+		sort.Strings(names)
+	}
+
+	for _, tsName := range names {
+		x, ok := vs.Vars.NamedVariables[tsName]
+		if !ok {
+			err = fmt.Errorf("Cannot find variable with name: %v in variable store.\n", tsName)
+			return err
+		}
+
+		if !lazy.Has(tsName) {
+			err = fmt.Errorf("Cannot find tensor with name: %v in file: %v.\n", tsName, filepath)
+			return err
+		}
+
+		currTs, err := lazy.Get(tsName)
+		if err != nil {
+			return err
+		}
+
+		// mismatched shape
+		sourceShape := currTs.MustSize()
+		destShape := x.MustSize()
+		if !reflect.DeepEqual(destShape, sourceShape) {
+			currTs.MustDrop()
+			err = fmt.Errorf("Mismatched shape error for variable name: %v - At store: %v - At source %v\n", tsName, destShape, sourceShape)
+			return err
+		}
+
+		ts.NoGrad(func() {
+			err = x.Copy_(currTs)
+		})
+		currTs.MustDrop()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Freeze freezes a var store.
 //
 // Gradients for the variables in this store are not tracked
 // anymore.
-func (vs *VarStore) Freeze() {
+func (vs *VarStore) Freeze() error {
 	vs.Vars.mutex.Lock()
 	defer vs.Vars.mutex.Unlock()
 
 	for _, v := range vs.Vars.TrainableVariables {
 		_, err := v.Tensor.SetRequiresGrad(false, false)
 		if err != nil {
-			log.Fatalf("Freeze() Error: %v\n", err)
+			err = fmt.Errorf("Freeze() Error: %w\n", err)
+			return err
 		}
 	}
+
+	return nil
 }
 
 // Unfreeze unfreezes a var store.
 //
 // Gradients for the variables in this store are tracked again.
-func (vs *VarStore) Unfreeze() {
+func (vs *VarStore) Unfreeze() error {
 	vs.Vars.mutex.Lock()
 	defer vs.Vars.mutex.Unlock()
 
 	for _, v := range vs.Vars.TrainableVariables {
 		_, err := v.Tensor.SetRequiresGrad(true, false)
 		if err != nil {
-			log.Fatalf("Unfreeze() Error: %v\n", err)
+			err = fmt.Errorf("Unfreeze() Error: %w\n", err)
+			return err
 		}
 	}
+
+	return nil
 }
 
 // Copy copies variable values from a source var store to this var store.
@@ -315,21 +465,99 @@ func (vs *VarStore) Copy(src VarStore) error {
 			return err
 		}
 		ts.NoGrad(func() {
-			ts.Copy_(v, srcDevTs)
+			err = ts.Copy_(v, srcDevTs)
 		})
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// RegisterHook registers a hook to be called with gradient of the variable
+// of given name in backward pass. See `ts.Tensor.RegisterHook`.
+//
+// Example:
+//
+//	// Gradient reversal for "encoder.weight".
+//	h, err := vs.RegisterHook("encoder.weight", func(grad *ts.Tensor) *ts.Tensor {
+//		return grad.MustNeg(false)
+//	})
+func (vs *VarStore) RegisterHook(name string, hook ts.Hook) (*ts.HookHandle, error) {
+	vs.Vars.mutex.Lock()
+	x, ok := vs.Vars.NamedVariables[name]
+	vs.Vars.mutex.Unlock()
+	if !ok {
+		err := fmt.Errorf("VarStore.RegisterHook() failed: cannot find variable %q.\n", name)
+		return nil, err
+	}
+
+	return x.RegisterHook(hook)
+}
+
+// RegisterHooks registers a hook to every trainable variable of the var store.
+// The hook is called with variable name and its gradient in backward pass,
+// e.g. for logging gradient norms or per-layer gradient clipping.
+func (vs *VarStore) RegisterHooks(hook func(name string, grad *ts.Tensor) *ts.Tensor) ([]*ts.HookHandle, error) {
+	vs.Vars.mutex.Lock()
+	trainable := make(map[*ts.Tensor]bool, len(vs.Vars.TrainableVariables))
+	for _, v := range vs.Vars.TrainableVariables {
+		trainable[v.Tensor] = true
+	}
+	var names []string
+	for name, x := range vs.Vars.NamedVariables {
+		if trainable[x] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	tensors := make([]*ts.Tensor, len(names))
+	for i, name := range names {
+		tensors[i] = vs.Vars.NamedVariables[name]
+	}
+	vs.Vars.mutex.Unlock()
+
+	handles := make([]*ts.HookHandle, 0, len(names))
+	for i, x := range tensors {
+		name := names[i]
+		h, err := x.RegisterHook(func(grad *ts.Tensor) *ts.Tensor {
+			return hook(name, grad)
+		})
+		if err != nil {
+			for _, h := range handles {
+				h.Remove()
+			}
+			return nil, err
+		}
+		handles = append(handles, h)
+	}
+
+	return handles, nil
+}
+
 // Path methods:
 // =============
 
 // Sub gets a sub-path of the given path.
+//
+// It panics if `str` contains path separator. See `SubE`.
 func (p *Path) Sub(str string) *Path {
+	sub, err := p.SubE(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return sub
+}
+
+// SubE gets a sub-path of the given path. It returns error if `str` contains
+// path separator.
+func (p *Path) SubE(str string) (*Path, error) {
 
 	if strings.Contains(str, SEP) {
-		log.Fatalf("Sub name cannot contain %v (%v)\n", SEP, str)
+		err := fmt.Errorf("Sub name cannot contain %v (%v)\n", SEP, str)
+		return nil, err
 	}
 
 	path := p.path
@@ -338,7 +566,7 @@ func (p *Path) Sub(str string) *Path {
 		path:     path,
 		varstore: p.varstore,
 		group:    p.group,
-	}
+	}, nil
 }
 
 // Device gets the device where the var-store variables are stored.
@@ -348,21 +576,25 @@ func (p *Path) Device() gotch.Device {
 }
 
 // NOTE: Cannot name as `path` as having a field name `path`
-func (p *Path) getpath(name string) string {
+func (p *Path) getpath(name string) (string, error) {
 
 	if strings.Contains(name, SEP) {
-		log.Fatalf("Sub name cannot contain %v (%v)\n", SEP, name)
+		err := fmt.Errorf("Sub name cannot contain %v (%v)\n", SEP, name)
+		return "", err
 	}
 
 	if len(p.path) == 0 {
-		return name
+		return name, nil
 	} else {
-		return fmt.Sprintf("%v%v%v", strings.Join(p.path, SEP), SEP, name)
+		return fmt.Sprintf("%v%v%v", strings.Join(p.path, SEP), SEP, name), nil
 	}
 }
 
-func (p *Path) add(name string, newTs *ts.Tensor, trainable bool) *ts.Tensor {
-	path := p.getpath(name)
+func (p *Path) add(name string, newTs *ts.Tensor, trainable bool) (*ts.Tensor, error) {
+	path, err := p.getpath(name)
+	if err != nil {
+		return nil, err
+	}
 
 	p.varstore.Vars.mutex.Lock()
 	defer p.varstore.Vars.mutex.Unlock()
@@ -371,17 +603,16 @@ func (p *Path) add(name string, newTs *ts.Tensor, trainable bool) *ts.Tensor {
 		path = fmt.Sprintf("%v__%v", path, len(p.varstore.Vars.NamedVariables))
 	}
 
-	var (
-		tensor *ts.Tensor
-		err    error
-	)
+	tensor, err := newTs.ShallowClone()
+	if err != nil {
+		return nil, err
+	}
 	if trainable {
-		tensor, err = newTs.MustShallowClone().SetRequiresGrad(true, false)
+		tensor, err = tensor.SetRequiresGrad(true, false)
 		if err != nil {
-			log.Fatalf("Path 'add' method error: %v\n", err)
+			err = fmt.Errorf("Path 'add' method error: %w\n", err)
+			return nil, err
 		}
-	} else {
-		tensor = newTs.MustShallowClone()
 	}
 
 	if trainable {
@@ -392,31 +623,47 @@ func (p *Path) add(name string, newTs *ts.Tensor, trainable bool) *ts.Tensor {
 		p.varstore.Vars.TrainableVariables = append(p.varstore.Vars.TrainableVariables, v)
 	}
 
+	// Variables live as long as the varstore. Don't let them be freed by
+	// the tensor scope they were created in.
+	ts.Untrack(tensor)
 	p.varstore.Vars.NamedVariables[path] = tensor
 
-	return tensor
+	return tensor, nil
 }
 
 // Add adds a tensor to a given path.
-func (p *Path) Add(name string, x *ts.Tensor, trainable bool) *ts.Tensor {
+func (p *Path) Add(name string, x *ts.Tensor, trainable bool) (*ts.Tensor, error) {
 	return p.add(name, x, trainable)
 }
 
-func (p *Path) getOrAddWithLock(name string, tensor *ts.Tensor, trainable bool, variables Variables) *ts.Tensor {
-	path := p.getpath(name)
+// MustAdd adds a tensor to a given path. It panics if error occurred.
+func (p *Path) MustAdd(name string, x *ts.Tensor, trainable bool) *ts.Tensor {
+	x, err := p.Add(name, x, trainable)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
+func (p *Path) getOrAddWithLock(name string, tensor *ts.Tensor, trainable bool, variables Variables) (*ts.Tensor, error) {
+	path, err := p.getpath(name)
+	if err != nil {
+		return nil, err
+	}
 
 	// if found, return it
 	if v, ok := variables.NamedVariables[path]; ok {
-		return v
+		return v, nil
 	}
 
 	// not found, add it
-	var err error
 	var ttensor *ts.Tensor
 	if trainable {
 		ttensor, err = tensor.SetRequiresGrad(true, false)
 		if err != nil {
-			log.Fatalf("Path - call method 'getOrAddWithLock' error: %v\n", err)
+			err = fmt.Errorf("Path - call method 'getOrAddWithLock' error: %w\n", err)
+			return nil, err
 		}
 	} else {
 		ttensor = tensor
@@ -430,9 +677,10 @@ func (p *Path) getOrAddWithLock(name string, tensor *ts.Tensor, trainable bool,
 		variables.TrainableVariables = append(variables.TrainableVariables, v)
 	}
 
+	ts.Untrack(ttensor)
 	variables.NamedVariables[path] = ttensor
 
-	return ttensor
+	return ttensor, nil
 }
 
 func (p *Path) SetGroup(g uint) {
@@ -445,34 +693,56 @@ func (p *Path) SetGroup(g uint) {
 // has the specified shape. The variable will not be trainable so
 // gradients will not be tracked.
 // The variable uses a float tensor initialized with zeros.
-func (p *Path) ZerosNoTrain(name string, dims []int64) *ts.Tensor {
+func (p *Path) ZerosNoTrain(name string, dims []int64) (*ts.Tensor, error) {
 
 	device := p.Device()
 	z, err := ts.Zeros(dims, gotch.Float, device)
 	if err != nil {
-		log.Fatalf("Path - 'ZerosNoTrain' method call error: %v\n", err)
+		err = fmt.Errorf("Path - 'ZerosNoTrain' method call error: %w\n", err)
+		return nil, err
 	}
 
 	return p.add(name, z, false)
 }
 
+// MustZerosNoTrain creates a new variable initialized with zeros. It panics if error occurred.
+func (p *Path) MustZerosNoTrain(name string, dims []int64) *ts.Tensor {
+	x, err := p.ZerosNoTrain(name, dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // OnesNoTrain creates a new variable initialized with ones.
 //
 // The new variable is named according to the name parameter and
 // has the specified shape. The variable will not be trainable so
 // gradients will not be tracked.
 // The variable uses a float tensor initialized with ones.
-func (p *Path) OnesNoTrain(name string, dims []int64) *ts.Tensor {
+func (p *Path) OnesNoTrain(name string, dims []int64) (*ts.Tensor, error) {
 
 	device := p.Device()
 	z, err := ts.Ones(dims, gotch.Float, device)
 	if err != nil {
-		log.Fatalf("Path - 'OnesNoTrain' method call error: %v\n", err)
+		err = fmt.Errorf("Path - 'OnesNoTrain' method call error: %w\n", err)
+		return nil, err
 	}
 
 	return p.add(name, z, false)
 }
 
+// MustOnesNoTrain creates a new variable initialized with ones. It panics if error occurred.
+func (p *Path) MustOnesNoTrain(name string, dims []int64) *ts.Tensor {
+	x, err := p.OnesNoTrain(name, dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // NewVar creates a new variable.
 //
 // The new variable is named according to the name parameter and
@@ -480,35 +750,67 @@ func (p *Path) OnesNoTrain(name string, dims []int64) *ts.Tensor {
 // will be tracked.
 // The variable uses a float tensor initialized as per the
 // related argument.
-func (p *Path) NewVar(name string, dims []int64, ini Init) *ts.Tensor {
-
-	v := ini.InitTensor(dims, p.varstore.device)
+func (p *Path) NewVar(name string, dims []int64, ini Init) (*ts.Tensor, error) {
+	v, err := ini.InitTensor(dims, p.varstore.device)
+	if err != nil {
+		return nil, err
+	}
 
 	return p.add(name, v, true)
 }
 
+// MustNewVar creates a new variable. It panics if error occurred.
+func (p *Path) MustNewVar(name string, dims []int64, ini Init) *ts.Tensor {
+	x, err := p.NewVar(name, dims, ini)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // Zeros creates a new variable initialized with zeros.
 //
 // The new variable is named according to the name parameter and
 // has the specified shape. The variable is trainable, its gradient
 // will be tracked.
 // The variable uses a float tensor initialized with zeros.
-func (p *Path) Zeros(name string, dims []int64) *ts.Tensor {
+func (p *Path) Zeros(name string, dims []int64) (*ts.Tensor, error) {
 
 	return p.NewVar(name, dims, NewConstInit(0.0))
 }
 
+// MustZeros creates a new variable initialized with zeros. It panics if error occurred.
+func (p *Path) MustZeros(name string, dims []int64) *ts.Tensor {
+	x, err := p.Zeros(name, dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // Ones creates a new variable initialized with ones.
 //
 // The new variable is named according to the name parameter and
 // has the specified shape. The variable is trainable, its gradient
 // will be tracked.
 // The variable uses a float tensor initialized with ones.
-func (p *Path) Ones(name string, dims []int64) *ts.Tensor {
+func (p *Path) Ones(name string, dims []int64) (*ts.Tensor, error) {
 
 	return p.NewVar(name, dims, NewConstInit(1.0))
 }
 
+// MustOnes creates a new variable initialized with ones. It panics if error occurred.
+func (p *Path) MustOnes(name string, dims []int64) *ts.Tensor {
+	x, err := p.Ones(name, dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // RandnStandard creates a new variable initialized randomly with normal distribution.
 //
 // The new variable is named according to the name parameter and
@@ -516,11 +818,21 @@ func (p *Path) Ones(name string, dims []int64) *ts.Tensor {
 // will be tracked.
 // The variable uses a float tensor initialized randomly using a
 // standard normal distribution.
-func (p *Path) RandnStandard(name string, dims []int64) *ts.Tensor {
+func (p *Path) RandnStandard(name string, dims []int64) (*ts.Tensor, error) {
 
 	return p.NewVar(name, dims, NewRandnInit(0.0, 1.0))
 }
 
+// MustRandnStandard creates a new variable initialized randomly with normal distribution. It panics if error occurred.
+func (p *Path) MustRandnStandard(name string, dims []int64) *ts.Tensor {
+	x, err := p.RandnStandard(name, dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // Randn creates a new variable initialized randomly with normal distribution.
 //
 // The new variable is named according to the name parameter and
@@ -528,11 +840,21 @@ func (p *Path) RandnStandard(name string, dims []int64) *ts.Tensor {
 // will be tracked.
 // The variable uses a float tensor initialized randomly using a
 // normal distribution with the specified mean and standard deviation.
-func (p *Path) Randn(name string, dims []int64, mean float64, stdev float64) *ts.Tensor {
+func (p *Path) Randn(name string, dims []int64, mean float64, stdev float64) (*ts.Tensor, error) {
 
 	return p.NewVar(name, dims, NewRandnInit(mean, stdev))
 }
 
+// MustRandn creates a new variable initialized randomly with normal distribution. It panics if error occurred.
+func (p *Path) MustRandn(name string, dims []int64, mean float64, stdev float64) *ts.Tensor {
+	x, err := p.Randn(name, dims, mean, stdev)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // Uniform creates a new variable initialized randomly with uniform distribution.
 //
 // The new variable is named according to the name parameter and
@@ -540,11 +862,21 @@ func (p *Path) Randn(name string, dims []int64, mean float64, stdev float64) *ts
 // will be tracked.
 // The variable uses a float tensor initialized randomly using a
 // uniform distribution between the specified bounds.
-func (p *Path) Uniform(name string, dims []int64, lo, up float64) *ts.Tensor {
+func (p *Path) Uniform(name string, dims []int64, lo, up float64) (*ts.Tensor, error) {
 
 	return p.NewVar(name, dims, NewUniformInit(lo, up))
 }
 
+// MustUniform creates a new variable initialized randomly with uniform distribution. It panics if error occurred.
+func (p *Path) MustUniform(name string, dims []int64, lo, up float64) *ts.Tensor {
+	x, err := p.Uniform(name, dims, lo, up)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // KaimingUniform creates a new variable initialized randomly with kaiming uniform.
 //
 // The new variable is named according to the name parameter and
@@ -552,11 +884,21 @@ func (p *Path) Uniform(name string, dims []int64, lo, up float64) *ts.Tensor {
 // will be tracked.
 // The variable uses a float tensor initialized randomly using a
 // uniform distribution which bounds follow Kaiming initialization.
-func (p *Path) KaimingUniform(name string, dims []int64) *ts.Tensor {
+func (p *Path) KaimingUniform(name string, dims []int64) (*ts.Tensor, error) {
 
 	return p.NewVar(name, dims, NewKaimingUniformInit())
 }
 
+// MustKaimingUniform creates a new variable initialized randomly with kaiming uniform. It panics if error occurred.
+func (p *Path) MustKaimingUniform(name string, dims []int64) *ts.Tensor {
+	x, err := p.KaimingUniform(name, dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // VarCopy creates a new variable initialized by copying an existing tensor.
 //
 // The new variable is named according to the name parameter and
@@ -564,19 +906,36 @@ func (p *Path) KaimingUniform(name string, dims []int64) *ts.Tensor {
 // will be tracked.
 // The variable uses a float tensor initialized by copying some
 // given tensor.
-func (p *Path) VarCopy(name string, t *ts.Tensor) *ts.Tensor {
+func (p *Path) VarCopy(name string, t *ts.Tensor) (*ts.Tensor, error) {
 
 	size, err := t.Size()
 	if err != nil {
-		log.Fatalf("Path - VarCopy method call error: %v\n", err)
+		err = fmt.Errorf("Path - VarCopy method call error: %w\n", err)
+		return nil, err
+	}
+	v, err := p.Zeros(name, size)
+	if err != nil {
+		return nil, err
 	}
-	v := p.Zeros(name, size)
 
 	ts.NoGrad(func() {
-		ts.Copy_(v, t)
+		err = ts.Copy_(v, t)
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return v
+	return v, nil
+}
+
+// MustVarCopy creates a new variable initialized by copying an existing tensor. It panics if error occurred.
+func (p *Path) MustVarCopy(name string, t *ts.Tensor) *ts.Tensor {
+	x, err := p.VarCopy(name, t)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
 }
 
 // Get gets the tensor corresponding to a given name if present.
@@ -615,74 +974,190 @@ func (p *Path) Entry(name string) *Entry {
 // var store, the corresponding tensor is returned. Otherwise a new
 // variable is added to the var-store with the entry name and is
 // initialized according to the init parameter.
-func (e *Entry) OrVar(dims []int64, init Init) *ts.Tensor {
+func (e *Entry) OrVar(dims []int64, init Init) (*ts.Tensor, error) {
+	v, err := init.InitTensor(dims, e.path.varstore.device)
+	if err != nil {
+		return nil, err
+	}
 
-	v := init.InitTensor(dims, e.path.varstore.device)
 	return e.path.getOrAddWithLock(e.name, v, true, *e.variables)
 }
 
+// MustOrVar returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrVar(dims []int64, init Init) *ts.Tensor {
+	x, err := e.OrVar(dims, init)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // Returns the existing entry if, otherwise create a new variable.
-func (e *Entry) OrVarCopy(tensor *ts.Tensor) *ts.Tensor {
+func (e *Entry) OrVarCopy(tensor *ts.Tensor) (*ts.Tensor, error) {
 
 	size, err := tensor.Size()
 	if err != nil {
-		log.Fatalf("Entry - OrVarCopy method call error: %v\n", err)
+		err = fmt.Errorf("Entry - OrVarCopy method call error: %w\n", err)
+		return nil, err
+	}
+	v, err := e.OrZeros(size)
+	if err != nil {
+		return nil, err
 	}
-	v := e.OrZeros(size)
 
 	ts.NoGrad(func() {
-		ts.Copy_(v, tensor)
+		err = ts.Copy_(v, tensor)
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return v
+	return v, nil
+}
+
+// MustOrVarCopy returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrVarCopy(tensor *ts.Tensor) *ts.Tensor {
+	x, err := e.OrVarCopy(tensor)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
 }
 
 // Returns the existing entry if, otherwise create a new variable.
-func (e *Entry) OrKaimingUniform(dims []int64) *ts.Tensor {
+func (e *Entry) OrKaimingUniform(dims []int64) (*ts.Tensor, error) {
 
 	return e.OrVar(dims, NewKaimingUniformInit())
 }
 
+// MustOrKaimingUniform returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrKaimingUniform(dims []int64) *ts.Tensor {
+	x, err := e.OrKaimingUniform(dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // OrOnes returns the existing entry if, otherwise create a new variable.
-func (e *Entry) OrOnes(dims []int64) *ts.Tensor {
+func (e *Entry) OrOnes(dims []int64) (*ts.Tensor, error) {
 
 	return e.OrVar(dims, NewConstInit(1.0))
 }
 
+// MustOrOnes returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrOnes(dims []int64) *ts.Tensor {
+	x, err := e.OrOnes(dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // OrOnesNoTrain returns the existing entry if, otherwise create a new variable.
-func (e *Entry) OrOnesNoTrain(dims []int64) *ts.Tensor {
+func (e *Entry) OrOnesNoTrain(dims []int64) (*ts.Tensor, error) {
 
-	o := ts.MustOnes(dims, gotch.Float, e.path.Device())
+	o, err := ts.Ones(dims, gotch.Float, e.path.Device())
+	if err != nil {
+		return nil, err
+	}
 	return e.path.getOrAddWithLock(e.name, o, true, *e.variables)
 }
 
+// MustOrOnesNoTrain returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrOnesNoTrain(dims []int64) *ts.Tensor {
+	x, err := e.OrOnesNoTrain(dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // OrRandn returns the existing entry if, otherwise create a new variable.
-func (e *Entry) OrRandn(dims []int64, mean, stdev float64) *ts.Tensor {
+func (e *Entry) OrRandn(dims []int64, mean, stdev float64) (*ts.Tensor, error) {
 
 	return e.OrVar(dims, NewRandnInit(mean, stdev))
 }
 
+// MustOrRandn returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrRandn(dims []int64, mean, stdev float64) *ts.Tensor {
+	x, err := e.OrRandn(dims, mean, stdev)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // OrRandnStandard returns the existing entry if, otherwise create a new variable.
-func (e *Entry) OrRandnStandard(dims []int64) *ts.Tensor {
+func (e *Entry) OrRandnStandard(dims []int64) (*ts.Tensor, error) {
 
 	return e.OrVar(dims, NewRandnInit(0.0, 1.0))
 }
 
+// MustOrRandnStandard returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrRandnStandard(dims []int64) *ts.Tensor {
+	x, err := e.OrRandnStandard(dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // OrUniform returns the existing entry if, otherwise create a new variable.
-func (e *Entry) OrUniform(dims []int64, lo, up float64) (retVal *ts.Tensor) {
+func (e *Entry) OrUniform(dims []int64, lo, up float64) (*ts.Tensor, error) {
 
 	return e.OrVar(dims, NewUniformInit(lo, up))
 }
 
+// MustOrUniform returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrUniform(dims []int64, lo, up float64) *ts.Tensor {
+	x, err := e.OrUniform(dims, lo, up)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // OrZeros returns the existing entry if, otherwise create a new variable.
-func (e *Entry) OrZeros(dims []int64) *ts.Tensor {
+func (e *Entry) OrZeros(dims []int64) (*ts.Tensor, error) {
 
 	return e.OrVar(dims, NewConstInit(0.0))
 }
 
+// MustOrZeros returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrZeros(dims []int64) *ts.Tensor {
+	x, err := e.OrZeros(dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
 // OrZerosNoTrain returns the existing entry if, otherwise create a new variable.
-func (e *Entry) OrZerosNoTrain(dims []int64) *ts.Tensor {
+func (e *Entry) OrZerosNoTrain(dims []int64) (*ts.Tensor, error) {
 
-	z := ts.MustZeros(dims, gotch.Float, e.path.Device())
+	z, err := ts.Zeros(dims, gotch.Float, e.path.Device())
+	if err != nil {
+		return nil, err
+	}
 	return e.path.getOrAddWithLock(e.name, z, true, *e.variables)
 }
+
+// MustOrZerosNoTrain returns the existing entry if, otherwise create a new variable. It panics if error occurred.
+func (e *Entry) MustOrZerosNoTrain(dims []int64) *ts.Tensor {
+	x, err := e.OrZerosNoTrain(dims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
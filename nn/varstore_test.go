@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/sugarme/gotch"
@@ -15,9 +16,9 @@ func TestVarStoreEntry(t *testing.T) {
 	vs := nn.NewVarStore(gotch.CPU)
 	root := vs.Root()
 	e1 := root.Entry("key")
-	t1 := e1.OrZeros([]int64{3, 1, 4})
+	t1 := e1.MustOrZeros([]int64{3, 1, 4})
 	e2 := root.Entry("key")
-	t2 := e2.OrZeros([]int64{1, 5, 9})
+	t2 := e2.MustOrZeros([]int64{1, 5, 9})
 
 	wantT1 := []int64{3, 1, 4}
 	wantT2 := []int64{3, 1, 4}
@@ -36,6 +37,79 @@ func TestVarStoreEntry(t *testing.T) {
 	}
 }
 
+func TestVarStoreFreeze(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	x := root.MustZeros("x", []int64{2})
+
+	if err := vs.Freeze(); err != nil {
+		t.Fatal(err)
+	}
+	if x.MustRequiresGrad() {
+		t.Errorf("Expected frozen variable not requiring grad\n")
+	}
+
+	if err := vs.Unfreeze(); err != nil {
+		t.Fatal(err)
+	}
+	if !x.MustRequiresGrad() {
+		t.Errorf("Expected unfrozen variable requiring grad\n")
+	}
+
+	// Invalid name returns error instead of exiting.
+	if _, err := root.Zeros("a.b", []int64{2}); err == nil {
+		t.Errorf("Expected error for invalid variable name\n")
+	}
+}
+
+func TestVarStoreRegisterHooks(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	w := root.MustOnes("w", []int64{2})
+	b := root.MustZeros("b", []int64{2})
+	_ = root.MustZerosNoTrain("c", []int64{2})
+
+	var names []string
+	handles, err := vs.RegisterHooks(func(name string, grad *ts.Tensor) *ts.Tensor {
+		names = append(names, name)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clip gradient of w to zero.
+	h, err := vs.RegisterHook("w", func(grad *ts.Tensor) *ts.Tensor {
+		return grad.MustMul1(ts.FloatScalar(0.0), false)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handles = append(handles, h)
+
+	loss := w.MustAdd(b, false).MustSum(gotch.Float, true)
+	loss.MustBackward()
+
+	sort.Strings(names)
+	wantNames := []string{"b", "w"}
+	if !reflect.DeepEqual(wantNames, names) {
+		t.Errorf("Expected names: %v\n", wantNames)
+		t.Errorf("Got names: %v\n", names)
+	}
+
+	wantGrad := []float64{0, 0}
+	gotGrad := w.MustGrad(false).Float64Values()
+	if !reflect.DeepEqual(wantGrad, gotGrad) {
+		t.Errorf("Expected grad: %v\n", wantGrad)
+		t.Errorf("Got grad: %v\n", gotGrad)
+	}
+
+	for _, h := range handles {
+		h.MustRemove()
+	}
+	loss.MustDrop()
+}
+
 // NOTE: comment out for working on Travis.
 // uncomment to test locally
 
@@ -49,14 +123,14 @@ func TestSaveLoad(t *testing.T) {
 	add := func(vs *nn.Path) (*ts.Tensor, *ts.Tensor) {
 		subA := vs.Sub("a")
 		subB := subA.Sub("b")
-		v := subB.Ones("t2", []int64{3})
-		u := vs.Zeros("t1", []int64{4})
+		v := subB.MustOnes("t2", []int64{3})
+		u := vs.MustZeros("t1", []int64{4})
 
 		wa := vs.Sub("a")
 		wb := wa.Sub("b")
 		wc := wb.Sub("ccc")
-		_ = wc.Ones("t123", []int64{3})
-		_ = wc.Ones("t123", []int64{3})
+		_ = wc.MustOnes("t123", []int64{3})
+		_ = wc.MustOnes("t123", []int64{3})
 
 		return u, v
 	}
@@ -133,3 +207,127 @@ func TestSaveLoad(t *testing.T) {
 		t.Errorf("Failed deleting varstore saved file: %v\n", filenameAbs)
 	}
 }
+
+func TestSaveLoadSafetensors(t *testing.T) {
+	filename := "vsload-safetensors.test"
+	filenameAbs, err := filepath.Abs(filename)
+	if err != nil {
+		panic(err)
+	}
+
+	add := func(vs *nn.Path) (*ts.Tensor, *ts.Tensor) {
+		u := vs.MustZeros("t1", []int64{4})
+		v := vs.Sub("a").MustOnes("t2", []int64{3})
+
+		return u, v
+	}
+
+	vs1 := nn.NewVarStore(gotch.CPU)
+	vs2 := nn.NewVarStore(gotch.CPU)
+
+	u1, v1 := add(vs1.Root())
+	u2, v2 := add(vs2.Root())
+
+	ts.NoGrad(func() {
+		u1.Add1_(ts.FloatScalar(42.0))
+		v1.Mul1_(ts.FloatScalar(2.0))
+	})
+
+	err = vs1.SaveSafetensors(filenameAbs)
+	if err != nil {
+		panic(err)
+	}
+
+	err = vs2.LoadSafetensors(filenameAbs)
+	if err != nil {
+		panic(err)
+	}
+
+	wantU2 := float64(42.0)
+	wantV2 := float64(2.0)
+	gotU2 := u2.MustMean(gotch.Float, false).Float64Values()[0]
+	gotV2 := v2.MustMean(gotch.Float, false).Float64Values()[0]
+
+	if !reflect.DeepEqual(wantU2, gotU2) {
+		t.Errorf("Expected u2: %v\n", wantU2)
+		t.Errorf("Got u2: %v\n", gotU2)
+	}
+	if !reflect.DeepEqual(wantV2, gotV2) {
+		t.Errorf("Expected v2: %v\n", wantV2)
+		t.Errorf("Got v2: %v\n", gotV2)
+	}
+
+	err = os.Remove(filenameAbs)
+	if err != nil {
+		t.Errorf("Failed deleting varstore saved file: %v\n", filenameAbs)
+	}
+}
+
+func TestLoadLazy(t *testing.T) {
+	add := func(vs *nn.Path) (*ts.Tensor, *ts.Tensor) {
+		u := vs.MustZeros("t1", []int64{4})
+		v := vs.Sub("a").MustOnes("t2", []int64{3})
+
+		return u, v
+	}
+
+	vs1 := nn.NewVarStore(gotch.CPU)
+	u1, v1 := add(vs1.Root())
+	ts.NoGrad(func() {
+		u1.Add1_(ts.FloatScalar(42.0))
+		v1.Mul1_(ts.FloatScalar(2.0))
+	})
+
+	safetensorsFile, err := filepath.Abs("vsload-lazy.safetensors")
+	if err != nil {
+		panic(err)
+	}
+	npzFile, err := filepath.Abs("vsload-lazy.npz")
+	if err != nil {
+		panic(err)
+	}
+	defer os.Remove(safetensorsFile)
+	defer os.Remove(npzFile)
+
+	if err := vs1.SaveSafetensors(safetensorsFile); err != nil {
+		panic(err)
+	}
+	namedTensors := []ts.NamedTensor{{Name: "t1", Tensor: u1}, {Name: "a.t2", Tensor: v1}}
+	if err := ts.WriteNpz(namedTensors, npzFile, false); err != nil {
+		panic(err)
+	}
+
+	for _, file := range []string{safetensorsFile, npzFile} {
+		// load subset
+		vs2 := nn.NewVarStore(gotch.CPU)
+		u2, v2 := add(vs2.Root())
+		if err := vs2.LoadLazy(file, "a.t2"); err != nil {
+			t.Fatal(err)
+		}
+
+		want := []float64{0, 2}
+		got := []float64{
+			u2.MustMean(gotch.Float, false).Float64Values()[0],
+			v2.MustMean(gotch.Float, false).Float64Values()[0],
+		}
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("Expected: %v\n", want)
+			t.Errorf("Got: %v\n", got)
+		}
+
+		// load all
+		if err := vs2.LoadLazy(file); err != nil {
+			t.Fatal(err)
+		}
+
+		want = []float64{42, 2}
+		got = []float64{
+			u2.MustMean(gotch.Float, false).Float64Values()[0],
+			v2.MustMean(gotch.Float, false).Float64Values()[0],
+		}
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("Expected: %v\n", want)
+			t.Errorf("Got: %v\n", got)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package gotch
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// rngSource is a splitmix64 random source whose state is a single uint64 so
+// that it can be saved and restored. It is safe for concurrent use.
+type rngSource struct {
+	mu    sync.Mutex
+	state uint64
+}
+
+// Seed implements rand.Source.
+func (s *rngSource) Seed(seed int64) {
+	s.mu.Lock()
+	s.state = uint64(seed)
+	s.mu.Unlock()
+}
+
+// Uint64 implements rand.Source64.
+func (s *rngSource) Uint64() uint64 {
+	s.mu.Lock()
+	s.state += splitmixGamma
+	z := s.state
+	s.mu.Unlock()
+
+	return splitmixMix(z)
+}
+
+// splitmixGamma is the state increment of splitmix64.
+const splitmixGamma = 0x9e3779b97f4a7c15
+
+// splitmixMix is the output mixing function of splitmix64.
+func splitmixMix(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Int63 implements rand.Source.
+func (s *rngSource) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+var (
+	rngSrc = &rngSource{state: uint64(time.Now().UnixNano())}
+	rng    = rand.New(rngSrc)
+)
+
+// Rand returns the random number generator used by Go code of gotch packages
+// (e.g. samplers in `dutil` and random transforms in `vision/aug`).
+// It is seeded from current time unless `ManualSeed` is called and is safe
+// for concurrent use.
+func Rand() *rand.Rand {
+	return rng
+}
+
+// ManualSeed seeds libtorch random number generators (CPU and CUDA) and
+// the Go random number generator returned by `Rand` to make runs
+// reproducible.
+func ManualSeed(seed int64) {
+	lib.AtManualSeed(seed)
+	rngSrc.Seed(seed)
+}
+
+// SeedFor derives a seed of given epoch (or any other stream index) from
+// seed, e.g. to reshuffle a sampler each epoch reproducibly. As the splitmix64
+// steps used are bijections, epochs of the same seed get different seeds and,
+// unlike seed + epoch, seeds do not collide with shifted epochs of other
+// seeds.
+func SeedFor(seed int64, epoch int) int64 {
+	z := splitmixMix(uint64(seed) + splitmixGamma)
+	return int64(splitmixMix(z + uint64(epoch) + splitmixGamma))
+}
+
+// RandState returns current state of the Go random number generator
+// returned by `Rand`.
+func RandState() uint64 {
+	rngSrc.mu.Lock()
+	defer rngSrc.mu.Unlock()
+
+	return rngSrc.state
+}
+
+// SetRandState restores state of the Go random number generator returned by
+// `Rand` from a value returned by `RandState`.
+func SetRandState(state uint64) {
+	rngSrc.mu.Lock()
+	rngSrc.state = state
+	rngSrc.mu.Unlock()
+}
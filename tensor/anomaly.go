@@ -0,0 +1,45 @@
+package tensor
+
+import (
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// AnomalyModeSetEnabled sets whether anomaly detection is enabled. It returns
+// PREVIOUS state.
+//
+// When it is enabled, `Backward` and `RunBackward` fail when a backward
+// function returns gradients containing NaN or Inf values, instead of
+// propagating them to gradients of leaf tensors. The error names the backward
+// function of the offending op, e.g.:
+//
+//	Function 'SqrtBackward' returned nan or inf values in its 0th output.
+//
+// The setting is global to the process (not per goroutine) and it slows down
+// backward pass as every gradient is checked. It is meant for debugging.
+func AnomalyModeSetEnabled(b bool) bool {
+	prev := lib.AtAnomalyEnabled()
+	lib.AtSetAnomalyEnabled(b)
+
+	return prev
+}
+
+// AnomalyModeEnabled returns whether anomaly detection is enabled.
+func AnomalyModeEnabled() bool {
+	return lib.AtAnomalyEnabled()
+}
+
+// DetectAnomaly runs fn with anomaly detection enabled. See
+// `AnomalyModeSetEnabled`.
+//
+// Example:
+//
+//	var err error
+//	ts.DetectAnomaly(func() {
+//		err = opt.BackwardStep(loss)
+//	})
+func DetectAnomaly(fn func()) {
+	prev := AnomalyModeSetEnabled(true)
+	defer AnomalyModeSetEnabled(prev)
+
+	fn()
+}
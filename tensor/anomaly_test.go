@@ -0,0 +1,44 @@
+package tensor_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestDetectAnomaly(t *testing.T) {
+	x := ts.MustOfSlice([]float64{0, 1}).MustSetRequiresGrad(true, true)
+	defer x.MustDrop()
+
+	// Gradient of sqrt at 0 is inf.
+	backward := func() error {
+		y := x.MustSqrt(false)
+		loss := y.MustSum(y.DType(), true)
+		defer loss.MustDrop()
+		return loss.Backward()
+	}
+
+	if err := backward(); err != nil {
+		t.Fatal(err)
+	}
+	grad := x.MustGrad(false)
+	if !math.IsInf(grad.Float64Values()[0], 1) {
+		t.Errorf("Want: %v\n", math.Inf(1))
+		t.Errorf("Got: %v\n", grad.Float64Values()[0])
+	}
+	grad.MustDrop()
+
+	var err error
+	ts.DetectAnomaly(func() {
+		err = backward()
+	})
+	if err == nil || !strings.Contains(err.Error(), "SqrtBackward") {
+		t.Errorf("Want error naming SqrtBackward\n")
+		t.Errorf("Got: %v\n", err)
+	}
+	if ts.AnomalyModeEnabled() {
+		t.Errorf("Want anomaly mode disabled after DetectAnomaly\n")
+	}
+}
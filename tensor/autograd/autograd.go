@@ -0,0 +1,156 @@
+package autograd
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// Func is a function of tensors to be differentiated by `VJP`, `JVP`,
+// `Jacobian` and `Hessian`. It should compute its outputs from inputs with
+// tensor operations (which track gradients) and must not drop the inputs.
+// Outputs are dropped by the caller and should be new tensors or the inputs
+// themselves.
+type Func func(inputs []*ts.Tensor) ([]*ts.Tensor, error)
+
+// Grad computes gradients of `outputs` with respect to `inputs`, one for each
+// input. The inputs must require grad. Unlike `Backward`, gradients are
+// returned instead of being accumulated to `Grad` of the inputs.
+//
+// `gradOutputs` are vectors of vector-Jacobian products, one for each output
+// with the same shape. If it is nil (or an element is nil), ones are used, i.e.
+// gradient of sum of the outputs is computed.
+//
+// If `retainGraph` is false, graph of the outputs is freed and backward cannot
+// be run through it again. If `createGraph` is true, graph of the gradients is
+// created (and graph of the outputs is retained) so that they can be
+// differentiated again (double backward).
+//
+// Gradient of an input which outputs do not depend on is zeros.
+func Grad(outputs, inputs, gradOutputs []*ts.Tensor, retainGraph, createGraph bool) ([]*ts.Tensor, error) {
+	if len(inputs) == 0 {
+		err := fmt.Errorf("Grad() failed: empty inputs.\n")
+		return nil, err
+	}
+	if gradOutputs != nil && len(gradOutputs) != len(outputs) {
+		err := fmt.Errorf("Grad() failed: expected %v gradOutputs, got %v.\n", len(outputs), len(gradOutputs))
+		return nil, err
+	}
+
+	restore, err := enableGrad()
+	if err != nil {
+		return nil, err
+	}
+	defer restore()
+
+	// Backward from y * v (with gradient of ones) gives vector-Jacobian
+	// product of y and v. Outputs not requiring grad have no gradient.
+	var (
+		roots []ts.Tensor
+		temps []*ts.Tensor
+	)
+	defer func() {
+		dropAll(temps)
+	}()
+	for i, y := range outputs {
+		if y == nil {
+			err := fmt.Errorf("Grad() failed: output at %v is nil.\n", i)
+			return nil, err
+		}
+		requiresGrad, err := y.RequiresGrad()
+		if err != nil {
+			return nil, err
+		}
+		if !requiresGrad {
+			continue
+		}
+
+		root := y
+		if gradOutputs != nil && gradOutputs[i] != nil {
+			root, err = y.Mul(gradOutputs[i], false)
+			if err != nil {
+				return nil, err
+			}
+			temps = append(temps, root)
+		}
+		roots = append(roots, *root)
+	}
+
+	xs := make([]ts.Tensor, len(inputs))
+	for i, x := range inputs {
+		if x == nil {
+			err := fmt.Errorf("Grad() failed: input at %v is nil.\n", i)
+			return nil, err
+		}
+		xs[i] = *x
+	}
+
+	var gs []ts.Tensor
+	if len(roots) > 0 {
+		gs, err = ts.RunBackward(roots, xs, retainGraph || createGraph, createGraph)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	grads := make([]*ts.Tensor, len(inputs))
+	for i, x := range inputs {
+		if i < len(gs) {
+			g := gs[i]
+			if g.MustDefined() {
+				grads[i] = &g
+				continue
+			}
+			g.MustDrop()
+		}
+
+		grads[i], err = x.ZerosLike(false)
+		if err != nil {
+			dropAll(grads)
+			for _, g := range gs[i+1:] {
+				g.MustDrop()
+			}
+			return nil, err
+		}
+	}
+
+	return grads, nil
+}
+
+// MustGrad computes gradients of `outputs` with respect to `inputs`. It panics
+// if error occurred.
+func MustGrad(outputs, inputs, gradOutputs []*ts.Tensor, retainGraph, createGraph bool) []*ts.Tensor {
+	grads, err := Grad(outputs, inputs, gradOutputs, retainGraph, createGraph)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return grads
+}
+
+// enableGrad enables grad mode, locking the calling goroutine to its OS thread
+// where grad mode is set, and returns a func to restore it.
+func enableGrad() (func(), error) {
+	runtime.LockOSThread()
+	prev, err := ts.GradSetEnabled(true)
+	if err != nil {
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+
+	return func() {
+		ts.MustGradSetEnabled(prev)
+		runtime.UnlockOSThread()
+	}, nil
+}
+
+// dropAll drops non-nil tensors.
+func dropAll(tensors []*ts.Tensor) {
+	for _, x := range tensors {
+		if x != nil {
+			x.MustDrop()
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package autograd_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+	"github.com/sugarme/gotch/tensor/autograd"
+)
+
+// square computes x * x.
+func square(inputs []*ts.Tensor) ([]*ts.Tensor, error) {
+	return []*ts.Tensor{inputs[0].MustMul(inputs[0], false)}, nil
+}
+
+// sumCubic computes sum(x * x * y + x * y).
+func sumCubic(inputs []*ts.Tensor) ([]*ts.Tensor, error) {
+	x, y := inputs[0], inputs[1]
+	xy := x.MustMul(y, false)
+	z := x.MustMul(xy, false).MustAdd(xy, true)
+	xy.MustDrop()
+	return []*ts.Tensor{z.MustSum(gotch.Double, true)}, nil
+}
+
+func TestGrad(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3}).MustSetRequiresGrad(true, true)
+	y := x.MustMul(x, false).MustMul(x, true)
+
+	// dy/dx = 3x^2
+	grads := autograd.MustGrad([]*ts.Tensor{y}, []*ts.Tensor{x}, nil, false, true)
+	want := []float64{3, 12, 27}
+	if !reflect.DeepEqual(want, grads[0].Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", grads[0].Float64Values())
+	}
+
+	// Double backward: d2y/dx2 = 6x
+	grads2 := autograd.MustGrad(grads, []*ts.Tensor{x}, nil, false, false)
+	want = []float64{6, 12, 18}
+	if !reflect.DeepEqual(want, grads2[0].Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", grads2[0].Float64Values())
+	}
+
+	grads2[0].MustDrop()
+	grads[0].MustDrop()
+	y.MustDrop()
+	x.MustDrop()
+}
+
+func TestVJPAndJVP(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3})
+	v := ts.MustOfSlice([]float64{1, 0, 2})
+
+	outputs, vjp := autograd.MustVJP(square, []*ts.Tensor{x}, []*ts.Tensor{v}, false)
+	want := []float64{1, 4, 9}
+	if !reflect.DeepEqual(want, outputs[0].Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", outputs[0].Float64Values())
+	}
+	want = []float64{2, 0, 12}
+	if !reflect.DeepEqual(want, vjp[0].Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", vjp[0].Float64Values())
+	}
+
+	// Jacobian of x*x is diagonal, so JVP equals VJP.
+	outputs2, jvp := autograd.MustJVP(square, []*ts.Tensor{x}, []*ts.Tensor{v}, false)
+	if !reflect.DeepEqual(want, jvp[0].Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", jvp[0].Float64Values())
+	}
+
+	for _, x := range []*ts.Tensor{outputs[0], vjp[0], outputs2[0], jvp[0], v, x} {
+		x.MustDrop()
+	}
+}
+
+func TestJacobianAndHessian(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2})
+	y := ts.MustOfSlice([]float64{3, 4})
+
+	jacobian := autograd.MustJacobian(square, []*ts.Tensor{x}, false)
+	want := []float64{2, 0, 0, 4}
+	if !reflect.DeepEqual(want, jacobian[0][0].Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", jacobian[0][0].Float64Values())
+	}
+	wantShape := []int64{2, 2}
+	if !reflect.DeepEqual(wantShape, jacobian[0][0].MustSize()) {
+		t.Errorf("Want: %v\n", wantShape)
+		t.Errorf("Got: %v\n", jacobian[0][0].MustSize())
+	}
+
+	// f = sum(x^2 y + x y): d2f/dx2 = diag(2y), d2f/dxdy = diag(2x + 1),
+	// d2f/dy2 = 0.
+	hessian := autograd.MustHessian(sumCubic, []*ts.Tensor{x, y}, false)
+	wants := [][][]float64{
+		{{6, 0, 0, 8}, {3, 0, 0, 5}},
+		{{3, 0, 0, 5}, {0, 0, 0, 0}},
+	}
+	for i := range wants {
+		for j := range wants[i] {
+			if !reflect.DeepEqual(wants[i][j], hessian[i][j].Float64Values()) {
+				t.Errorf("Want: %v\n", wants[i][j])
+				t.Errorf("Got: %v\n", hessian[i][j].Float64Values())
+			}
+			hessian[i][j].MustDrop()
+		}
+	}
+
+	jacobian[0][0].MustDrop()
+	y.MustDrop()
+	x.MustDrop()
+}
+
+// wrongSquare computes x * x with a wrong gradient x.
+type wrongSquare struct{}
+
+func (wrongSquare) Forward(ctx *ts.FunctionCtx, inputs []*ts.Tensor) ([]*ts.Tensor, error) {
+	if err := ctx.SaveForBackward(inputs[0]); err != nil {
+		return nil, err
+	}
+	return []*ts.Tensor{inputs[0].MustMul(inputs[0], false)}, nil
+}
+
+func (wrongSquare) Backward(ctx *ts.FunctionCtx, gradOutputs []*ts.Tensor) ([]*ts.Tensor, error) {
+	saved, err := ctx.SavedTensors()
+	if err != nil {
+		return nil, err
+	}
+	return []*ts.Tensor{gradOutputs[0].MustMul(saved[0], false)}, nil
+}
+
+func TestGradCheck(t *testing.T) {
+	x := ts.MustOfSlice([]float32{1, 2})
+	y := ts.MustOfSlice([]float32{3, 4})
+	defer x.MustDrop()
+	defer y.MustDrop()
+
+	if err := autograd.GradCheck(sumCubic, []*ts.Tensor{x, y}, 1e-6, 1e-5); err != nil {
+		t.Errorf("Want: %v\n", nil)
+		t.Errorf("Got: %v\n", err)
+	}
+
+	fn := func(inputs []*ts.Tensor) ([]*ts.Tensor, error) {
+		return ts.ApplyFunction(wrongSquare{}, inputs...)
+	}
+	if err := autograd.GradCheck(fn, []*ts.Tensor{x}, 1e-6, 1e-5); err == nil {
+		t.Errorf("Want error checking wrong gradient\n")
+	}
+}
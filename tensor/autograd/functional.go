@@ -0,0 +1,411 @@
+package autograd
+
+import (
+	"fmt"
+	"log"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// VJP computes outputs of fn at `inputs` and vector-Jacobian products of the
+// outputs with `v`, i.e. gradients of sum(outputs[i] * v[i]) with respect to
+// the inputs, one for each input. If v is nil (or an element is nil), ones are
+// used.
+//
+// If `createGraph` is true, the results can be differentiated again with
+// respect to the inputs (which require grad), otherwise they are detached.
+func VJP(fn Func, inputs, v []*ts.Tensor, createGraph bool) (outputs, vjp []*ts.Tensor, err error) {
+	restore, err := enableGrad()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer restore()
+
+	xs, err := prepareInputs(inputs, createGraph)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dropAll(xs)
+
+	ys, err := call(fn, xs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vjp, err = Grad(ys, xs, v, createGraph, createGraph)
+	if err != nil {
+		dropAll(ys)
+		return nil, nil, err
+	}
+
+	outputs, err = detachOutputs(ys, createGraph)
+	if err != nil {
+		dropAll(vjp)
+		return nil, nil, err
+	}
+
+	return outputs, vjp, nil
+}
+
+// MustVJP computes outputs of fn and vector-Jacobian products of them with
+// `v`. It panics if error occurred.
+func MustVJP(fn Func, inputs, v []*ts.Tensor, createGraph bool) (outputs, vjp []*ts.Tensor) {
+	outputs, vjp, err := VJP(fn, inputs, v, createGraph)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return outputs, vjp
+}
+
+// JVP computes outputs of fn at `inputs` and Jacobian-vector products of the
+// outputs with `v`, i.e. directional derivatives of the outputs along v (one
+// for each input with the same shape), one for each output. If an element of
+// v is nil, ones are used.
+//
+// It runs backward twice (double backward trick), so fn should be twice
+// differentiable with respect to its inputs. If `createGraph` is true, the
+// results can be differentiated again with respect to the inputs (which
+// require grad), otherwise they are detached.
+func JVP(fn Func, inputs, v []*ts.Tensor, createGraph bool) (outputs, jvp []*ts.Tensor, err error) {
+	if len(v) != len(inputs) {
+		err := fmt.Errorf("JVP() failed: expected %v v, got %v.\n", len(inputs), len(v))
+		return nil, nil, err
+	}
+
+	restore, err := enableGrad()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer restore()
+
+	xs, err := prepareInputs(inputs, createGraph)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dropAll(xs)
+
+	ys, err := call(fn, xs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// g(u) = J^T u is linear in u and its vector-Jacobian product with v is
+	// J v.
+	us := make([]*ts.Tensor, 0, len(ys))
+	defer func() {
+		dropAll(us)
+	}()
+	for _, y := range ys {
+		u, err := y.ZerosLike(false)
+		if err == nil {
+			u, err = u.SetRequiresGrad(true, true)
+		}
+		if err != nil {
+			dropAll(ys)
+			return nil, nil, err
+		}
+		us = append(us, u)
+	}
+
+	gs, err := Grad(ys, xs, us, true, true)
+	if err != nil {
+		dropAll(ys)
+		return nil, nil, err
+	}
+	defer dropAll(gs)
+
+	jvp, err = Grad(gs, us, v, createGraph, createGraph)
+	if err != nil {
+		dropAll(ys)
+		return nil, nil, err
+	}
+
+	outputs, err = detachOutputs(ys, createGraph)
+	if err != nil {
+		dropAll(jvp)
+		return nil, nil, err
+	}
+
+	return outputs, jvp, nil
+}
+
+// MustJVP computes outputs of fn and Jacobian-vector products of them with
+// `v`. It panics if error occurred.
+func MustJVP(fn Func, inputs, v []*ts.Tensor, createGraph bool) (outputs, jvp []*ts.Tensor) {
+	outputs, jvp, err := JVP(fn, inputs, v, createGraph)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return outputs, jvp
+}
+
+// Jacobian computes Jacobians of outputs of fn with respect to `inputs`.
+// jacobian[i][j] is Jacobian of output i with respect to input j, of shape
+// (output i shape..., input j shape...).
+//
+// It runs one backward pass per element of the outputs. If `createGraph` is
+// true, the results can be differentiated again with respect to the inputs
+// (which require grad).
+func Jacobian(fn Func, inputs []*ts.Tensor, createGraph bool) ([][]*ts.Tensor, error) {
+	restore, err := enableGrad()
+	if err != nil {
+		return nil, err
+	}
+	defer restore()
+
+	xs, err := prepareInputs(inputs, createGraph)
+	if err != nil {
+		return nil, err
+	}
+	defer dropAll(xs)
+
+	ys, err := call(fn, xs)
+	if err != nil {
+		return nil, err
+	}
+	defer dropAll(ys)
+
+	jacobian := make([][]*ts.Tensor, len(ys))
+	for i, y := range ys {
+		jacobian[i], err = jacobianOf(y, xs, createGraph)
+		if err != nil {
+			for _, row := range jacobian[:i] {
+				dropAll(row)
+			}
+			return nil, err
+		}
+	}
+
+	return jacobian, nil
+}
+
+// MustJacobian computes Jacobians of outputs of fn with respect to `inputs`.
+// It panics if error occurred.
+func MustJacobian(fn Func, inputs []*ts.Tensor, createGraph bool) [][]*ts.Tensor {
+	jacobian, err := Jacobian(fn, inputs, createGraph)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return jacobian
+}
+
+// Hessian computes Hessian of fn, which returns a single scalar output, with
+// respect to `inputs`. hessian[i][j] is second derivative with respect to
+// input i and input j, of shape (input i shape..., input j shape...).
+//
+// If `createGraph` is true, the results can be differentiated again with
+// respect to the inputs (which require grad).
+func Hessian(fn Func, inputs []*ts.Tensor, createGraph bool) ([][]*ts.Tensor, error) {
+	gradFn := func(xs []*ts.Tensor) ([]*ts.Tensor, error) {
+		ys, err := call(fn, xs)
+		if err != nil {
+			return nil, err
+		}
+		defer dropAll(ys)
+
+		if len(ys) != 1 || ys[0].Numel() != 1 {
+			err := fmt.Errorf("Hessian() failed: fn should return a single scalar output.\n")
+			return nil, err
+		}
+
+		return Grad(ys, xs, nil, true, true)
+	}
+
+	return Jacobian(gradFn, inputs, createGraph)
+}
+
+// MustHessian computes Hessian of fn with respect to `inputs`. It panics if
+// error occurred.
+func MustHessian(fn Func, inputs []*ts.Tensor, createGraph bool) [][]*ts.Tensor {
+	hessian, err := Hessian(fn, inputs, createGraph)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return hessian
+}
+
+// jacobianOf computes Jacobian of y with respect to each of xs, one row (i.e.
+// one backward pass) for each element of y.
+func jacobianOf(y *ts.Tensor, xs []*ts.Tensor, createGraph bool) ([]*ts.Tensor, error) {
+	yshape, err := y.Size()
+	if err != nil {
+		return nil, err
+	}
+	device, err := y.Device()
+	if err != nil {
+		return nil, err
+	}
+	n := int64(y.Numel())
+
+	eye, err := ts.Eye(n, y.DType(), device)
+	if err != nil {
+		return nil, err
+	}
+	defer eye.MustDrop()
+
+	// rows[j][k] is gradient of element k of y with respect to xs[j].
+	rows := make([][]ts.Tensor, len(xs))
+	defer func() {
+		for _, row := range rows {
+			for _, g := range row {
+				g.MustDrop()
+			}
+		}
+	}()
+	for k := int64(0); k < n; k++ {
+		v, err := eye.Select(0, k, false)
+		if err == nil {
+			v, err = v.Reshape(yshape, true)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		gs, err := Grad([]*ts.Tensor{y}, xs, []*ts.Tensor{v}, true, createGraph)
+		v.MustDrop()
+		if err != nil {
+			return nil, err
+		}
+		for j, g := range gs {
+			rows[j] = append(rows[j], *g)
+		}
+	}
+
+	jacobian := make([]*ts.Tensor, len(xs))
+	for j, x := range xs {
+		xshape, err := x.Size()
+		if err != nil {
+			dropAll(jacobian)
+			return nil, err
+		}
+		shape := append(append([]int64{}, yshape...), xshape...)
+
+		var jac *ts.Tensor
+		if n == 0 {
+			jac, err = ts.Zeros(shape, x.DType(), device)
+		} else {
+			jac, err = ts.Stack(rows[j], 0)
+			if err == nil {
+				jac, err = jac.Reshape(shape, true)
+			}
+		}
+		if err != nil {
+			dropAll(jacobian)
+			return nil, err
+		}
+		jacobian[j] = jac
+	}
+
+	return jacobian, nil
+}
+
+// prepareInputs returns new tensors of inputs requiring grad. With
+// `createGraph`, an input which requires grad keeps its graph so that results
+// can be differentiated with respect to it, otherwise it is detached.
+func prepareInputs(inputs []*ts.Tensor, createGraph bool) ([]*ts.Tensor, error) {
+	xs := make([]*ts.Tensor, 0, len(inputs))
+	for i, x := range inputs {
+		if x == nil {
+			dropAll(xs)
+			err := fmt.Errorf("Input at %v is nil.\n", i)
+			return nil, err
+		}
+		requiresGrad, err := x.RequiresGrad()
+		if err != nil {
+			dropAll(xs)
+			return nil, err
+		}
+
+		var input *ts.Tensor
+		if createGraph && requiresGrad {
+			input, err = x.ViewAs(x, false)
+		} else {
+			input, err = x.Detach(false)
+			if err == nil {
+				input, err = input.SetRequiresGrad(true, true)
+			}
+		}
+		if err != nil {
+			dropAll(xs)
+			return nil, err
+		}
+		xs = append(xs, input)
+	}
+
+	return xs, nil
+}
+
+// call calls fn and replaces its outputs which are the inputs themselves with
+// new tensors so that they can be dropped independently.
+func call(fn Func, xs []*ts.Tensor) ([]*ts.Tensor, error) {
+	if fn == nil {
+		err := fmt.Errorf("Func is nil.\n")
+		return nil, err
+	}
+
+	ys, err := fn(xs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, y := range ys {
+		if y == nil {
+			err = fmt.Errorf("Output at %v of Func is nil.\n", i)
+			break
+		}
+		for _, x := range xs {
+			if y == x {
+				ys[i], err = x.ShallowClone()
+				break
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	if err != nil {
+		for _, y := range ys {
+			if y != nil && !contains(xs, y) {
+				y.MustDrop()
+			}
+		}
+		return nil, err
+	}
+
+	return ys, nil
+}
+
+// detachOutputs returns outputs detached from graph unless `createGraph`. It
+// drops ys.
+func detachOutputs(ys []*ts.Tensor, createGraph bool) ([]*ts.Tensor, error) {
+	if createGraph {
+		return ys, nil
+	}
+
+	outputs := make([]*ts.Tensor, len(ys))
+	for i, y := range ys {
+		out, err := y.Detach(true)
+		if err != nil {
+			dropAll(outputs)
+			dropAll(ys[i+1:])
+			return nil, err
+		}
+		outputs[i] = out
+	}
+
+	return outputs, nil
+}
+
+func contains(tensors []*ts.Tensor, x *ts.Tensor) bool {
+	for _, t := range tensors {
+		if t == x {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,179 @@
+package autograd
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// GradCheck checks gradients of fn at `inputs` by comparing its analytical
+// Jacobians (computed by backward pass) with numerical ones computed by central
+// finite differences with step `eps`. Inputs are converted to double precision
+// so fn should not convert them back to lower precision.
+//
+// It returns nil if every element of the Jacobians differs by at most `atol`,
+// otherwise an error describing the first mismatch.
+//
+// Example:
+//
+//	// Check a custom autograd function.
+//	fn := func(inputs []*ts.Tensor) ([]*ts.Tensor, error) {
+//		return ts.ApplyFunction(myFunc{}, inputs...)
+//	}
+//	x := ts.MustRandn([]int64{3, 4}, gotch.Double, gotch.CPU)
+//	if err := autograd.GradCheck(fn, []*ts.Tensor{x}, 1e-6, 1e-5); err != nil {
+//		log.Fatal(err)
+//	}
+func GradCheck(fn Func, inputs []*ts.Tensor, eps, atol float64) error {
+	if eps <= 0 || atol < 0 {
+		err := fmt.Errorf("GradCheck() failed: invalid eps (%v) or atol (%v).\n", eps, atol)
+		return err
+	}
+
+	xs := make([]*ts.Tensor, 0, len(inputs))
+	defer func() {
+		dropAll(xs)
+	}()
+	for i, x := range inputs {
+		if x == nil {
+			err := fmt.Errorf("GradCheck() failed: input at %v is nil.\n", i)
+			return err
+		}
+		d, err := x.Detach(false)
+		if err == nil {
+			d, err = d.Totype(gotch.Double, true)
+		}
+		if err != nil {
+			return err
+		}
+		xs = append(xs, d)
+	}
+
+	analytical, err := Jacobian(fn, xs, false)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, row := range analytical {
+			dropAll(row)
+		}
+	}()
+
+	for j, x := range xs {
+		numerical, err := numericalJacobian(fn, xs, j, eps)
+		if err != nil {
+			return err
+		}
+
+		nin := int(x.Numel())
+		for i := range analytical {
+			a := analytical[i][j].Float64Values()
+			for idx := range a {
+				n := numerical[i][idx]
+				if !(math.Abs(a[idx]-n) <= atol) {
+					err := fmt.Errorf("GradCheck() failed: Jacobian mismatch of output %v with respect to input %v at output element %v, input element %v: analytical %v, numerical %v.\n", i, j, idx/nin, idx%nin, a[idx], n)
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// numericalJacobian computes Jacobians of outputs of fn with respect to
+// xs[j] by central finite differences. jacobian[i] is Jacobian of output i in
+// row-major order of shape (output i shape..., xs[j] shape...).
+func numericalJacobian(fn Func, xs []*ts.Tensor, j int, eps float64) ([][]float64, error) {
+	guard := ts.NewNoGradGuard()
+	defer guard.Drop()
+
+	x := xs[j]
+	shape, err := x.Size()
+	if err != nil {
+		return nil, err
+	}
+	device, err := x.Device()
+	if err != nil {
+		return nil, err
+	}
+	n := int64(x.Numel())
+
+	eye, err := ts.Eye(n, gotch.Double, device)
+	if err != nil {
+		return nil, err
+	}
+	defer eye.MustDrop()
+
+	var jacobian [][]float64
+	for k := int64(0); k < n; k++ {
+		delta, err := eye.Select(0, k, false)
+		if err == nil {
+			delta, err = delta.Reshape(shape, true)
+		}
+		if err == nil {
+			delta, err = delta.Mul1(ts.FloatScalar(eps), true)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		plus, err := x.Add(delta, false)
+		if err != nil {
+			delta.MustDrop()
+			return nil, err
+		}
+		fplus, err := evalAt(fn, xs, j, plus)
+		if err != nil {
+			delta.MustDrop()
+			return nil, err
+		}
+
+		minus, err := x.Sub(delta, false)
+		delta.MustDrop()
+		if err != nil {
+			return nil, err
+		}
+		fminus, err := evalAt(fn, xs, j, minus)
+		if err != nil {
+			return nil, err
+		}
+
+		if jacobian == nil {
+			jacobian = make([][]float64, len(fplus))
+			for i := range fplus {
+				jacobian[i] = make([]float64, len(fplus[i])*int(n))
+			}
+		}
+		for i := range fplus {
+			for o := range fplus[i] {
+				jacobian[i][o*int(n)+int(k)] = (fplus[i][o] - fminus[i][o]) / (2 * eps)
+			}
+		}
+	}
+
+	return jacobian, nil
+}
+
+// evalAt returns values of outputs of fn at xs with xs[j] replaced by xj. It
+// drops xj.
+func evalAt(fn Func, xs []*ts.Tensor, j int, xj *ts.Tensor) ([][]float64, error) {
+	defer xj.MustDrop()
+
+	inputs := append([]*ts.Tensor{}, xs...)
+	inputs[j] = xj
+	ys, err := call(fn, inputs)
+	if err != nil {
+		return nil, err
+	}
+	defer dropAll(ys)
+
+	values := make([][]float64, len(ys))
+	for i, y := range ys {
+		values[i] = y.Float64Values()
+	}
+
+	return values, nil
+}
@@ -0,0 +1,363 @@
+package tensor
+
+// #include <stdlib.h>
+import "C"
+
+import (
+	"fmt"
+	"log"
+	"unsafe"
+
+	"github.com/sugarme/gotch"
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// FromBlob creates a tensor of given shape and dtype over memory `data` on
+// `device` without copying it. The memory must hold the tensor elements
+// contiguously in row-major order and stay valid until `release` is called.
+// Writing to the memory modifies the tensor and vice versa.
+//
+// `release` (can be nil) is called once the memory is no longer used by the
+// tensor and tensors sharing it (views, shallow clones), which can be later
+// than dropping the tensor and from another goroutine. It is also called if
+// creating the tensor fails.
+//
+// Pinned Go buffers are NOT supported: the module targets Go 1.14, which has
+// no `runtime.Pinner`, and cgo rules do not allow C code to keep a Go pointer
+// after the call returns. The memory must not be Go memory (e.g. a Go slice).
+// Use C memory (e.g. `C.malloc`, freed in `release`) or memory mapped outside
+// of Go heap, or copy Go data once into C memory with `FromGoSlice`:
+//
+//	ptr := C.malloc(C.size_t(nbytes))
+//	x, err := ts.FromBlob(ptr, shape, gotch.Float, gotch.CPU, func() {
+//		C.free(ptr)
+//	})
+func FromBlob(data unsafe.Pointer, shape []int64, dtype gotch.DType, device gotch.Device, release func()) (*Tensor, error) {
+	fail := func(err error) (*Tensor, error) {
+		if release != nil {
+			release()
+		}
+		return nil, err
+	}
+
+	if data == nil && ElementCount(shape) > 0 {
+		err := fmt.Errorf("FromBlob() failed: nil data.\n")
+		return fail(err)
+	}
+	cint, err := gotch.DType2CInt(dtype)
+	if err != nil {
+		return fail(err)
+	}
+
+	ctensor := lib.AtTensorOfBlobWithDeleter(data, shape, nil, int(cint), int(device.CInt()), release)
+	if err = TorchErr(); err != nil {
+		return nil, err
+	}
+
+	return newTensor(ctensor), nil
+}
+
+// MustFromBlob creates a tensor over memory `data` without copying it. It
+// panics if error occurred.
+func MustFromBlob(data unsafe.Pointer, shape []int64, dtype gotch.DType, device gotch.Device, release func()) *Tensor {
+	x, err := FromBlob(data, shape, dtype, device, release)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
+// FromGoSlice creates a CPU tensor of given shape from a Go slice by copying
+// its data once into C memory, which is freed when the tensor and tensors
+// sharing it are dropped. The dtype is inferred from the slice element type.
+//
+// It is the entry point for Go buffers as `FromBlob` cannot keep a pointer to
+// Go memory. Unlike `OfSlice`, the copy is the tensor storage, so it can be
+// viewed with `Float32Data`, ... without another copy. Changes to `data`
+// after the call are not seen by the tensor.
+func FromGoSlice(data interface{}, shape []int64) (*Tensor, error) {
+	n, err := DataDim(data)
+	if err != nil {
+		return nil, err
+	}
+	if int64(n) != ElementCount(shape) {
+		err := fmt.Errorf("FromGoSlice() failed: data has %v elements, shape %v needs %v.\n", n, shape, ElementCount(shape))
+		return nil, err
+	}
+	dtype, err := gotch.DTypeFromData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	ptr, err := DataAsPtr(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return FromBlob(ptr, shape, dtype, gotch.CPU, func() {
+		C.free(ptr)
+	})
+}
+
+// MustFromGoSlice creates a CPU tensor by copying a Go slice into C memory. It
+// panics if error occurred.
+func MustFromGoSlice(data interface{}, shape []int64) *Tensor {
+	x, err := FromGoSlice(data, shape)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
+// Data views:
+//
+// `Float32Data`, `Float64Data`, ... return a Go slice sharing memory of a
+// contiguous CPU tensor of the same dtype, without copying. Use `Vals`,
+// `Float64Values` or `CopyData` for a copy, `Contiguous` and `To` to get a
+// tensor which can be viewed.
+//
+// Writing to the slice modifies the tensor (and tensors sharing its storage)
+// and vice versa. The slice is only valid while the tensor storage is alive:
+// after the tensor is dropped (or freed by a scope or finalizer), or resized
+// by an in-place op, accessing the slice is undefined behaviour and can
+// crash the program. Keep the tensor alive, e.g. with `runtime.KeepAlive(x)`
+// after last use of the slice, and never retain the slice longer than the
+// tensor.
+
+// dataView returns pointer to the first element and number of elements of a
+// contiguous CPU tensor of dtype.
+func (ts *Tensor) dataView(dtype gotch.DType) (unsafe.Pointer, int, error) {
+	if ts.DType() != dtype {
+		err := fmt.Errorf("Tensor dtype (%v) mismatched with data view dtype (%v).\n", ts.DType(), dtype)
+		return nil, 0, err
+	}
+	device, err := ts.Device()
+	if err != nil {
+		return nil, 0, err
+	}
+	if device.IsCuda() {
+		err := fmt.Errorf("Data view requires a CPU tensor, got device %v.\n", device)
+		return nil, 0, err
+	}
+	contiguous, err := ts.IsContiguous()
+	if err != nil {
+		return nil, 0, err
+	}
+	if !contiguous {
+		err := fmt.Errorf("Data view requires a contiguous tensor.\n")
+		return nil, 0, err
+	}
+
+	n := int(ts.Numel())
+	if n == 0 {
+		return nil, 0, nil
+	}
+	ptr, err := ts.DataPtr()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ptr, n, nil
+}
+
+// setSlice points slice at slicePtr to n elements at data.
+func setSlice(slicePtr unsafe.Pointer, data unsafe.Pointer, n int) {
+	sh := (*sliceHeader)(slicePtr)
+	sh.Data = data
+	sh.Len = n
+	sh.Cap = n
+}
+
+// Float32Data returns a slice sharing memory of a contiguous CPU tensor of
+// dtype Float. See "Data views" for lifetime rules.
+func (ts *Tensor) Float32Data() ([]float32, error) {
+	ptr, n, err := ts.dataView(gotch.Float)
+	if err != nil {
+		return nil, err
+	}
+	data := []float32{}
+	setSlice(unsafe.Pointer(&data), ptr, n)
+
+	return data, nil
+}
+
+// MustFloat32Data returns a slice sharing memory of the tensor. It panics if
+// error occurred.
+func (ts *Tensor) MustFloat32Data() []float32 {
+	data, err := ts.Float32Data()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return data
+}
+
+// Float64Data returns a slice sharing memory of a contiguous CPU tensor of
+// dtype Double. See "Data views" for lifetime rules.
+func (ts *Tensor) Float64Data() ([]float64, error) {
+	ptr, n, err := ts.dataView(gotch.Double)
+	if err != nil {
+		return nil, err
+	}
+	data := []float64{}
+	setSlice(unsafe.Pointer(&data), ptr, n)
+
+	return data, nil
+}
+
+// MustFloat64Data returns a slice sharing memory of the tensor. It panics if
+// error occurred.
+func (ts *Tensor) MustFloat64Data() []float64 {
+	data, err := ts.Float64Data()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return data
+}
+
+// Int64Data returns a slice sharing memory of a contiguous CPU tensor of
+// dtype Int64. See "Data views" for lifetime rules.
+func (ts *Tensor) Int64Data() ([]int64, error) {
+	ptr, n, err := ts.dataView(gotch.Int64)
+	if err != nil {
+		return nil, err
+	}
+	data := []int64{}
+	setSlice(unsafe.Pointer(&data), ptr, n)
+
+	return data, nil
+}
+
+// MustInt64Data returns a slice sharing memory of the tensor. It panics if
+// error occurred.
+func (ts *Tensor) MustInt64Data() []int64 {
+	data, err := ts.Int64Data()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return data
+}
+
+// Int32Data returns a slice sharing memory of a contiguous CPU tensor of
+// dtype Int. See "Data views" for lifetime rules.
+func (ts *Tensor) Int32Data() ([]int32, error) {
+	ptr, n, err := ts.dataView(gotch.Int)
+	if err != nil {
+		return nil, err
+	}
+	data := []int32{}
+	setSlice(unsafe.Pointer(&data), ptr, n)
+
+	return data, nil
+}
+
+// MustInt32Data returns a slice sharing memory of the tensor. It panics if
+// error occurred.
+func (ts *Tensor) MustInt32Data() []int32 {
+	data, err := ts.Int32Data()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return data
+}
+
+// Int16Data returns a slice sharing memory of a contiguous CPU tensor of
+// dtype Int16. See "Data views" for lifetime rules.
+func (ts *Tensor) Int16Data() ([]int16, error) {
+	ptr, n, err := ts.dataView(gotch.Int16)
+	if err != nil {
+		return nil, err
+	}
+	data := []int16{}
+	setSlice(unsafe.Pointer(&data), ptr, n)
+
+	return data, nil
+}
+
+// MustInt16Data returns a slice sharing memory of the tensor. It panics if
+// error occurred.
+func (ts *Tensor) MustInt16Data() []int16 {
+	data, err := ts.Int16Data()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return data
+}
+
+// Int8Data returns a slice sharing memory of a contiguous CPU tensor of
+// dtype Int8. See "Data views" for lifetime rules.
+func (ts *Tensor) Int8Data() ([]int8, error) {
+	ptr, n, err := ts.dataView(gotch.Int8)
+	if err != nil {
+		return nil, err
+	}
+	data := []int8{}
+	setSlice(unsafe.Pointer(&data), ptr, n)
+
+	return data, nil
+}
+
+// MustInt8Data returns a slice sharing memory of the tensor. It panics if
+// error occurred.
+func (ts *Tensor) MustInt8Data() []int8 {
+	data, err := ts.Int8Data()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return data
+}
+
+// Uint8Data returns a slice sharing memory of a contiguous CPU tensor of
+// dtype Uint8. See "Data views" for lifetime rules.
+func (ts *Tensor) Uint8Data() ([]uint8, error) {
+	ptr, n, err := ts.dataView(gotch.Uint8)
+	if err != nil {
+		return nil, err
+	}
+	data := []uint8{}
+	setSlice(unsafe.Pointer(&data), ptr, n)
+
+	return data, nil
+}
+
+// MustUint8Data returns a slice sharing memory of the tensor. It panics if
+// error occurred.
+func (ts *Tensor) MustUint8Data() []uint8 {
+	data, err := ts.Uint8Data()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return data
+}
+
+// BoolData returns a slice sharing memory of a contiguous CPU tensor of
+// dtype Bool. See "Data views" for lifetime rules.
+func (ts *Tensor) BoolData() ([]bool, error) {
+	ptr, n, err := ts.dataView(gotch.Bool)
+	if err != nil {
+		return nil, err
+	}
+	data := []bool{}
+	setSlice(unsafe.Pointer(&data), ptr, n)
+
+	return data, nil
+}
+
+// MustBoolData returns a slice sharing memory of the tensor. It panics if
+// error occurred.
+func (ts *Tensor) MustBoolData() []bool {
+	data, err := ts.BoolData()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return data
+}
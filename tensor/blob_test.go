@@ -0,0 +1,58 @@
+package tensor_test
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestFromGoSlice(t *testing.T) {
+	data := []float32{1, 2, 3, 4, 5, 6}
+	x := ts.MustFromGoSlice(data, []int64{2, 3})
+	defer x.MustDrop()
+
+	// Data is copied once: the tensor does not see later changes of the
+	// slice, and its own storage can be viewed.
+	data[0] = 10
+	view := x.MustFloat32Data()
+	view[5] = 60
+	want := []float64{1, 2, 3, 4, 5, 60}
+	if got := x.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+	if got := x.DType(); got != gotch.Float {
+		t.Errorf("Want: %v\n", gotch.Float)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	if _, err := ts.FromGoSlice(data, []int64{4, 2}); err == nil {
+		t.Errorf("Want error for mismatched shape\n")
+	}
+}
+
+func TestDataView(t *testing.T) {
+	x := ts.MustOfSlice([]int64{1, 2, 3, 4}).MustView([]int64{2, 2}, true)
+	defer x.MustDrop()
+
+	if _, err := x.Float32Data(); err == nil {
+		t.Errorf("Want error viewing Int64 tensor as float32\n")
+	}
+
+	xt := x.MustT(false)
+	defer xt.MustDrop()
+	if _, err := xt.Int64Data(); err == nil {
+		t.Errorf("Want error viewing non-contiguous tensor\n")
+	}
+
+	data := x.MustInt64Data()
+	want := []int64{1, 2, 3, 4}
+	if !reflect.DeepEqual(want, data) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", data)
+	}
+	runtime.KeepAlive(x)
+}
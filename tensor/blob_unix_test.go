@@ -0,0 +1,54 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package tensor_test
+
+import (
+	"reflect"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestFromBlob(t *testing.T) {
+	// Memory outside of Go heap as libtorch keeps the pointer.
+	mem, err := syscall.Mmap(-1, 0, 6*4, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	released := make(chan struct{}, 1)
+	x := ts.MustFromBlob(unsafe.Pointer(&mem[0]), []int64{2, 3}, gotch.Float, gotch.CPU, func() {
+		syscall.Munmap(mem)
+		released <- struct{}{}
+	})
+
+	// Memory is shared both ways.
+	data := x.MustFloat32Data()
+	if unsafe.Pointer(&data[0]) != unsafe.Pointer(&mem[0]) {
+		t.Errorf("Want data view over blob memory\n")
+	}
+	copy(data, []float32{10, 2, 3, 4, 5, 60})
+	want := []float64{10, 2, 3, 4, 5, 60}
+	if !reflect.DeepEqual(want, x.Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", x.Float64Values())
+	}
+
+	// Storage is released when the last tensor sharing it is dropped.
+	y := x.MustShallowClone()
+	x.MustDrop()
+	select {
+	case <-released:
+		t.Errorf("Want blob not released while shared\n")
+	default:
+	}
+	y.MustDrop()
+	select {
+	case <-released:
+	default:
+		t.Errorf("Want blob released after dropping tensors\n")
+	}
+}
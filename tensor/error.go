@@ -5,8 +5,10 @@ import "C"
 
 import (
 	"fmt"
+	"strings"
 	"unsafe"
 
+	"github.com/sugarme/gotch"
 	lib "github.com/sugarme/gotch/libtch"
 )
 
@@ -28,9 +30,66 @@ func ptrToString(cptr *C.char) string {
 	return str
 }
 
+// TorchError is an error raised by libtorch. It is the concrete type of
+// errors returned by `TorchErr` and tensor operations on libtorch failures.
+//
+// Example:
+//
+//	_, err := x.Matmul(y, false)
+//	if e, ok := err.(*ts.TorchError); ok {
+//		fmt.Println(e.Op, e.Inputs, e.Message)
+//	}
+type TorchError struct {
+	Op        string       // name of the operation if known
+	Message   string       // C++ exception message
+	Backtrace string       // C++ backtrace if available
+	Inputs    []TensorInfo // shapes and dtypes of input tensors if known
+}
+
+// TensorInfo is shape and dtype of a tensor.
+type TensorInfo struct {
+	Shape []int64
+	DType gotch.DType
+}
+
+func (ti TensorInfo) String() string {
+	return fmt.Sprintf("%v%v", ti.DType, ti.Shape)
+}
+
+// Error implements error interface. Backtrace is not included.
+func (e *TorchError) Error() string {
+	if e.Op == "" {
+		return fmt.Sprintf("Libtorch API Error: %v\n", e.Message)
+	}
+
+	inputs := make([]string, len(e.Inputs))
+	for i, ti := range e.Inputs {
+		inputs[i] = ti.String()
+	}
+
+	return fmt.Sprintf("Libtorch API Error: %v(%v): %v\n", e.Op, strings.Join(inputs, ", "), e.Message)
+}
+
+// newTorchError parses C++ exception message to message and backtrace.
+func newTorchError(errStr string) *TorchError {
+	e := &TorchError{Message: errStr}
+
+	// c10::Error message is followed by the source location and backtrace.
+	for _, sep := range []string{"\nException raised from ", "\nframe #0"} {
+		if i := strings.Index(errStr, sep); i >= 0 {
+			e.Message = errStr[:i]
+			e.Backtrace = errStr[i+1:]
+			break
+		}
+	}
+	e.Message = strings.TrimSpace(e.Message)
+
+	return e
+}
+
 // TorchErr checks and retrieves last error message from
 // C `thread_local` if existing and frees up C memory the C pointer
-// points to.
+// points to. The returned error if any is a `*TorchError`.
 //
 // NOTE: Go language atm does not have generic function something
 // similar to `macro` in Rust language, does it? So we have to
@@ -41,8 +100,36 @@ func TorchErr() error {
 	cptr := (*C.char)(lib.GetAndResetLastErr())
 	errStr := ptrToString(cptr)
 	if errStr != "" {
-		return fmt.Errorf("Libtorch API Error: %v\n", errStr)
+		return newTorchError(errStr)
 	}
 
 	return nil
 }
+
+// torchErrOp is like TorchErr but also records operation name and shapes and
+// dtypes of input tensors to the error.
+func torchErrOp(op string, inputs ...*Tensor) error {
+	cptr := (*C.char)(lib.GetAndResetLastErr())
+	errStr := ptrToString(cptr)
+	if errStr == "" {
+		return nil
+	}
+
+	e := newTorchError(errStr)
+	e.Op = op
+	for _, x := range inputs {
+		if x == nil {
+			continue
+		}
+		if defined, err := x.Defined(); err != nil || !defined {
+			continue
+		}
+		shape, err := x.Size()
+		if err != nil {
+			continue
+		}
+		e.Inputs = append(e.Inputs, TensorInfo{Shape: shape, DType: x.DType()})
+	}
+
+	return e
+}
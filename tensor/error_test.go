@@ -0,0 +1,44 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestTorchError(t *testing.T) {
+	x := ts.MustOnes([]int64{2, 3}, gotch.Float, gotch.CPU)
+	y := ts.MustOnes([]int64{4, 5}, gotch.Float, gotch.CPU)
+	defer x.MustDrop()
+	defer y.MustDrop()
+
+	_, err := x.Matmul(y, false)
+	if err == nil {
+		t.Fatalf("Want error, got nil\n")
+	}
+
+	e, ok := err.(*ts.TorchError)
+	if !ok {
+		t.Fatalf("Want *TorchError, got %T\n", err)
+	}
+
+	if e.Op != "Matmul" {
+		t.Errorf("Want: %v\n", "Matmul")
+		t.Errorf("Got: %v\n", e.Op)
+	}
+
+	want := []ts.TensorInfo{
+		{Shape: []int64{2, 3}, DType: gotch.Float},
+		{Shape: []int64{4, 5}, DType: gotch.Float},
+	}
+	if !reflect.DeepEqual(want, e.Inputs) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", e.Inputs)
+	}
+
+	if e.Message == "" {
+		t.Errorf("Want non-empty error message\n")
+	}
+}
@@ -0,0 +1,265 @@
+package tensor
+
+import (
+	"fmt"
+	"log"
+	"unsafe"
+
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// Function is an autograd function with hand-written forward and backward
+// passes (e.g. a straight-through estimator). It is applied with
+// `ApplyFunction` and recorded in the autograd graph like a native op so that
+// `Backward` calls its `Backward` method.
+//
+// Forward is called with no grad mode on and returns output tensors computed
+// from inputs. Tensors needed in backward pass should be saved with
+// `ctx.SaveForBackward`.
+//
+// Backward is called with gradients of outputs and returns gradients of
+// inputs, one for each input. A nil gradient of output means that it was not
+// computed (i.e. the output was not used) and a nil gradient of input means no
+// gradient for that input.
+//
+// Tensors passed to callbacks (inputs, gradients, saved tensors) are only
+// valid inside the callback and freed when it returns. Returned tensors are
+// handed over to libtorch and freed as well, so they should be newly created
+// tensors or tensors passed to the callback. An output of Forward which is
+// (a view of) an input is copied so that the input is not modified by
+// autograd.
+//
+// A Function can also implement `Name() string` which gives name of its node
+// in autograd graph (default to its Go type).
+//
+// NOTE. Backward can be called from threads of libtorch autograd engine (e.g.
+// for CUDA tensors), not the goroutine calling `Backward`. A panic in a
+// callback is returned as an error.
+type Function interface {
+	Forward(ctx *FunctionCtx, inputs []*Tensor) ([]*Tensor, error)
+	Backward(ctx *FunctionCtx, gradOutputs []*Tensor) ([]*Tensor, error)
+}
+
+// FunctionCtx is context of a `Function` passed to its callbacks to save
+// tensors in forward pass and retrieve them in backward pass.
+type FunctionCtx struct {
+	cctx    unsafe.Pointer
+	forward bool
+	temps   []*Tensor // tensors freed when callback returns
+}
+
+// SaveForBackward saves tensors (inputs or outputs of forward) to be used in
+// backward pass. It can only be called in `Forward`.
+func (ctx *FunctionCtx) SaveForBackward(tensors ...*Tensor) error {
+	if ctx.cctx == nil || !ctx.forward {
+		err := fmt.Errorf("SaveForBackward() failed: only valid in Forward.\n")
+		return err
+	}
+
+	ctensors := make([]lib.Ctensor, len(tensors))
+	for i, x := range tensors {
+		if x == nil {
+			err := fmt.Errorf("SaveForBackward() failed: tensor at %v is nil.\n", i)
+			return err
+		}
+		ctensors[i] = x.ctensor
+	}
+
+	lib.AtFunctionCtxSave(ctx.cctx, ctensors)
+	return TorchErr()
+}
+
+// MustSaveForBackward saves tensors to be used in backward pass. It panics if
+// error occurred.
+func (ctx *FunctionCtx) MustSaveForBackward(tensors ...*Tensor) {
+	if err := ctx.SaveForBackward(tensors...); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// SavedTensors returns tensors saved in forward pass in order they were
+// saved. It can only be called in `Backward`.
+func (ctx *FunctionCtx) SavedTensors() ([]*Tensor, error) {
+	if ctx.cctx == nil || ctx.forward {
+		err := fmt.Errorf("SavedTensors() failed: only valid in Backward.\n")
+		return nil, err
+	}
+
+	ctensors := lib.AtFunctionCtxSaved(ctx.cctx)
+	if err := TorchErr(); err != nil {
+		return nil, err
+	}
+
+	tensors := make([]*Tensor, len(ctensors))
+	for i, c := range ctensors {
+		tensors[i] = newTensor(c)
+		ctx.temps = append(ctx.temps, tensors[i])
+	}
+
+	return tensors, nil
+}
+
+// MustSavedTensors returns tensors saved in forward pass. It panics if error
+// occurred.
+func (ctx *FunctionCtx) MustSavedTensors() []*Tensor {
+	tensors, err := ctx.SavedTensors()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return tensors
+}
+
+func (ctx *FunctionCtx) owns(x *Tensor) bool {
+	for _, t := range ctx.temps {
+		if t == x || t.ctensor == x.ctensor {
+			return true
+		}
+	}
+
+	return false
+}
+
+// free frees tensors passed to callback and invalidates the context.
+func (ctx *FunctionCtx) free() {
+	for _, x := range ctx.temps {
+		x.MustDrop()
+	}
+	ctx.temps = nil
+	ctx.cctx = nil
+}
+
+// functionCallback wraps forward or backward of fn as a libtorch callback.
+func functionCallback(fn Function, forward bool) lib.FunctionCallback {
+	return func(cctx unsafe.Pointer, ctensors []lib.Ctensor) (outs []lib.Ctensor, err error) {
+		ctx := &FunctionCtx{cctx: cctx, forward: forward}
+		defer ctx.free()
+		defer func() {
+			if r := recover(); r != nil {
+				for _, c := range outs {
+					if c != nil {
+						lib.AtFree(c)
+					}
+				}
+				outs = nil
+				err = fmt.Errorf("Function callback panic: %v\n", r)
+			}
+		}()
+
+		tensors := make([]*Tensor, len(ctensors))
+		for i, c := range ctensors {
+			// nil is an undefined gradient.
+			if c == nil {
+				continue
+			}
+			tensors[i] = newTensor(c)
+			ctx.temps = append(ctx.temps, tensors[i])
+		}
+
+		var results []*Tensor
+		if forward {
+			results, err = fn.Forward(ctx, tensors)
+		} else {
+			results, err = fn.Backward(ctx, tensors)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// libtorch takes new handles to returned tensors. Free ours.
+		outs = make([]lib.Ctensor, len(results))
+		for i, x := range results {
+			if x == nil {
+				continue
+			}
+			outs[i] = lib.AtShallowClone(x.ctensor)
+			if err = TorchErr(); err != nil {
+				for _, c := range outs[:i] {
+					if c != nil {
+						lib.AtFree(c)
+					}
+				}
+				return nil, err
+			}
+		}
+		dropped := make(map[*Tensor]bool)
+		for _, x := range results {
+			if x == nil || dropped[x] || ctx.owns(x) {
+				continue
+			}
+			x.MustDrop()
+			dropped[x] = true
+		}
+
+		return outs, nil
+	}
+}
+
+// ApplyFunction applies a custom autograd function to input tensors and
+// returns its outputs. If any input requires grad and grad mode is enabled,
+// outputs require grad and `fn.Backward` is called to compute gradients of
+// inputs in backward pass.
+//
+// Example:
+//
+//	// STE is a straight-through estimator of sign function.
+//	type STE struct{}
+//
+//	func (STE) Forward(ctx *ts.FunctionCtx, inputs []*ts.Tensor) ([]*ts.Tensor, error) {
+//		return []*ts.Tensor{inputs[0].MustSign(false)}, nil
+//	}
+//
+//	func (STE) Backward(ctx *ts.FunctionCtx, gradOutputs []*ts.Tensor) ([]*ts.Tensor, error) {
+//		return []*ts.Tensor{gradOutputs[0]}, nil
+//	}
+//
+//	outputs, err := ts.ApplyFunction(STE{}, x)
+func ApplyFunction(fn Function, inputs ...*Tensor) ([]*Tensor, error) {
+	if fn == nil {
+		err := fmt.Errorf("ApplyFunction() failed: nil function.\n")
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%T", fn)
+	if n, ok := fn.(interface{ Name() string }); ok {
+		name = n.Name()
+	}
+
+	cinputs := make([]lib.Ctensor, len(inputs))
+	for i, x := range inputs {
+		if x == nil {
+			err := fmt.Errorf("ApplyFunction() failed: input at %v is nil.\n", i)
+			return nil, err
+		}
+		cinputs[i] = x.ctensor
+	}
+
+	callbacks := &lib.FunctionCallbacks{
+		Forward:  functionCallback(fn, true),
+		Backward: functionCallback(fn, false),
+	}
+	coutputs := lib.AtFunctionApply(name, callbacks, cinputs)
+	if err := torchErrOp("ApplyFunction", inputs...); err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*Tensor, len(coutputs))
+	for i, c := range coutputs {
+		if c != nil {
+			outputs[i] = newTensor(c)
+		}
+	}
+
+	return outputs, nil
+}
+
+// MustApplyFunction applies a custom autograd function to input tensors and
+// returns its outputs. It panics if error occurred.
+func MustApplyFunction(fn Function, inputs ...*Tensor) []*Tensor {
+	outputs, err := ApplyFunction(fn, inputs...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return outputs
+}
@@ -0,0 +1,92 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// straightThrough is sign function with identity gradient.
+type straightThrough struct{}
+
+func (straightThrough) Forward(ctx *ts.FunctionCtx, inputs []*ts.Tensor) ([]*ts.Tensor, error) {
+	return []*ts.Tensor{inputs[0].MustSign(false)}, nil
+}
+
+func (straightThrough) Backward(ctx *ts.FunctionCtx, gradOutputs []*ts.Tensor) ([]*ts.Tensor, error) {
+	return []*ts.Tensor{gradOutputs[0]}, nil
+}
+
+// square computes x * x with gradient 2 * x from saved input.
+type square struct{}
+
+func (square) Forward(ctx *ts.FunctionCtx, inputs []*ts.Tensor) ([]*ts.Tensor, error) {
+	if err := ctx.SaveForBackward(inputs[0]); err != nil {
+		return nil, err
+	}
+	return []*ts.Tensor{inputs[0].MustMul(inputs[0], false)}, nil
+}
+
+func (square) Backward(ctx *ts.FunctionCtx, gradOutputs []*ts.Tensor) ([]*ts.Tensor, error) {
+	saved, err := ctx.SavedTensors()
+	if err != nil {
+		return nil, err
+	}
+	x2 := saved[0].MustMul1(ts.FloatScalar(2.0), false)
+	grad := x2.MustMul(gradOutputs[0], true)
+	return []*ts.Tensor{grad}, nil
+}
+
+func TestApplyFunction(t *testing.T) {
+	x := ts.MustOfSlice([]float64{-2, 0.5, 3}).MustSetRequiresGrad(true, false)
+
+	y := ts.MustApplyFunction(straightThrough{}, x)[0]
+	want := []float64{-1, 1, 1}
+	if !reflect.DeepEqual(want, y.Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", y.Float64Values())
+	}
+
+	loss := y.MustMul1(ts.FloatScalar(3.0), false).MustSum(gotch.Double, true)
+	loss.MustBackward()
+
+	grad := x.MustGrad(false)
+	want = []float64{3, 3, 3}
+	if !reflect.DeepEqual(want, grad.Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", grad.Float64Values())
+	}
+
+	grad.MustDrop()
+	loss.MustDrop()
+	y.MustDrop()
+	x.MustDrop()
+}
+
+func TestApplyFunctionSaved(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3}).MustSetRequiresGrad(true, false)
+
+	y := ts.MustApplyFunction(square{}, x)[0]
+	want := []float64{1, 4, 9}
+	if !reflect.DeepEqual(want, y.Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", y.Float64Values())
+	}
+
+	loss := y.MustSum(gotch.Double, false)
+	loss.MustBackward()
+
+	grad := x.MustGrad(false)
+	want = []float64{2, 4, 6}
+	if !reflect.DeepEqual(want, grad.Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", grad.Float64Values())
+	}
+
+	grad.MustDrop()
+	loss.MustDrop()
+	y.MustDrop()
+	x.MustDrop()
+}
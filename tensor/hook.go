@@ -0,0 +1,111 @@
+package tensor
+
+import (
+	"fmt"
+	"log"
+
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// Hook is a function called with gradient of a tensor when it is computed
+// in backward pass. It can return a new tensor to replace the gradient or
+// nil (or the input `grad` itself) to keep it unchanged.
+//
+// `grad` is only valid inside the hook and freed when it returns. A returned
+// tensor other than `grad` is handed over to libtorch and freed as well, so it
+// should be a newly created tensor.
+//
+// NOTE. hooks can be called from threads of libtorch autograd engine (e.g.
+// for CUDA tensors), not the goroutine calling `Backward`. A panic in a hook
+// aborts the backward pass and `Backward` returns it as an error.
+type Hook func(grad *Tensor) *Tensor
+
+// HookHandle is a registered hook which can be removed.
+type HookHandle struct {
+	ts      *Tensor
+	pos     int
+	removed bool
+}
+
+// RegisterHook registers a hook to be called with gradient of the tensor in
+// backward pass. The tensor must require grad.
+//
+// Example:
+//
+//	// Clip gradient of x to [-1, 1].
+//	h := x.MustRegisterHook(func(grad *ts.Tensor) *ts.Tensor {
+//		return grad.MustClamp(ts.FloatScalar(-1.0), ts.FloatScalar(1.0), false)
+//	})
+//	defer h.MustRemove()
+func (ts *Tensor) RegisterHook(hook Hook) (*HookHandle, error) {
+	if hook == nil {
+		err := fmt.Errorf("RegisterHook() failed: nil hook.\n")
+		return nil, err
+	}
+
+	fn := func(grad lib.Ctensor) (out lib.Ctensor, err error) {
+		g := newTensor(grad)
+		defer g.MustDrop()
+		defer func() {
+			if r := recover(); r != nil {
+				out = nil
+				err = fmt.Errorf("Gradient hook panic: %v\n", r)
+			}
+		}()
+
+		x := hook(g)
+		if x == nil || x == g || x.ctensor == g.ctensor {
+			return nil, nil
+		}
+
+		// libtorch takes a new handle to the same tensor. Free ours.
+		out = lib.AtShallowClone(x.ctensor)
+		if err = TorchErr(); err != nil {
+			return nil, err
+		}
+		x.MustDrop()
+
+		return out, nil
+	}
+
+	pos := lib.AtRegisterHook(ts.ctensor, fn)
+	if err := torchErrOp("RegisterHook", ts); err != nil {
+		return nil, err
+	}
+
+	return &HookHandle{ts: ts, pos: pos}, nil
+}
+
+// MustRegisterHook registers a hook to be called with gradient of the tensor
+// in backward pass. It panics if error occurred.
+func (ts *Tensor) MustRegisterHook(hook Hook) *HookHandle {
+	h, err := ts.RegisterHook(hook)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return h
+}
+
+// Remove removes the hook from its tensor. It must be called before the
+// tensor is dropped. Calling it more than once is a no-op.
+func (h *HookHandle) Remove() error {
+	if h.removed {
+		return nil
+	}
+
+	lib.AtRemoveHook(h.ts.ctensor, h.pos)
+	if err := TorchErr(); err != nil {
+		return err
+	}
+	h.removed = true
+
+	return nil
+}
+
+// MustRemove removes the hook from its tensor. It panics if error occurred.
+func (h *HookHandle) MustRemove() {
+	if err := h.Remove(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,70 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestRegisterHook(t *testing.T) {
+	x := ts.MustOnes([]int64{3}, gotch.Float, gotch.CPU).MustSetRequiresGrad(true, true)
+	y := x.MustMul1(ts.FloatScalar(2.0), false)
+
+	// Observe gradient of x.
+	var got []float64
+	hx := x.MustRegisterHook(func(grad *ts.Tensor) *ts.Tensor {
+		got = grad.Float64Values()
+		return nil
+	})
+
+	// Reverse gradient flowing through y.
+	hy := y.MustRegisterHook(func(grad *ts.Tensor) *ts.Tensor {
+		return grad.MustNeg(false)
+	})
+
+	loss := y.MustSum(gotch.Float, false)
+	loss.MustBackward()
+
+	want := []float64{-2, -2, -2}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+	grad := x.MustGrad(false)
+	if !reflect.DeepEqual(want, grad.Float64Values()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", grad.Float64Values())
+	}
+
+	hx.MustRemove()
+	hy.MustRemove()
+	grad.MustDrop()
+	loss.MustDrop()
+	y.MustDrop()
+	x.MustDrop()
+}
+
+func TestRegisterHookError(t *testing.T) {
+	x := ts.MustOnes([]int64{3}, gotch.Float, gotch.CPU)
+	defer x.MustDrop()
+
+	// x does not require grad.
+	if _, err := x.RegisterHook(func(grad *ts.Tensor) *ts.Tensor { return nil }); err == nil {
+		t.Errorf("Want error registering hook on tensor not requiring grad\n")
+	}
+
+	w := x.MustSetRequiresGrad(true, false)
+	defer w.MustDrop()
+	h := w.MustRegisterHook(func(grad *ts.Tensor) *ts.Tensor {
+		panic("bad gradient")
+	})
+	defer h.MustRemove()
+
+	loss := w.MustSum(gotch.Float, false)
+	defer loss.MustDrop()
+	if err := loss.Backward(); err == nil {
+		t.Errorf("Want error from panicking hook\n")
+	}
+}
@@ -64,7 +64,10 @@ package tensor
 import (
 	"fmt"
 	"log"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/sugarme/gotch"
 )
@@ -82,6 +85,23 @@ type Narrow struct {
 type IndexSelect struct{ Index *Tensor }
 type InsertNewAxis struct{}
 
+// Slice selects elements in range [Start, End) of a dimension with Step as
+// Python `start:end:step`. Negative Start, End count from the end of the
+// dimension and out of range values are clamped. Step must be positive.
+type Slice struct {
+	Start int64
+	End   int64
+	Step  int64
+}
+
+// Ellipsis expands to full slices of all dimensions which are not indexed
+// by other indexers as Python `...`.
+type Ellipsis struct{}
+
+// Mask selects elements where bool tensor Mask is true. Mask dimensions must
+// match the dimensions it indexes, which are flattened to one dimension.
+type Mask struct{ Mask *Tensor }
+
 // NewSelect creates an tensor indexer with given index.
 // `index` must be in range of tensor dimension. E.g. tensor shape [2,8]
 // will have size = 2, hence `index` should be in range from [0,2)
@@ -101,6 +121,18 @@ func NewInsertNewAxis() InsertNewAxis {
 	return InsertNewAxis{}
 }
 
+func NewSlice(start, end, step int64) Slice {
+	return Slice{Start: start, End: end, Step: step}
+}
+
+func NewEllipsis() Ellipsis {
+	return Ellipsis{}
+}
+
+func NewMask(mask *Tensor) Mask {
+	return Mask{Mask: mask}
+}
+
 func NewSliceIndex(sl []int64) IndexSelect {
 	ts := MustOfSlice(sl)
 
@@ -129,89 +161,195 @@ type IndexOp interface {
 // Idx implements `IndexOp` interface for Tensor
 //
 // NOTE:
-// - `index`: expects type `TensorIndexer` or `[]TensorIndexer`
+//   - `index`: expects type `TensorIndexer`, `[]TensorIndexer`, a Python-like
+//     index string (see `ParseIndex`) or a `*Tensor`. A bool tensor is used as
+//     a mask, other tensors as indices to select (see `IndexSelect`).
+//
+// Example:
+//
+//	x := ts.MustArange1(ts.IntScalar(0), ts.IntScalar(24), gotch.Int64, gotch.CPU).MustView([]int64{2, 3, 4}, true)
+//	y := x.Idx("..., 1:4:2, None") // shape [2, 3, 2, 1]
+//	mask := x.MustGe(ts.IntScalar(12), false)
+//	z := x.Idx(mask) // shape [12]
+//
+// It panics if error occurred. See `IdxE`.
 func (ts *Tensor) Idx(index interface{}) (retVal *Tensor) {
+	retVal, err := ts.IdxE(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return retVal
+}
+
+// IdxE is like `Idx` but returns error if `index` is invalid.
+func (ts *Tensor) IdxE(index interface{}) (*Tensor, error) {
+	indexes, err := toIndexers(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.indexer(indexes)
+}
+
+// toIndexers converts input of `Idx` to a slice of indexers.
+func toIndexers(index interface{}) ([]TensorIndexer, error) {
+	switch idx := index.(type) {
+	case string:
+		return ParseIndex(idx)
+	case *Tensor:
+		dtype, err := idx.DTypeE()
+		if err != nil {
+			return nil, err
+		}
+		if dtype == gotch.Bool {
+			return []TensorIndexer{NewMask(idx)}, nil
+		}
+		return []TensorIndexer{NewIndexSelect(idx)}, nil
+	case []TensorIndexer:
+		if len(idx) > 7 {
+			err := fmt.Errorf("Invalid input 'index' slice length (%v) - max is 7\n", len(idx))
+			return nil, err
+		}
+		return idx, nil
+	}
+
+	if reflect.ValueOf(index).Kind() != reflect.Struct {
+		err := fmt.Errorf("Invalid 'index' type (%v) - Expected type 'TensorIndexer', '[]TensorIndexer', 'string' or '*Tensor'\n.", reflect.ValueOf(index).Kind().String())
+		return nil, err
+	}
 
-	// indexTyp := reflect.TypeOf(index)
-	indexVal := reflect.ValueOf(index)
+	return []TensorIndexer{index}, nil
+}
 
+// ParseIndex parses a Python-like index string to indexers. Items are separated
+// by comma and can be:
+//
+//   - an integer, e.g. `1` or `-1`: `Select`
+//   - a slice `start:end:step` where all parts are optional, e.g. `:`, `1:`,
+//     `:-1` or `::2`: `Slice`
+//   - `...`: `Ellipsis`
+//   - `None`: `InsertNewAxis`
+func ParseIndex(spec string) ([]TensorIndexer, error) {
 	var indexes []TensorIndexer
+	if strings.TrimSpace(spec) == "" {
+		return indexes, nil
+	}
 
-	switch indexVal.Kind().String() {
-	case "struct": // T: A
-		indexes = append(indexes, index.(TensorIndexer))
-	case "slice": // T: []TensorIndexer
-		switch len(index.([]TensorIndexer)) {
-		case 1: // T: [A]
-			idxA := index.([]TensorIndexer)[0]
-			indexes = append(indexes, idxA)
-		case 2: // T: [A, B]
-			idxA := index.([]TensorIndexer)[0]
-			idxB := index.([]TensorIndexer)[1]
-			indexes = append(indexes, idxA, idxB)
-		case 3: // T: [A, B, C]
-			idxA := index.([]TensorIndexer)[0]
-			idxB := index.([]TensorIndexer)[1]
-			idxC := index.([]TensorIndexer)[2]
-			indexes = append(indexes, idxA, idxB, idxC)
-		case 4: // T: [A, B, C, D]
-			idxA := index.([]TensorIndexer)[0]
-			idxB := index.([]TensorIndexer)[1]
-			idxC := index.([]TensorIndexer)[2]
-			idxD := index.([]TensorIndexer)[3]
-			indexes = append(indexes, idxA, idxB, idxC, idxD)
-		case 5: // T: [A, B, C, D, E]
-			idxA := index.([]TensorIndexer)[0]
-			idxB := index.([]TensorIndexer)[1]
-			idxC := index.([]TensorIndexer)[2]
-			idxD := index.([]TensorIndexer)[3]
-			idxE := index.([]TensorIndexer)[4]
-			indexes = append(indexes, idxA, idxB, idxC, idxD, idxE)
-		case 6: // T: [A, B, C, D, E, F]
-			idxA := index.([]TensorIndexer)[0]
-			idxB := index.([]TensorIndexer)[1]
-			idxC := index.([]TensorIndexer)[2]
-			idxD := index.([]TensorIndexer)[3]
-			idxE := index.([]TensorIndexer)[4]
-			idxF := index.([]TensorIndexer)[5]
-			indexes = append(indexes, idxA, idxB, idxC, idxD, idxE, idxF)
-		case 7: // T: [A, B, C, D, E, F, G]
-			idxA := index.([]TensorIndexer)[0]
-			idxB := index.([]TensorIndexer)[1]
-			idxC := index.([]TensorIndexer)[2]
-			idxD := index.([]TensorIndexer)[3]
-			idxE := index.([]TensorIndexer)[4]
-			idxF := index.([]TensorIndexer)[5]
-			idxG := index.([]TensorIndexer)[6]
-			indexes = append(indexes, idxA, idxB, idxC, idxD, idxE, idxF, idxG)
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		switch {
+		case item == "...":
+			indexes = append(indexes, NewEllipsis())
+		case item == "None":
+			indexes = append(indexes, NewInsertNewAxis())
+		case strings.Contains(item, ":"):
+			parts := strings.Split(item, ":")
+			if len(parts) > 3 {
+				err := fmt.Errorf("Invalid slice %q in index %q\n", item, spec)
+				return nil, err
+			}
+			vals := []int64{0, math.MaxInt64, 1}
+			for i, p := range parts {
+				p = strings.TrimSpace(p)
+				if p == "" {
+					continue
+				}
+				v, err := strconv.ParseInt(p, 10, 64)
+				if err != nil {
+					err = fmt.Errorf("Invalid slice %q in index %q: %v\n", item, spec, err)
+					return nil, err
+				}
+				vals[i] = v
+			}
+			indexes = append(indexes, NewSlice(vals[0], vals[1], vals[2]))
 		default:
-			log.Fatalf("Invalid input 'index' slice length (%v) - max is 7\n", len(index.([]TensorIndexer)))
+			v, err := strconv.ParseInt(item, 10, 64)
+			if err != nil {
+				err = fmt.Errorf("Invalid item %q in index %q\n", item, spec)
+				return nil, err
+			}
+			indexes = append(indexes, NewSelect(v))
 		}
-	default:
-		log.Fatalf("Invalid 'index' type (%v) - Expected type 'TensorIndexer' or '[]TensorIndexer'\n.", indexVal.Kind().String())
 	}
 
-	return ts.mustIndexer(indexes)
+	return indexes, nil
 }
 
-// Tensor Methods:
-// ===============
-func (ts *Tensor) indexer(indexSpec []TensorIndexer) (retVal *Tensor, err error) {
+// expandEllipsis replaces `Ellipsis` in index spec with full slices of
+// dimensions not indexed by other indexers.
+func expandEllipsis(indexSpec []TensorIndexer, ndims int) ([]TensorIndexer, error) {
+	pos := -1
+	for i, spec := range indexSpec {
+		if reflect.TypeOf(spec).Name() == "Ellipsis" {
+			if pos >= 0 {
+				err := fmt.Errorf("An index can only have a single ellipsis ('...')\n")
+				return nil, err
+			}
+			pos = i
+		}
+	}
+	if pos < 0 {
+		return indexSpec, nil
+	}
+
+	numDims, err := indexedDims(indexSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var retVal []TensorIndexer
+	retVal = append(retVal, indexSpec[:pos]...)
+	for i := numDims; i < ndims; i++ {
+		retVal = append(retVal, NewSlice(0, math.MaxInt64, 1))
+	}
+	retVal = append(retVal, indexSpec[pos+1:]...)
+
+	return retVal, nil
+}
 
-	// Make sure number of non-newaxis is not exceed number of dimensions
-	var numNewAxis int = 0
-	for _, ti := range indexSpec {
-		if reflect.TypeOf(ti).Name() == "InsertNewAxis" {
-			numNewAxis += 1
+// indexedDims returns number of tensor dimensions consumed by index spec.
+func indexedDims(indexSpec []TensorIndexer) (int, error) {
+	var n int
+	for _, spec := range indexSpec {
+		switch reflect.TypeOf(spec).Name() {
+		case "InsertNewAxis", "Ellipsis":
+		case "Mask":
+			mask := reflect.ValueOf(spec).FieldByName("Mask").Interface().(*Tensor)
+			maskShape, err := mask.Size()
+			if err != nil {
+				return 0, err
+			}
+			n += len(maskShape)
+		default:
+			n += 1
 		}
 	}
 
+	return n, nil
+}
+
+// Tensor Methods:
+// ===============
+func (ts *Tensor) indexer(indexSpec []TensorIndexer) (retVal *Tensor, err error) {
+
 	tsShape, err := ts.Size()
 	if err != nil {
 		return retVal, err
 	}
 	tsLen := len(tsShape)
-	if len(indexSpec) > tsLen+numNewAxis {
+
+	indexSpec, err = expandEllipsis(indexSpec, tsLen)
+	if err != nil {
+		return retVal, err
+	}
+
+	// Make sure number of indexed dimensions is not exceed number of dimensions
+	numDims, err := indexedDims(indexSpec)
+	if err != nil {
+		return retVal, err
+	}
+	if numDims > tsLen {
 		err = fmt.Errorf("Too many indices for tensor of dimension %v\n", tsLen)
 		return retVal, err
 	}
@@ -235,16 +373,45 @@ func (ts *Tensor) indexer(indexSpec []TensorIndexer) (retVal *Tensor, err error)
 				}
 
 				// 2. Or its input tensor has an unsupported dtype
-				if inputTensor.DType() != gotch.Int64 &&
-					inputTensor.DType() != gotch.Int16 &&
-					inputTensor.DType() != gotch.Int8 &&
-					inputTensor.DType() != gotch.Int {
+				dtype, err := inputTensor.DTypeE()
+				if err != nil {
+					return retVal, err
+				}
+				if dtype != gotch.Int64 &&
+					dtype != gotch.Int16 &&
+					dtype != gotch.Int8 &&
+					dtype != gotch.Int {
 
-					err = fmt.Errorf("The dtype of tensor used (%v) as indices must be one of: 'int64', 'int16', 'int8', 'int'. \n", inputTensor.DType())
+					err = fmt.Errorf("The dtype of tensor used (%v) as indices must be one of: 'int64', 'int16', 'int8', 'int'. \n", dtype)
 					return retVal, err
 				}
 			}
 		}
+
+		switch spec := spec.(type) {
+		case Slice:
+			if spec.Step <= 0 {
+				err = fmt.Errorf("Slice step must be positive (got %v)\n", spec.Step)
+				return retVal, err
+			}
+		case Mask:
+			dtype, err := spec.Mask.DTypeE()
+			if err != nil {
+				return retVal, err
+			}
+			if dtype != gotch.Bool {
+				err = fmt.Errorf("The dtype of tensor used (%v) as mask must be 'bool'.\n", dtype)
+				return retVal, err
+			}
+			dim, err := spec.Mask.DimE()
+			if err != nil {
+				return retVal, err
+			}
+			if dim == 0 {
+				err = fmt.Errorf("0-dimensional tensor is not supported as mask.\n")
+				return retVal, err
+			}
+		}
 	}
 
 	// Now, apply indexing from left to right.
@@ -297,6 +464,19 @@ func (ts *Tensor) indexer(indexSpec []TensorIndexer) (retVal *Tensor, err error)
 				return retVal, err
 			}
 			nextIdx = currIdx + 1
+		case "Slice": // 3 fields: `(Start, End, Step int64)`
+			sl := spec.(Slice)
+			nextTensor, err = currTensor.Slice(currIdx, sl.Start, sl.End, sl.Step, true)
+			if err != nil {
+				return retVal, err
+			}
+			nextIdx = currIdx + 1
+		case "Mask": // 1 field `(Mask *Tensor)`
+			nextTensor, err = currTensor.maskSelect(currIdx, spec.(Mask).Mask)
+			if err != nil {
+				return retVal, err
+			}
+			nextIdx = currIdx + 1
 		} // end of switch
 
 		currTensor = nextTensor
@@ -307,10 +487,336 @@ func (ts *Tensor) indexer(indexSpec []TensorIndexer) (retVal *Tensor, err error)
 	return retVal, nil
 }
 
-func (ts *Tensor) mustIndexer(indexSpec []TensorIndexer) (retVal *Tensor) {
-	retVal, err := ts.indexer(indexSpec)
+// maskSelect selects elements of dimensions from `dim` where `mask` is true.
+// Indexed dimensions are flattened to one dimension. It deletes `ts`.
+func (ts *Tensor) maskSelect(dim int64, mask *Tensor) (retVal *Tensor, err error) {
+	shape, err := ts.Size()
 	if err != nil {
-		panic(err)
+		return retVal, err
 	}
-	return retVal
+	maskShape, err := mask.Size()
+	if err != nil {
+		return retVal, err
+	}
+	ndims := int64(len(maskShape))
+	if dim+ndims > int64(len(shape)) || !reflect.DeepEqual(shape[dim:dim+ndims], maskShape) {
+		err = fmt.Errorf("The shape of the mask %v does not match the shape of the indexed tensor %v at dimension %v\n", maskShape, shape, dim)
+		return retVal, err
+	}
+
+	device, err := ts.Device()
+	if err != nil {
+		return retVal, err
+	}
+
+	flatMask, err := mask.Reshape([]int64{-1}, false)
+	if err != nil {
+		return retVal, err
+	}
+	index, err := flatMask.Nonzero(true)
+	if err != nil {
+		return retVal, err
+	}
+	index, err = index.Reshape([]int64{-1}, true)
+	if err != nil {
+		return retVal, err
+	}
+	index, err = index.To(device, true)
+	if err != nil {
+		return retVal, err
+	}
+	defer index.MustDrop()
+
+	flat := ts
+	if ndims != 1 {
+		flat, err = ts.Flatten(dim, dim+ndims-1, true)
+		if err != nil {
+			return retVal, err
+		}
+	}
+
+	return flat.IndexSelect(dim, index, true)
+}
+
+// IdxPut writes `value` in place to elements of the tensor selected by
+// `index`, which accepts the same types as `Idx`. `value` is broadcast to the
+// shape of `ts.Idx(index)` and converted to dtype and device of the tensor.
+//
+// Example:
+//
+//	x := ts.MustZeros([]int64{2, 3}, gotch.Float, gotch.CPU)
+//	err := x.IdxPut(":, 1:", ts.MustOfSlice([]float32{1, 2})) // [[0, 1, 2], [0, 1, 2]]
+func (ts *Tensor) IdxPut(index interface{}, value *Tensor) error {
+	indexSpec, err := toIndexers(index)
+	if err != nil {
+		return err
+	}
+
+	shape, err := ts.Size()
+	if err != nil {
+		return err
+	}
+	indexSpec, err = expandEllipsis(indexSpec, len(shape))
+	if err != nil {
+		return err
+	}
+	numDims, err := indexedDims(indexSpec)
+	if err != nil {
+		return err
+	}
+	if numDims > len(shape) {
+		err = fmt.Errorf("Too many indices for tensor of dimension %v\n", len(shape))
+		return err
+	}
+	// Dimensions not indexed are taken entirely.
+	for i := numDims; i < len(shape); i++ {
+		indexSpec = append(indexSpec, NewSlice(0, math.MaxInt64, 1))
+	}
+
+	// Each indexer is converted to 1D index tensors (one per indexed
+	// dimension) which are broadcast on their own axis so that `index_put_`
+	// writes to the cartesian product of them.
+	var (
+		dimIndices []*Tensor // 1D index tensor of each dimension
+		dimAxes    []int     // broadcast axis of each dimension
+		meshShape  []int64   // shape of broadcast indices
+		valueShape []int64   // shape of `ts.Idx(index)`
+		tmps       []*Tensor // tensors to drop when done
+	)
+	defer func() {
+		for _, x := range tmps {
+			x.MustDrop()
+		}
+	}()
+
+	addAxis := func(indices []*Tensor, size int64, keep bool) {
+		for _, x := range indices {
+			tmps = append(tmps, x)
+			dimIndices = append(dimIndices, x)
+			dimAxes = append(dimAxes, len(meshShape))
+		}
+		meshShape = append(meshShape, size)
+		if keep {
+			valueShape = append(valueShape, size)
+		}
+	}
+
+	dim := 0
+	for _, spec := range indexSpec {
+		switch spec := spec.(type) {
+		case InsertNewAxis:
+			valueShape = append(valueShape, 1)
+			continue
+		case Select:
+			i := spec.Index
+			if i < 0 {
+				i += shape[dim]
+			}
+			if i < 0 || i >= shape[dim] {
+				err = fmt.Errorf("Index %v is out of bounds for dimension %v with size %v\n", spec.Index, dim, shape[dim])
+				return err
+			}
+			x, err := OfSlice([]int64{i})
+			if err != nil {
+				return err
+			}
+			addAxis([]*Tensor{x}, 1, false)
+		case Narrow:
+			start := spec.Start
+			if start < 0 {
+				start += shape[dim]
+			}
+			end := start + spec.End - spec.Start
+			if start < 0 || end < start || end > shape[dim] {
+				err = fmt.Errorf("Narrow (%v, %v) is out of bounds for dimension %v with size %v\n", spec.Start, spec.End, dim, shape[dim])
+				return err
+			}
+			x, size, err := rangeIndex(start, end, 1)
+			if err != nil {
+				return err
+			}
+			addAxis([]*Tensor{x}, size, true)
+		case Slice:
+			if spec.Step <= 0 {
+				err = fmt.Errorf("Slice step must be positive (got %v)\n", spec.Step)
+				return err
+			}
+			start, end := sliceBounds(spec.Start, spec.End, shape[dim])
+			x, size, err := rangeIndex(start, end, spec.Step)
+			if err != nil {
+				return err
+			}
+			addAxis([]*Tensor{x}, size, true)
+		case IndexSelect:
+			ndim, err := spec.Index.DimE()
+			if err != nil {
+				return err
+			}
+			if ndim != 1 {
+				err = fmt.Errorf("Multi-dimenstional tensor is not supported for indexing.\n")
+				return err
+			}
+			x, err := spec.Index.Totype(gotch.Int64, false)
+			if err != nil {
+				return err
+			}
+			addAxis([]*Tensor{x}, x.MustSize()[0], true)
+		case Mask:
+			mask := spec.Mask
+			dtype, err := mask.DTypeE()
+			if err != nil {
+				return err
+			}
+			if dtype != gotch.Bool {
+				err = fmt.Errorf("The dtype of tensor used (%v) as mask must be 'bool'.\n", dtype)
+				return err
+			}
+			maskShape, err := mask.Size()
+			if err != nil {
+				return err
+			}
+			ndims := len(maskShape)
+			if ndims == 0 || !reflect.DeepEqual(shape[dim:dim+ndims], maskShape) {
+				err = fmt.Errorf("The shape of the mask %v does not match the shape of the indexed tensor %v at dimension %v\n", maskShape, shape, dim)
+				return err
+			}
+			nonzero, err := mask.Nonzero(false)
+			if err != nil {
+				return err
+			}
+			tmps = append(tmps, nonzero)
+			var indices []*Tensor
+			for j := 0; j < ndims; j++ {
+				x, err := nonzero.Select(1, int64(j), false)
+				if err != nil {
+					return err
+				}
+				indices = append(indices, x)
+			}
+			addAxis(indices, nonzero.MustSize()[0], true)
+			dim += ndims
+			continue
+		default:
+			err = fmt.Errorf("Unsupported indexer type %T\n", spec)
+			return err
+		}
+		dim++
+	}
+
+	// Like PyTorch, writing to an empty selection is a no-op.
+	for _, size := range meshShape {
+		if size == 0 {
+			return nil
+		}
+	}
+
+	device, err := ts.Device()
+	if err != nil {
+		return err
+	}
+
+	var indices []Tensor
+	for d, x := range dimIndices {
+		viewShape := make([]int64, len(meshShape))
+		for i := range viewShape {
+			viewShape[i] = 1
+		}
+		viewShape[dimAxes[d]] = meshShape[dimAxes[d]]
+
+		x, err := x.Reshape(viewShape, false)
+		if err != nil {
+			return err
+		}
+		tmps = append(tmps, x)
+		x, err = x.To(device, false)
+		if err != nil {
+			return err
+		}
+		tmps = append(tmps, x)
+		indices = append(indices, *x)
+	}
+
+	v, err := value.To(device, false)
+	if err != nil {
+		return err
+	}
+	tmps = append(tmps, v)
+	vdtype, err := v.DTypeE()
+	if err != nil {
+		return err
+	}
+	dtype, err := ts.DTypeE()
+	if err != nil {
+		return err
+	}
+	if vdtype != dtype {
+		v, err = v.Totype(dtype, false)
+		if err != nil {
+			return err
+		}
+		tmps = append(tmps, v)
+	}
+	v, err = v.Expand(valueShape, false, false)
+	if err != nil {
+		return err
+	}
+	tmps = append(tmps, v)
+	v, err = v.Reshape(meshShape, false)
+	if err != nil {
+		return err
+	}
+	tmps = append(tmps, v)
+
+	return ts.IndexPut_(indices, v, false)
+}
+
+// MustIdxPut writes `value` in place to elements of the tensor selected by
+// `index`. It panics if error occurred.
+func (ts *Tensor) MustIdxPut(index interface{}, value *Tensor) {
+	if err := ts.IdxPut(index, value); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// sliceBounds returns start and end of a Python-like slice clamped to a
+// dimension of given size.
+func sliceBounds(start, end, size int64) (int64, int64) {
+	if start < 0 {
+		start += size
+	}
+	if end < 0 {
+		end += size
+	}
+	if start < 0 {
+		start = 0
+	}
+	if start > size {
+		start = size
+	}
+	if end > size {
+		end = size
+	}
+	if end < start {
+		end = start
+	}
+
+	return start, end
+}
+
+// rangeIndex returns a 1D int64 tensor of indices from start (inclusive) to
+// end (exclusive) with step and its size. The tensor is empty if end <= start.
+func rangeIndex(start, end, step int64) (*Tensor, int64, error) {
+	if end < start {
+		end = start
+	}
+	startS, endS, stepS := IntScalar(start), IntScalar(end), IntScalar(step)
+	x, err := Arange2(startS, endS, stepS, gotch.Int64, gotch.CPU)
+	startS.MustDrop()
+	endS.MustDrop()
+	stepS.MustDrop()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return x, (end - start + step - 1) / step, nil
 }
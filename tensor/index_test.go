@@ -297,3 +297,170 @@ func TestIndex3D(t *testing.T) {
 		t.Errorf("Got tensor values: %v\n", got3)
 	}
 }
+
+func TestStringIndex(t *testing.T) {
+	tensor := ts.MustArange1(ts.IntScalar(0), ts.IntScalar(2*3*4), gotch.Int64, gotch.CPU).MustView([]int64{2, 3, 4}, true)
+
+	result1 := tensor.Idx("..., 1:4:2, None")
+	want1 := []int64{1, 3, 5, 7, 9, 11, 13, 15, 17, 19, 21, 23}
+	want1Shape := []int64{2, 3, 2, 1}
+	got1 := result1.Vals()
+	got1Shape := result1.MustSize()
+	if !reflect.DeepEqual(want1, got1) {
+		t.Errorf("Expected tensor values: %v\n", want1)
+		t.Errorf("Got tensor values: %v\n", got1)
+	}
+	if !reflect.DeepEqual(want1Shape, got1Shape) {
+		t.Errorf("Expected tensor shape: %v\n", want1Shape)
+		t.Errorf("Got tensor shape: %v\n", got1Shape)
+	}
+
+	result2 := tensor.Idx("0, ::2, -1")
+	want2 := []int64{3, 11}
+	got2 := result2.Vals()
+	if !reflect.DeepEqual(want2, got2) {
+		t.Errorf("Expected tensor values: %v\n", want2)
+		t.Errorf("Got tensor values: %v\n", got2)
+	}
+
+	result3 := tensor.Idx("-1, 1:-1")
+	want3 := []int64{16, 17, 18, 19}
+	want3Shape := []int64{1, 4}
+	got3 := result3.Vals()
+	got3Shape := result3.MustSize()
+	if !reflect.DeepEqual(want3, got3) {
+		t.Errorf("Expected tensor values: %v\n", want3)
+		t.Errorf("Got tensor values: %v\n", got3)
+	}
+	if !reflect.DeepEqual(want3Shape, got3Shape) {
+		t.Errorf("Expected tensor shape: %v\n", want3Shape)
+		t.Errorf("Got tensor shape: %v\n", got3Shape)
+	}
+
+	if _, err := ts.ParseIndex("1, a:2"); err == nil {
+		t.Errorf("Expected error for invalid index string")
+	}
+}
+
+func TestMaskIndex(t *testing.T) {
+	tensor := ts.MustArange1(ts.IntScalar(0), ts.IntScalar(2*3*4), gotch.Int64, gotch.CPU).MustView([]int64{2, 3, 4}, true)
+
+	mask1 := tensor.MustGe(ts.IntScalar(20), false)
+	result1 := tensor.Idx(mask1)
+	want1 := []int64{20, 21, 22, 23}
+	want1Shape := []int64{4}
+	got1 := result1.Vals()
+	got1Shape := result1.MustSize()
+	if !reflect.DeepEqual(want1, got1) {
+		t.Errorf("Expected tensor values: %v\n", want1)
+		t.Errorf("Got tensor values: %v\n", got1)
+	}
+	if !reflect.DeepEqual(want1Shape, got1Shape) {
+		t.Errorf("Expected tensor shape: %v\n", want1Shape)
+		t.Errorf("Got tensor shape: %v\n", got1Shape)
+	}
+
+	mask2 := ts.MustOfSlice([]bool{true, false, false, true})
+	result2 := tensor.Idx([]ts.TensorIndexer{
+		ts.NewEllipsis(),
+		ts.NewMask(mask2),
+	})
+	want2 := []int64{0, 3, 4, 7, 8, 11, 12, 15, 16, 19, 20, 23}
+	want2Shape := []int64{2, 3, 2}
+	got2 := result2.Vals()
+	got2Shape := result2.MustSize()
+	if !reflect.DeepEqual(want2, got2) {
+		t.Errorf("Expected tensor values: %v\n", want2)
+		t.Errorf("Got tensor values: %v\n", got2)
+	}
+	if !reflect.DeepEqual(want2Shape, got2Shape) {
+		t.Errorf("Expected tensor shape: %v\n", want2Shape)
+		t.Errorf("Got tensor shape: %v\n", got2Shape)
+	}
+}
+
+func TestIdxPut(t *testing.T) {
+	tensor := ts.MustZeros([]int64{2, 3}, gotch.Float, gotch.CPU)
+
+	err := tensor.IdxPut(":, 1:", ts.MustOfSlice([]float32{1, 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want1 := []float32{0, 1, 2, 0, 1, 2}
+	got1 := tensor.Vals()
+	if !reflect.DeepEqual(want1, got1) {
+		t.Errorf("Expected tensor values: %v\n", want1)
+		t.Errorf("Got tensor values: %v\n", got1)
+	}
+
+	// value is broadcast and converted to tensor dtype.
+	err = tensor.IdxPut("..., 0", ts.MustOfSlice([]int64{7}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want2 := []float32{7, 1, 2, 7, 1, 2}
+	got2 := tensor.Vals()
+	if !reflect.DeepEqual(want2, got2) {
+		t.Errorf("Expected tensor values: %v\n", want2)
+		t.Errorf("Got tensor values: %v\n", got2)
+	}
+
+	mask := tensor.MustGe(ts.FloatScalar(2), false)
+	err = tensor.IdxPut(mask, ts.MustOfSlice([]float32{-1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want3 := []float32{-1, 1, -1, -1, 1, -1}
+	got3 := tensor.Vals()
+	if !reflect.DeepEqual(want3, got3) {
+		t.Errorf("Expected tensor values: %v\n", want3)
+		t.Errorf("Got tensor values: %v\n", got3)
+	}
+
+	err = tensor.IdxPut("1, ::2", ts.MustOfSlice([]float32{5, 6}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want4 := []float32{-1, 1, -1, 5, 1, 6}
+	got4 := tensor.Vals()
+	if !reflect.DeepEqual(want4, got4) {
+		t.Errorf("Expected tensor values: %v\n", want4)
+		t.Errorf("Got tensor values: %v\n", got4)
+	}
+}
+
+func TestIdxPut_Empty(t *testing.T) {
+	tensor := ts.MustZeros([]int64{3, 2}, gotch.Float, gotch.CPU)
+	value := ts.MustOfSlice([]float32{1})
+
+	// Writing to an empty selection is a no-op as `Idx` returns an empty tensor.
+	indexes := []interface{}{
+		"5:",
+		":0",
+		":, 1:1",
+		[]ts.TensorIndexer{ts.NewNarrow(1, 1)},
+	}
+	for _, index := range indexes {
+		x := tensor.Idx(index)
+		if got := x.Numel(); got != 0 {
+			t.Errorf("Expected empty tensor for index %v, got %v elements\n", index, got)
+		}
+		x.MustDrop()
+
+		if err := tensor.IdxPut(index, value); err != nil {
+			t.Errorf("Index %v: %v\n", index, err)
+		}
+	}
+
+	want := []float32{0, 0, 0, 0, 0, 0}
+	got := tensor.Vals()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", got)
+	}
+
+	// Other indexers are still checked.
+	if err := tensor.IdxPut("5:, 3", value); err == nil {
+		t.Errorf("Expected error for out of bounds index\n")
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
-	"log"
 	"reflect"
 	"unsafe"
 
@@ -50,13 +49,25 @@ type IValue struct {
 }
 
 // NewIValue creates a new IValue from given value of various types.
+// It panics if type of `v` is not supported.
 func NewIValue(v interface{}) *IValue {
+	retVal, err := NewIValueE(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return retVal
+}
+
+// NewIValueE creates a new IValue from given value of various types. It
+// returns error if type of `v` is not supported.
+func NewIValueE(v interface{}) (*IValue, error) {
 
 	retVal := &IValue{value: v}
 	if v == nil {
 		retVal.kind = NoneVal
 		retVal.name = "None"
-		return retVal
+		return retVal, nil
 	}
 
 	inputTypeStr := reflect.TypeOf(v).Kind().String()
@@ -134,7 +145,7 @@ func NewIValue(v interface{}) *IValue {
 				retVal.name = "TensorList"
 				retVal.value = v.([]Tensor)
 			default:
-				log.Fatalf("NewIValue method call - 'slice -> struct' case - Unsupported type (%v)\n", reflect.TypeOf(v).Kind().String())
+				return nil, fmt.Errorf("NewIValue method call - 'slice -> struct' case - Unsupported type (%v)\n", reflect.TypeOf(v).Kind().String())
 			}
 		}
 	case "map":
@@ -149,13 +160,13 @@ func NewIValue(v interface{}) *IValue {
 			retVal.kind = TensorVal
 			retVal.name = "Tensor"
 		default:
-			log.Fatalf("NewIValue method call - 'struct' case - Unsupported type (%v)\n", reflect.TypeOf(v).Kind().String())
+			return nil, fmt.Errorf("NewIValue method call - 'struct' case - Unsupported type (%v)\n", reflect.TypeOf(v).Kind().String())
 		}
 	default:
-		log.Fatalf("NewIValue method call - Unsupported type (%v)\n", reflect.TypeOf(v).Kind().String())
+		return nil, fmt.Errorf("NewIValue method call - Unsupported type (%v)\n", reflect.TypeOf(v).Kind().String())
 	}
 
-	return retVal
+	return retVal, nil
 }
 
 // IValue methods:
@@ -209,7 +220,10 @@ func (iv *IValue) ToCIValue() (*CIValue, error) {
 			var v []Tensor = iv.value.([]Tensor)
 			var cvals []lib.Civalue
 			for _, tensor := range v {
-				ival := NewIValue(tensor)
+				ival, err := NewIValueE(tensor)
+				if err != nil {
+					return nil, err
+				}
 				cval, err := ival.ToCIValue()
 				if err != nil {
 					err = fmt.Errorf("ToCIValue method call err - Tuple case: %v\n", err)
@@ -254,7 +268,10 @@ func (iv *IValue) ToCIValue() (*CIValue, error) {
 		case "string":
 			var v []string = iv.value.([]string)
 			for _, i := range v {
-				ival := NewIValue(i)
+				ival, err := NewIValueE(i)
+				if err != nil {
+					return nil, err
+				}
 				cval, err := ival.ToCIValue()
 				if err != nil {
 					err = fmt.Errorf("ToCIValue method call err - GenericList case: %v\n", err)
@@ -266,35 +283,48 @@ func (iv *IValue) ToCIValue() (*CIValue, error) {
 		case "int":
 			var v []int = iv.value.([]int)
 			for _, i := range v {
-				ival := NewIValue(i)
+				ival, err := NewIValueE(i)
+				if err != nil {
+					return nil, err
+				}
 				cval, err := ival.ToCIValue()
 				if err != nil {
-					log.Fatalf("ToCIValue method call err - int case: %v\n", err)
+					err = fmt.Errorf("ToCIValue method call err - int case: %v\n", err)
+					return nil, err
 				}
 				cvals = append(cvals, cval.civalue)
 			}
 		case "int32":
 			var v []int32 = iv.value.([]int32)
 			for _, i := range v {
-				ival := NewIValue(i)
+				ival, err := NewIValueE(i)
+				if err != nil {
+					return nil, err
+				}
 				cval, err := ival.ToCIValue()
 				if err != nil {
-					log.Fatalf("ToCIValue method call err - int32 case: %v\n", err)
+					err = fmt.Errorf("ToCIValue method call err - int32 case: %v\n", err)
+					return nil, err
 				}
 				cvals = append(cvals, cval.civalue)
 			}
 		case "float32":
 			var v []float32 = iv.value.([]float32)
 			for _, i := range v {
-				ival := NewIValue(i)
+				ival, err := NewIValueE(i)
+				if err != nil {
+					return nil, err
+				}
 				cval, err := ival.ToCIValue()
 				if err != nil {
-					log.Fatalf("ToCIValue method call err - float32 case: %v\n", err)
+					err = fmt.Errorf("ToCIValue method call err - float32 case: %v\n", err)
+					return nil, err
 				}
 				cvals = append(cvals, cval.civalue)
 			}
 		default:
-			log.Fatalf("ToCIValue method call err - Default case: Unsupport type (%v)\n", vtyp)
+			err := fmt.Errorf("ToCIValue method call err - Default case: Unsupport type (%v)\n", vtyp)
+			return nil, err
 
 		}
 
@@ -361,10 +391,14 @@ func (iv *IValue) ToCIValue() (*CIValue, error) {
 				vals = append(vals, k, v)
 			}
 			for _, v := range vals {
-				ival := NewIValue(v)
+				ival, err := NewIValueE(v)
+				if err != nil {
+					return nil, err
+				}
 				cval, err := ival.ToCIValue()
 				if err != nil {
-					log.Fatalf("ToCIValue method call err - GenericDict case: %v\n", err)
+					err = fmt.Errorf("ToCIValue method call err - GenericDict case: %v\n", err)
+					return nil, err
 				}
 				cvals = append(cvals, cval.civalue)
 			}
@@ -376,10 +410,14 @@ func (iv *IValue) ToCIValue() (*CIValue, error) {
 				vals = append(vals, k, v)
 			}
 			for _, v := range vals {
-				ival := NewIValue(v)
+				ival, err := NewIValueE(v)
+				if err != nil {
+					return nil, err
+				}
 				cval, err := ival.ToCIValue()
 				if err != nil {
-					log.Fatalf("ToCIValue method call err - GenericDict case: %v\n", err)
+					err = fmt.Errorf("ToCIValue method call err - GenericDict case: %v\n", err)
+					return nil, err
 				}
 				cvals = append(cvals, cval.civalue)
 			}
@@ -391,10 +429,14 @@ func (iv *IValue) ToCIValue() (*CIValue, error) {
 				vals = append(vals, k, v)
 			}
 			for _, v := range vals {
-				ival := NewIValue(v)
+				ival, err := NewIValueE(v)
+				if err != nil {
+					return nil, err
+				}
 				cval, err := ival.ToCIValue()
 				if err != nil {
-					log.Fatalf("ToCIValue method call err - GenericDict case: %v\n", err)
+					err = fmt.Errorf("ToCIValue method call err - GenericDict case: %v\n", err)
+					return nil, err
 				}
 				cvals = append(cvals, cval.civalue)
 			}
@@ -406,7 +448,8 @@ func (iv *IValue) ToCIValue() (*CIValue, error) {
 		// ...
 
 		default:
-			log.Fatalf("ToCIValue method call - GenericDict case: unsupported key type(%v) or value type(%v) \n", keyType, valType)
+			err := fmt.Errorf("ToCIValue method call - GenericDict case: unsupported key type(%v) or value type(%v) \n", keyType, valType)
+			return nil, err
 		}
 
 		// 2. Pairing key and value in a slice (cvals)
@@ -526,7 +569,7 @@ func IValueFromC(cval *CIValue) (*IValue, error) {
 					return nil, err
 				}
 
-				vals = append(vals, Tensor{v.Value().(lib.Ctensor)})
+				vals = append(vals, newTensorValue(v.Value().(lib.Ctensor)))
 			}
 			return &IValue{
 				value: vals,
@@ -719,11 +762,11 @@ func IValueFromC(cval *CIValue) (*IValue, error) {
 
 		// 3. Get values
 		var tensors []Tensor
-		tensors = append(tensors, Tensor{ctensor: *ptr1})
+		tensors = append(tensors, newTensorValue(*ptr1))
 		currPtr := ptr1
 		for i := 1; i < int(len); i++ {
 			nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currPtr)) + unsafe.Sizeof(ptr1)))
-			tensors = append(tensors, Tensor{ctensor: *nextPtr})
+			tensors = append(tensors, newTensorValue(*nextPtr))
 			currPtr = nextPtr
 		}
 
@@ -812,7 +855,8 @@ func IValueFromC(cval *CIValue) (*IValue, error) {
 			}, nil
 
 		default:
-			log.Fatalf("IValueFromC method call - GenericList case: Unsupported item type (%v)\n", itemTyp)
+			err := fmt.Errorf("IValueFromC method call - GenericList case: Unsupported item type (%v)\n", itemTyp)
+			return nil, err
 		}
 
 	case 13: // GenericDict map[IValue]IValue
@@ -943,11 +987,9 @@ type CModule struct {
 	Cmodule lib.Cmodule
 }
 
-func (cm *CModule) Drop() {
+func (cm *CModule) Drop() error {
 	lib.AtmFree(cm.Cmodule)
-	if err := TorchErr(); err != nil {
-		log.Fatalf("CModule Drop method err: %v\n", err)
-	}
+	return TorchErr()
 }
 
 // Loads a PyTorch saved JIT model from a file.
@@ -1054,7 +1096,7 @@ func (cm *CModule) ForwardTs(tensors []Tensor) (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // ForwardIs performs the forward pass for a model on some specified ivalue input.
@@ -1112,11 +1154,9 @@ func (cm *CModule) ForwardIs(ivalues []IValue) (*IValue, error) {
 }
 
 // To moves CModule to specified device.
-func (cm *CModule) To(device gotch.Device, kind gotch.DType, nonBlocking bool) {
+func (cm *CModule) To(device gotch.Device, kind gotch.DType, nonBlocking bool) error {
 	lib.AtmTo(cm.Cmodule, device.CInt(), kind.CInt(), nonBlocking)
-	if err := TorchErr(); err != nil {
-		log.Fatalf("CModule To method call err: %v\n", err)
-	}
+	return TorchErr()
 }
 
 // Save save CModule to a specified path.
@@ -1138,7 +1178,7 @@ func (cm *CModule) NamedParameters() ([]NamedTensor, error) {
 	for _, v := range data.NamedCtensors {
 		namedTensor := NamedTensor{
 			Name:   v.Name,
-			Tensor: &Tensor{v.Ctensor},
+			Tensor: newTensor(v.Ctensor),
 		}
 
 		namedTensors = append(namedTensors, namedTensor)
@@ -1147,38 +1187,42 @@ func (cm *CModule) NamedParameters() ([]NamedTensor, error) {
 	return namedTensors, nil
 }
 
-// GetProfilingMode get CModule profiling mode
+// GetProfilingMode get CModule profiling mode. It panics if error occurred.
 func (cm *CModule) GetProfilingMode() bool {
+	retVal, err := cm.GetProfilingModeE()
+	if err != nil {
+		panic(err)
+	}
+
+	return retVal
+}
+
+// GetProfilingModeE get CModule profiling mode.
+func (cm *CModule) GetProfilingModeE() (bool, error) {
 	retVal := lib.AtmGetProfilingMode()
 	if err := TorchErr(); err != nil {
-		log.Fatal(err)
+		return false, err
 	}
 
-	return retVal
+	return retVal, nil
 }
 
 // SetProfilingMode set CModule profiling mode
-func (cm *CModule) SetProfilingMode(b bool) {
+func (cm *CModule) SetProfilingMode(b bool) error {
 	lib.AtmSetProfilingMode(b)
-	if err := TorchErr(); err != nil {
-		log.Fatal(err)
-	}
+	return TorchErr()
 }
 
 // SetTrain set CModule to train mode
-func (cm *CModule) SetTrain() {
+func (cm *CModule) SetTrain() error {
 	lib.AtmTrain(cm.Cmodule)
-	if err := TorchErr(); err != nil {
-		log.Fatal(err)
-	}
+	return TorchErr()
 }
 
 // SetEval set CModule to inference mode
-func (cm *CModule) SetEval() {
+func (cm *CModule) SetEval() error {
 	lib.AtmEval(cm.Cmodule)
-	if err := TorchErr(); err != nil {
-		log.Fatal(err)
-	}
+	return TorchErr()
 }
 
 // Implement Module for CModule:
@@ -1194,11 +1238,12 @@ func (cm *CModule) Forward(tensor *Tensor) (*Tensor, error) {
 // Tensor methods for CModule:
 // ======================================
 
-// Apply forwards tensor itself through a module.
+// Apply forwards tensor itself through a module. It panics if error occurred.
+// Use `CModule.Forward` to get the error instead.
 func (ts *Tensor) ApplyCModule(m *CModule) *Tensor {
 	retVal, err := m.Forward(ts)
 	if err != nil {
-		log.Fatal(err)
+		panic(err)
 	}
 
 	return retVal
@@ -2,8 +2,11 @@ package tensor_test
 
 import (
 	"reflect"
+	"runtime"
+	"sync"
 	"testing"
 
+	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
 )
 
@@ -114,3 +117,80 @@ func TestModuleForwardIValue(t *testing.T) {
 	 * } */
 
 }
+
+func TestSetNumThreads(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	prev := gotch.GetNumThreads()
+	defer gotch.SetNumThreads(prev)
+
+	if err := gotch.SetNumThreads(1); err != nil {
+		t.Fatal(err)
+	}
+	if got := gotch.GetNumThreads(); got != 1 {
+		t.Errorf("Want: %v\n", 1)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	if err := gotch.SetNumThreads(0); err == nil {
+		t.Errorf("Expected error for invalid number of threads")
+	}
+}
+
+// Goroutines share one module and run `Forward` concurrently with 1 intra-op
+// thread each as recommended for serving.
+func TestModuleForwardParallel(t *testing.T) {
+	runtime.LockOSThread()
+	prev := gotch.GetNumThreads()
+	if err := gotch.SetNumThreads(1); err != nil {
+		t.Fatal(err)
+	}
+	runtime.UnlockOSThread()
+	defer gotch.SetNumThreads(prev)
+
+	foo, err := ts.ModuleLoad("foo1.gt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		errCh   = make(chan error, 8)
+		wrongCh = make(chan int64, 8)
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int64) {
+			defer wg.Done()
+			for j := int64(0); j < 20; j++ {
+				ts1 := ts.TensorFrom([]int64{i})
+				ts2 := ts.TensorFrom([]int64{j})
+				res, err := foo.ForwardTs([]ts.Tensor{*ts1, *ts2})
+				if err != nil {
+					errCh <- err
+					return
+				}
+				// foo1.gt: 2*x + y
+				got := int64(res.Float64Values()[0])
+				if got != 2*i+j {
+					wrongCh <- got
+					return
+				}
+				ts1.MustDrop()
+				ts2.MustDrop()
+				res.MustDrop()
+			}
+		}(int64(i))
+	}
+	wg.Wait()
+	close(errCh)
+	close(wrongCh)
+
+	for err := range errCh {
+		t.Error(err)
+	}
+	for got := range wrongCh {
+		t.Errorf("Got wrong value: %v\n", got)
+	}
+}
@@ -0,0 +1,185 @@
+package tensor
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LazyTensors is a checkpoint file (.npz or safetensors) which is memory-mapped
+// and whose tensors are only loaded when requested with `Get`. It helps to
+// load big models as only one tensor is materialized at a time.
+//
+// NOTE. Tensors of .npz file should be stored without compression
+// (numpy `savez`) to be read directly from mapped memory. Compressed ones are
+// still supported but decompressed into memory one at a time.
+type LazyTensors struct {
+	data    []byte
+	unmap   func() error
+	names   []string
+	entries map[string]lazyEntry
+}
+
+type lazyEntry struct {
+	npzFile     *zip.File         // npz entry
+	safetensors *safetensorsEntry // safetensors entry
+	offset      int64             // offset of safetensors byte buffer
+}
+
+// OpenLazy memory-maps a checkpoint file in .npz or safetensors format and
+// reads its tensor names. `Close` should be called when done.
+func OpenLazy(filePath string) (*LazyTensors, error) {
+	data, unmap, err := mmapFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &LazyTensors{
+		data:    data,
+		unmap:   unmap,
+		entries: make(map[string]lazyEntry),
+	}
+
+	// zip file starts with local file header or end of central directory
+	// signature (empty zip).
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) || bytes.HasPrefix(data, []byte("PK\x05\x06")) {
+		err = l.openNpz()
+	} else {
+		err = l.openSafetensors()
+	}
+	if err != nil {
+		unmap()
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (l *LazyTensors) openNpz() error {
+	zr, err := zip.NewReader(bytes.NewReader(l.data), int64(len(l.data)))
+	if err != nil {
+		return err
+	}
+
+	for _, f := range zr.File {
+		// remove file extension to get tensor name
+		name := strings.TrimSuffix(f.Name, filepath.Ext(f.Name))
+		l.names = append(l.names, name)
+		l.entries[name] = lazyEntry{npzFile: f}
+	}
+
+	return nil
+}
+
+func (l *LazyTensors) openSafetensors() error {
+	header, err := readSafetensorsHeader(bytes.NewReader(l.data))
+	if err != nil {
+		return err
+	}
+
+	if header.offset+header.dataSize > int64(len(l.data)) {
+		err := fmt.Errorf("invalid safetensors file: data size (%v) is larger than file size (%v).\n", header.offset+header.dataSize, len(l.data))
+		return err
+	}
+
+	for i := range header.entries {
+		e := &header.entries[i]
+		l.names = append(l.names, e.name)
+		l.entries[e.name] = lazyEntry{safetensors: e, offset: header.offset}
+	}
+
+	return nil
+}
+
+// Names returns names of all tensors in the file.
+func (l *LazyTensors) Names() []string {
+	names := make([]string, len(l.names))
+	copy(names, l.names)
+	sort.Strings(names)
+
+	return names
+}
+
+// Has returns whether a tensor with given name exists in the file.
+func (l *LazyTensors) Has(name string) bool {
+	_, ok := l.entries[name]
+	return ok
+}
+
+// Get loads the tensor with given name to CPU memory.
+func (l *LazyTensors) Get(name string) (*Tensor, error) {
+	e, ok := l.entries[name]
+	if !ok {
+		err := fmt.Errorf("Cannot find tensor with name: %v in file.\n", name)
+		return nil, err
+	}
+
+	if e.safetensors != nil {
+		data := l.data[e.offset+e.safetensors.begin : e.offset+e.safetensors.end]
+		// NOTE. mapped memory is read-only. Copy before swapping bytes.
+		if nativeEndian == binary.BigEndian {
+			data = append([]byte(nil), data...)
+		}
+		return ofSafetensorsData(data, *e.safetensors)
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	switch e.npzFile.Method {
+	case zip.Store:
+		offset, err := e.npzFile.DataOffset()
+		if err != nil {
+			return nil, err
+		}
+		end := offset + int64(e.npzFile.UncompressedSize64)
+		if end > int64(len(l.data)) {
+			err := fmt.Errorf("invalid npz file: data of %v is out of file range.\n", e.npzFile.Name)
+			return nil, err
+		}
+		data = l.data[offset:end]
+	default:
+		rc, err := e.npzFile.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err = ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r := bytes.NewReader(data)
+	h, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	header, err := ParseNpyHeader(h)
+	if err != nil {
+		return nil, err
+	}
+
+	data = data[len(data)-r.Len():]
+	if header.bigEndian != (nativeEndian == binary.BigEndian) && e.npzFile.Method == zip.Store {
+		data = append([]byte(nil), data...)
+	}
+
+	return ofNpyData(data, header)
+}
+
+// Close unmaps the file from memory. Tensors loaded are not affected.
+func (l *LazyTensors) Close() error {
+	unmap := l.unmap
+	l.data = nil
+	l.entries = nil
+	l.unmap = func() error { return nil }
+
+	return unmap()
+}
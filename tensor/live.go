@@ -0,0 +1,233 @@
+package tensor
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/sugarme/gotch"
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// LiveTensor is information of a tensor which has been created but not freed
+// yet while live tensor tracking is enabled.
+type LiveTensor struct {
+	ID    uint64 // creation order
+	Shape []int64
+	DType gotch.DType
+	Bytes int64
+	Stack string // Go stack trace where the tensor was created
+}
+
+type liveEntry struct {
+	id    uint64
+	gid   int64 // id of goroutine which created the tensor
+	shape []int64
+	dtype gotch.DType
+	bytes int64
+	pcs   []uintptr
+}
+
+var (
+	liveOn      int32 // 1 if live tensor tracking is enabled
+	liveNum     int32 // number of tensors in liveTensors
+	liveLastID  uint64
+	liveTensors = make(map[lib.Ctensor]*liveEntry)
+)
+
+// LiveTensorsSetEnabled sets whether tensors created from now on are recorded
+// with their shape, dtype, size in bytes and creation stack trace until they
+// are freed. It is meant for debugging memory leaks as it slows down tensor
+// creation. It returns PREVIOUS state.
+//
+// Disabling it keeps records of tensors already tracked so that they can be
+// still reported by `LiveTensors` and `DumpLeaks`.
+func LiveTensorsSetEnabled(b bool) bool {
+	scopeMu.Lock()
+	defer scopeMu.Unlock()
+
+	prev := atomic.LoadInt32(&liveOn) == 1
+	if b != prev {
+		if b {
+			atomic.StoreInt32(&liveOn, 1)
+			atomic.AddInt32(&trackState, 1)
+		} else {
+			atomic.StoreInt32(&liveOn, 0)
+			atomic.AddInt32(&trackState, -1)
+		}
+	}
+
+	return prev
+}
+
+// LiveTensors returns recorded tensors which have not been freed, in creation
+// order. See `LiveTensorsSetEnabled`.
+func LiveTensors() []LiveTensor {
+	return liveTensorsAfter(0, 0)
+}
+
+// DumpLeaks writes a report of recorded tensors which have not been freed to
+// w. See `LiveTensorsSetEnabled`.
+func DumpLeaks(w io.Writer) error {
+	return dumpLiveTensors(w, LiveTensors())
+}
+
+// TestingT is the subset of `testing.TB` used by `CheckLeaks`.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// CheckLeaks runs fn with live tensor tracking enabled and fails the test if
+// any tensor created in fn is still alive when fn returns.
+//
+// Live tensor tracking is process-wide, so only tensors created by the
+// goroutine running fn are checked: tensors created meanwhile by other
+// goroutines (e.g. `t.Parallel()` tests or data loader workers) are not
+// reported as leaks of fn. This also means tensors created by goroutines
+// started in fn are not checked.
+//
+// Example:
+//
+//	func TestNoLeak(t *testing.T) {
+//		ts.CheckLeaks(t, func() {
+//			x := ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+//			x.MustDrop()
+//		})
+//	}
+func CheckLeaks(t TestingT, fn func()) {
+	t.Helper()
+
+	prev := LiveTensorsSetEnabled(true)
+	defer LiveTensorsSetEnabled(prev)
+
+	lastID := atomic.LoadUint64(&liveLastID)
+	fn()
+
+	leaks := liveTensorsAfter(lastID, goroutineID())
+	if len(leaks) == 0 {
+		return
+	}
+
+	var sb strings.Builder
+	dumpLiveTensors(&sb, leaks)
+	t.Errorf("%v tensor(s) leaked:\n%v", len(leaks), sb.String())
+}
+
+// liveTensorsAfter returns live tensors created after tensor with given id by
+// goroutine gid, or by any goroutine if gid is 0.
+func liveTensorsAfter(id uint64, gid int64) []LiveTensor {
+	scopeMu.Lock()
+	var entries []*liveEntry
+	for _, e := range liveTensors {
+		if e.id > id && (gid == 0 || e.gid == gid) {
+			entries = append(entries, e)
+		}
+	}
+	scopeMu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].id < entries[j].id
+	})
+
+	tensors := make([]LiveTensor, len(entries))
+	for i, e := range entries {
+		tensors[i] = LiveTensor{
+			ID:    e.id,
+			Shape: e.shape,
+			DType: e.dtype,
+			Bytes: e.bytes,
+			Stack: formatStack(e.pcs),
+		}
+	}
+
+	return tensors
+}
+
+func dumpLiveTensors(w io.Writer, tensors []LiveTensor) error {
+	var total int64
+	for _, x := range tensors {
+		total += x.Bytes
+	}
+	if _, err := fmt.Fprintf(w, "%v live tensor(s), %v bytes in total\n", len(tensors), total); err != nil {
+		return err
+	}
+
+	for _, x := range tensors {
+		_, err := fmt.Fprintf(w, "\ntensor #%v: shape=%v dtype=%v bytes=%v, created at:\n%v", x.ID, x.Shape, x.DType, x.Bytes, x.Stack)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func formatStack(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		fmt.Fprintf(&sb, "\t%v\n\t\t%v:%v\n", f.Function, f.File, f.Line)
+		if !more {
+			break
+		}
+	}
+
+	return sb.String()
+}
+
+// newLiveEntry records information of a newly created tensor if live tensor
+// tracking is enabled, otherwise it returns nil.
+func newLiveEntry(x *Tensor) *liveEntry {
+	if atomic.LoadInt32(&liveOn) == 0 {
+		return nil
+	}
+
+	e := &liveEntry{
+		id:  atomic.AddUint64(&liveLastID, 1),
+		gid: goroutineID(),
+	}
+
+	// skip runtime.Callers, newLiveEntry and newTensor.
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	e.pcs = pcs[:n]
+
+	if defined, err := x.Defined(); err == nil && defined {
+		if shape, err := x.Size(); err == nil {
+			e.shape = shape
+			e.dtype = x.DType()
+			if size, err := gotch.DTypeSize(e.dtype); err == nil {
+				e.bytes = ElementCount(shape) * int64(size)
+			}
+		}
+	}
+
+	return e
+}
+
+// addLive adds live tensor entry to registry.
+// NOTE. caller must hold `scopeMu`.
+func addLive(c lib.Ctensor, e *liveEntry) {
+	if _, ok := liveTensors[c]; !ok {
+		atomic.AddInt32(&liveNum, 1)
+	}
+	liveTensors[c] = e
+}
+
+// removeLive removes a freed ctensor from live tensor registry.
+// NOTE. caller must hold `scopeMu`.
+func removeLive(c lib.Ctensor) {
+	if _, ok := liveTensors[c]; ok {
+		delete(liveTensors, c)
+		atomic.AddInt32(&liveNum, -1)
+	}
+}
@@ -0,0 +1,111 @@
+package tensor_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+type fakeT struct {
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestLiveTensors(t *testing.T) {
+	prev := ts.LiveTensorsSetEnabled(true)
+	defer ts.LiveTensorsSetEnabled(prev)
+
+	x := ts.MustOnes([]int64{2, 3}, gotch.Float, gotch.CPU)
+	y := ts.MustZeros([]int64{4}, gotch.Int64, gotch.CPU)
+	y.MustDrop()
+
+	var found *ts.LiveTensor
+	for _, lt := range ts.LiveTensors() {
+		if strings.Contains(lt.Stack, "TestLiveTensors") {
+			lt := lt
+			found = &lt
+		}
+	}
+	if found == nil {
+		t.Fatalf("Expected live tensor created in TestLiveTensors")
+	}
+	if !reflect.DeepEqual(found.Shape, []int64{2, 3}) || found.DType != gotch.Float || found.Bytes != 24 {
+		t.Errorf("Want: shape=%v dtype=%v bytes=%v\n", []int64{2, 3}, gotch.Float, 24)
+		t.Errorf("Got: shape=%v dtype=%v bytes=%v\n", found.Shape, found.DType, found.Bytes)
+	}
+
+	var buf bytes.Buffer
+	if err := ts.DumpLeaks(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "shape=[2 3] dtype=float32 bytes=24") {
+		t.Errorf("Got unexpected dump: %v\n", buf.String())
+	}
+
+	x.MustDrop()
+	for _, lt := range ts.LiveTensors() {
+		if strings.Contains(lt.Stack, "TestLiveTensors") {
+			t.Errorf("Got live tensor after drop: %v\n", lt)
+		}
+	}
+}
+
+func TestCheckLeaks(t *testing.T) {
+	// No leak.
+	ft := new(fakeT)
+	ts.CheckLeaks(ft, func() {
+		x := ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+		y := x.MustMul1(ts.FloatScalar(2.0), true)
+		y.MustDrop()
+		ts.WithScope(func() {
+			ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+		})
+	})
+	if len(ft.errors) != 0 {
+		t.Errorf("Want: no error\n")
+		t.Errorf("Got: %v\n", ft.errors)
+	}
+
+	// Leak.
+	var leaked *ts.Tensor
+	ft = new(fakeT)
+	ts.CheckLeaks(ft, func() {
+		leaked = ts.MustOnes([]int64{3}, gotch.Float, gotch.CPU)
+	})
+	if len(ft.errors) != 1 || !strings.Contains(ft.errors[0], "1 tensor(s) leaked") {
+		t.Errorf("Want: 1 tensor(s) leaked\n")
+		t.Errorf("Got: %v\n", ft.errors)
+	}
+	leaked.MustDrop()
+}
+
+func TestCheckLeaks_Goroutine(t *testing.T) {
+	// Tensors of other goroutines are not leaks of fn.
+	started, done := make(chan struct{}), make(chan struct{})
+	var other *ts.Tensor
+	ft := new(fakeT)
+	ts.CheckLeaks(ft, func() {
+		go func() {
+			<-started
+			other = ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+			close(done)
+		}()
+		close(started)
+		<-done
+	})
+	if len(ft.errors) != 0 {
+		t.Errorf("Want: no error\n")
+		t.Errorf("Got: %v\n", ft.errors)
+	}
+	other.MustDrop()
+}
@@ -0,0 +1,389 @@
+package tensor
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+
+	"github.com/sugarme/gotch"
+)
+
+// Binary tensor format:
+//
+// A tensor is encoded as a self-describing record:
+//
+//   - 4 bytes: magic "GTSR"
+//   - 1 byte: format version (1)
+//   - 1 byte: byte order of data, 0 for little-endian, 1 for big-endian
+//   - 1 byte: compression of data, 0 for none, 1 for DEFLATE
+//   - 1 byte: dtype (libtorch scalar type)
+//   - uvarint: number of dimensions, followed by an uvarint for each dimension
+//   - uvarint: N, size of data in bytes (after compression)
+//   - N bytes: tensor data in C order
+//
+// Data are written in native byte order and swapped on reading if needed.
+// Named tensors are encoded as an uvarint number of tensors followed by, for
+// each tensor, an uvarint length of its name, the name and the tensor record.
+//
+// The format is used by `MarshalBinary`, `WriteTo` (and so `encoding/gob`)
+// without compression, `WriteTensor` and `WriteNamedTensors`.
+const (
+	tensorMagic   string = "GTSR"
+	tensorVersion byte   = 1
+
+	compressionNone    byte = 0
+	compressionDeflate byte = 1
+
+	maxDeflateRatio = 1032 // maximum compression ratio of DEFLATE
+)
+
+// WriteTensor writes tensor `x` to `w` in binary tensor format and returns
+// number of bytes written. If `compressed` is true, data are compressed with
+// DEFLATE, which pays off for tensors with many repeated values (e.g. zeros).
+func WriteTensor(w io.Writer, x *Tensor, compressed bool) (int64, error) {
+	dtype := x.DType()
+	cint, err := gotch.DType2CInt(dtype)
+	if err != nil {
+		return 0, err
+	}
+	shape, err := x.Size()
+	if err != nil {
+		return 0, err
+	}
+	data, err := x.rawData()
+	if err != nil {
+		return 0, err
+	}
+
+	compression := compressionNone
+	if compressed {
+		compression = compressionDeflate
+		var buf bytes.Buffer
+		fw, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := fw.Write(data); err != nil {
+			return 0, err
+		}
+		if err := fw.Close(); err != nil {
+			return 0, err
+		}
+		data = buf.Bytes()
+	}
+
+	var order byte
+	if nativeEndian == binary.BigEndian {
+		order = 1
+	}
+
+	header := append([]byte(tensorMagic), tensorVersion, order, compression, byte(cint))
+	header = appendUvarint(header, uint64(len(shape)))
+	for _, dim := range shape {
+		header = appendUvarint(header, uint64(dim))
+	}
+	header = appendUvarint(header, uint64(len(data)))
+
+	n, err := w.Write(header)
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := w.Write(data)
+
+	return int64(n + m), err
+}
+
+// ReadTensor reads a tensor (on CPU) in binary tensor format from `r`. It
+// reads exactly one record so that several tensors can be read from the same
+// stream.
+func ReadTensor(r io.Reader) (*Tensor, error) {
+	x, _, err := readTensor(&countingReader{r: r})
+	return x, err
+}
+
+// MustReadTensor reads a tensor in binary tensor format from `r`. It panics
+// if error occurred.
+func MustReadTensor(r io.Reader) *Tensor {
+	x, err := ReadTensor(r)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
+// readTensor reads a tensor record and returns number of bytes read.
+func readTensor(r *countingReader) (*Tensor, int64, error) {
+	start := r.n
+
+	header := make([]byte, len(tensorMagic)+4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, r.n - start, err
+	}
+	if string(header[:len(tensorMagic)]) != tensorMagic {
+		err := fmt.Errorf("invalid tensor data: bad magic %q.\n", header[:len(tensorMagic)])
+		return nil, r.n - start, err
+	}
+	version, order, compression, cint := header[4], header[5], header[6], header[7]
+	if version != tensorVersion {
+		err := fmt.Errorf("unsupported tensor format version %v.\n", version)
+		return nil, r.n - start, err
+	}
+	if order > 1 || compression > compressionDeflate {
+		err := fmt.Errorf("invalid tensor data: byte order (%v) or compression (%v).\n", order, compression)
+		return nil, r.n - start, err
+	}
+	dtype, err := gotch.CInt2DType(gotch.CInt(cint))
+	if err != nil {
+		return nil, r.n - start, err
+	}
+	eltSizeInBytes, err := gotch.DTypeSize(dtype)
+	if err != nil {
+		return nil, r.n - start, err
+	}
+
+	ndims, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, r.n - start, err
+	}
+	if ndims > 64 {
+		err := fmt.Errorf("invalid tensor data: too many dimensions (%v).\n", ndims)
+		return nil, r.n - start, err
+	}
+	shape := make([]int64, ndims)
+	nbytes := uint64(eltSizeInBytes)
+	for i := range shape {
+		dim, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, r.n - start, err
+		}
+		if dim > 0 && nbytes > (1<<62)/dim {
+			err := fmt.Errorf("invalid tensor data: shape is too large.\n")
+			return nil, r.n - start, err
+		}
+		shape[i] = int64(dim)
+		nbytes *= dim
+	}
+
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, r.n - start, err
+	}
+	if compression == compressionNone && size != nbytes {
+		err := fmt.Errorf("invalid tensor data: expected %v bytes of data for dtype %v and shape %v, got %v.\n", nbytes, dtype, shape, size)
+		return nil, r.n - start, err
+	}
+
+	// Data buffer grows with data actually read rather than being allocated
+	// from the header so that corrupted or malicious headers claiming huge
+	// shapes fail on missing data instead of exhausting memory.
+	var buf bytes.Buffer
+	lr := &io.LimitedReader{R: r, N: int64(size)}
+	switch compression {
+	case compressionNone:
+		_, err = io.CopyN(&buf, lr, int64(nbytes))
+	case compressionDeflate:
+		// DEFLATE cannot expand data more than maxDeflateRatio times.
+		if size < nbytes/maxDeflateRatio {
+			err := fmt.Errorf("invalid tensor data: %v bytes of compressed data cannot hold %v bytes.\n", size, nbytes)
+			return nil, r.n - start, err
+		}
+		fr := flate.NewReader(lr)
+		_, err = io.CopyN(&buf, fr, int64(nbytes))
+		if err == nil {
+			// data must end here.
+			if n, _ := fr.Read(make([]byte, 1)); n > 0 {
+				err = fmt.Errorf("invalid tensor data: decompressed data larger than %v bytes.\n", nbytes)
+			}
+		}
+		fr.Close()
+		if err == nil {
+			_, err = io.Copy(ioutil.Discard, lr)
+		}
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, r.n - start, err
+	}
+	data := buf.Bytes()
+
+	if (order == 1) != (nativeEndian == binary.BigEndian) {
+		swapBytes(data, int(eltSizeInBytes))
+	}
+
+	x, err := OfDataSize(data, shape, dtype)
+	if err != nil {
+		return nil, r.n - start, err
+	}
+
+	return x, r.n - start, nil
+}
+
+// WriteNamedTensors writes named tensors to `w` in binary tensor format and
+// returns number of bytes written. If `compressed` is true, data are
+// compressed with DEFLATE.
+func WriteNamedTensors(w io.Writer, namedTensors []NamedTensor, compressed bool) (int64, error) {
+	n, err := w.Write(appendUvarint(nil, uint64(len(namedTensors))))
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+
+	for _, nt := range namedTensors {
+		name := appendUvarint(nil, uint64(len(nt.Name)))
+		name = append(name, nt.Name...)
+		n, err := w.Write(name)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+
+		m, err := WriteTensor(w, nt.Tensor, compressed)
+		written += m
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// ReadNamedTensors reads named tensors (on CPU) in binary tensor format from
+// `r`.
+func ReadNamedTensors(r io.Reader) ([]NamedTensor, error) {
+	cr := &countingReader{r: r}
+	count, err := binary.ReadUvarint(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	var namedTensors []NamedTensor
+	fail := func(err error) ([]NamedTensor, error) {
+		for _, nt := range namedTensors {
+			nt.Tensor.MustDrop()
+		}
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	for i := uint64(0); i < count; i++ {
+		nameLen, err := binary.ReadUvarint(cr)
+		if err != nil {
+			return fail(err)
+		}
+		if nameLen > 1<<20 {
+			err := fmt.Errorf("invalid tensor data: name is too long (%v bytes).\n", nameLen)
+			return fail(err)
+		}
+		name := make([]byte, nameLen)
+		if _, err := io.ReadFull(cr, name); err != nil {
+			return fail(err)
+		}
+
+		x, _, err := readTensor(cr)
+		if err != nil {
+			return fail(err)
+		}
+		namedTensors = append(namedTensors, NamedTensor{string(name), x})
+	}
+
+	return namedTensors, nil
+}
+
+// Implement encoding.BinaryMarshaler, encoding.BinaryUnmarshaler,
+// io.WriterTo and io.ReaderFrom interfaces for Tensor:
+// ====================================================
+
+// MarshalBinary encodes the tensor in binary tensor format without
+// compression. Use `WriteTensor` for compression.
+func (ts *Tensor) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := WriteTensor(&buf, ts, false); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a tensor (on CPU) in binary tensor format, with or
+// without compression, into ts.
+//
+// NOTE. ts should be a new tensor (e.g. `new(ts.Tensor)` or a zero value as
+// when decoding with `encoding/gob`) as the tensor it holds, if any, is
+// replaced without being dropped. The decoded tensor is not tracked by scopes
+// and should be dropped manually.
+func (ts *Tensor) UnmarshalBinary(data []byte) error {
+	r := &countingReader{r: bytes.NewReader(data)}
+	x, _, err := readTensor(r)
+	if err != nil {
+		return err
+	}
+	if r.n != int64(len(data)) {
+		x.MustDrop()
+		err := fmt.Errorf("invalid tensor data: %v trailing bytes.\n", int64(len(data))-r.n)
+		return err
+	}
+	ts.ctensor = Untrack(x).ctensor
+
+	return nil
+}
+
+// WriteTo writes the tensor to `w` in binary tensor format without
+// compression and returns number of bytes written. Use `WriteTensor` for
+// compression.
+func (ts *Tensor) WriteTo(w io.Writer) (int64, error) {
+	return WriteTensor(w, ts, false)
+}
+
+// ReadFrom reads a tensor (on CPU) in binary tensor format from `r` into ts
+// and returns number of bytes read.
+//
+// NOTE. Unlike usual io.ReaderFrom, it stops after the tensor record rather
+// than reading `r` until EOF so that tensors can be streamed. Like
+// `UnmarshalBinary`, the tensor held by ts, if any, is not dropped.
+func (ts *Tensor) ReadFrom(r io.Reader) (int64, error) {
+	x, n, err := readTensor(&countingReader{r: r})
+	if err != nil {
+		return n, err
+	}
+	ts.ctensor = Untrack(x).ctensor
+
+	return n, nil
+}
+
+// countingReader counts bytes read and reads bytes one by one for uvarints
+// so that it does not read past a tensor record.
+type countingReader struct {
+	r   io.Reader
+	n   int64
+	buf [1]byte
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
+
+func (cr *countingReader) ReadByte() (byte, error) {
+	if _, err := io.ReadFull(cr, cr.buf[:]); err != nil {
+		return 0, err
+	}
+
+	return cr.buf[0], nil
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+
+	return append(buf, tmp[:n]...)
+}
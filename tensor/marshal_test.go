@@ -0,0 +1,153 @@
+package tensor_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	x := ts.MustOfSlice([]float32{1, 2, 3, 4, 5, 6}).MustView([]int64{2, 3}, true)
+	defer x.MustDrop()
+
+	data, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	y := new(ts.Tensor)
+	if err := y.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	defer y.MustDrop()
+	if !reflect.DeepEqual(x.MustSize(), y.MustSize()) {
+		t.Errorf("Want: %v\n", x.MustSize())
+		t.Errorf("Got: %v\n", y.MustSize())
+	}
+	if !reflect.DeepEqual(x.Vals(), y.Vals()) {
+		t.Errorf("Want: %v\n", x.Vals())
+		t.Errorf("Got: %v\n", y.Vals())
+	}
+
+	if err := new(ts.Tensor).UnmarshalBinary(append(data, 0)); err == nil {
+		t.Errorf("Want error for trailing bytes\n")
+	}
+	if err := new(ts.Tensor).UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("Want error for truncated data\n")
+	}
+}
+
+func TestWriteTensor(t *testing.T) {
+	x := ts.MustZeros([]int64{100, 10}, gotch.Double, gotch.CPU)
+	y := ts.MustOfSlice([]int64{1, 2, 3})
+	defer x.MustDrop()
+	defer y.MustDrop()
+
+	var plain, compressed bytes.Buffer
+	if _, err := x.WriteTo(&plain); err != nil {
+		t.Fatal(err)
+	}
+	n, err := ts.WriteTensor(&compressed, x, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(compressed.Len()) || compressed.Len() >= plain.Len() {
+		t.Errorf("Want compressed data smaller than %v bytes, got %v bytes (%v written)\n", plain.Len(), compressed.Len(), n)
+	}
+
+	// Tensors are read one by one from a stream.
+	if _, err := ts.WriteTensor(&compressed, y, false); err != nil {
+		t.Fatal(err)
+	}
+	x1 := ts.MustReadTensor(&compressed)
+	defer x1.MustDrop()
+	if !reflect.DeepEqual(x.Float64Values(), x1.Float64Values()) {
+		t.Errorf("Want: %v\n", x.Float64Values())
+		t.Errorf("Got: %v\n", x1.Float64Values())
+	}
+	y1 := new(ts.Tensor)
+	if _, err := y1.ReadFrom(&compressed); err != nil {
+		t.Fatal(err)
+	}
+	defer y1.MustDrop()
+	if !reflect.DeepEqual(y.Vals(), y1.Vals()) {
+		t.Errorf("Want: %v\n", y.Vals())
+		t.Errorf("Got: %v\n", y1.Vals())
+	}
+}
+
+func TestTensorGob(t *testing.T) {
+	type checkpoint struct {
+		Epoch  int
+		Weight *ts.Tensor
+	}
+
+	x := ts.MustOfSlice([]float32{0.5, -1.5})
+	defer x.MustDrop()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(checkpoint{Epoch: 3, Weight: x}); err != nil {
+		t.Fatal(err)
+	}
+	var got checkpoint
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	defer got.Weight.MustDrop()
+
+	if got.Epoch != 3 || !reflect.DeepEqual(x.Vals(), got.Weight.Vals()) {
+		t.Errorf("Want: %v %v\n", 3, x.Vals())
+		t.Errorf("Got: %v %v\n", got.Epoch, got.Weight.Vals())
+	}
+}
+
+func TestNamedTensorsStream(t *testing.T) {
+	namedTensors := []ts.NamedTensor{
+		{Name: "weight", Tensor: ts.MustOfSlice([]float32{1, 2, 3})},
+		{Name: "mask", Tensor: ts.MustOfSlice([]bool{true, false})},
+	}
+
+	var buf bytes.Buffer
+	if _, err := ts.WriteNamedTensors(&buf, namedTensors, true); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ts.ReadNamedTensors(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != len(namedTensors) {
+		t.Fatalf("Want %v tensors, got %v\n", len(namedTensors), len(got))
+	}
+	for i, nt := range namedTensors {
+		if nt.Name != got[i].Name || !reflect.DeepEqual(nt.Tensor.Vals(), got[i].Tensor.Vals()) {
+			t.Errorf("Want: %v %v\n", nt.Name, nt.Tensor.Vals())
+			t.Errorf("Got: %v %v\n", got[i].Name, got[i].Tensor.Vals())
+		}
+		nt.Tensor.MustDrop()
+		got[i].Tensor.MustDrop()
+	}
+}
+
+func TestReadTensorHugeShape(t *testing.T) {
+	cint, err := gotch.DType2CInt(gotch.Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Header of a [1<<30, 1<<30] float tensor followed by a few bytes of data.
+	for _, compression := range []byte{0, 1} {
+		data := append([]byte("GTSR"), 1, 0, compression, byte(cint))
+		data = append(data, 2, 0x80, 0x80, 0x80, 0x80, 0x04, 0x80, 0x80, 0x80, 0x80, 0x04)
+		data = append(data, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x40) // 1<<62 bytes
+		data = append(data, 1, 2, 3, 4)
+
+		if _, err := ts.ReadTensor(bytes.NewReader(data)); err == nil {
+			t.Errorf("Want error for truncated data of huge shape (compression %v)\n", compression)
+		}
+	}
+}
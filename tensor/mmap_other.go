@@ -0,0 +1,19 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package tensor
+
+import (
+	"io/ioutil"
+)
+
+// mmapFile reads the whole file to memory as memory mapping is not supported
+// on this platform.
+func mmapFile(filepath string) ([]byte, func() error, error) {
+	data, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, func() error { return nil }, nil
+}
@@ -0,0 +1,36 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package tensor
+
+import (
+	"os"
+	"syscall"
+)
+
+// mmapFile maps the whole file read-only to memory. Returned function
+// unmaps memory.
+func mmapFile(filepath string) ([]byte, func() error, error) {
+	f, err := os.Open(filepath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	size := fi.Size()
+	if size == 0 {
+		return []byte{}, func() error { return nil }, nil
+	}
+
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, func() error { return syscall.Munmap(data) }, nil
+}
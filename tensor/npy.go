@@ -3,9 +3,12 @@ package tensor
 import (
 	"archive/zip"
 	"bufio"
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -73,6 +76,22 @@ type NpyHeader struct {
 	descr        gotch.DType
 	fortranOrder bool
 	shape        []int64
+	bigEndian    bool
+}
+
+// npyDescr is a map of DType and numpy type descriptor (without byte order).
+var npyDescr = map[gotch.DType]string{
+	gotch.Bool:          "b1",
+	gotch.Uint8:         "u1",
+	gotch.Int8:          "i1",
+	gotch.Int16:         "i2",
+	gotch.Int:           "i4",
+	gotch.Int64:         "i8",
+	gotch.Half:          "f2",
+	gotch.Float:         "f4",
+	gotch.Double:        "f8",
+	gotch.ComplexFloat:  "c8",
+	gotch.ComplexDouble: "c16",
 }
 
 // NewHeader creates Header from input data
@@ -100,34 +119,27 @@ func (h *NpyHeader) ToString() (string, error) {
 
 	shape := strings.Join(shapeStr, ",")
 
-	var descr string
-	switch h.descr.Kind().String() {
-	// case "float16": // NOTE. No float16 in Go primary types. TODO. implement
-	// descr = "f2"
-	case "float32":
-		descr = "f4"
-	case "float64":
-		descr = "f8"
-	case "int":
-		descr = "i4"
-	case "int64":
-		descr = "i8"
-	case "int16":
-		descr = "i2"
-	case "int8":
-		descr = "i1"
-	case "uint8":
-		descr = "u1"
-	default:
+	descr, ok := npyDescr[h.descr]
+	if !ok {
 		err := fmt.Errorf("Unsupported kind: %v\n", h.descr)
 		return "", err
 	}
 
+	// byte order. NOTE. it's not applicable for 1 byte types.
+	switch {
+	case h.descr == gotch.Bool, h.descr == gotch.Uint8, h.descr == gotch.Int8:
+		descr = "|" + descr
+	case h.bigEndian:
+		descr = ">" + descr
+	default:
+		descr = "<" + descr
+	}
+
 	if len(h.shape) == 1 {
 		shape += ","
 	}
 
-	headStr := fmt.Sprintf("{'descr': '<%v', 'fortran_order': %v, 'shape': (%v), }", descr, fortranOrder, shape)
+	headStr := fmt.Sprintf("{'descr': '%v', 'fortran_order': %v, 'shape': (%v), }", descr, fortranOrder, shape)
 
 	return headStr, nil
 }
@@ -200,33 +212,29 @@ func ParseNpyHeader(header string) (*NpyHeader, error) {
 		return nil, err
 	}
 
-	if strings.HasPrefix(d, ">") {
-		err := fmt.Errorf("little-endian descr: %v\n", d)
-		return nil, err
+	var bigEndian bool
+	switch d[0] {
+	case '>':
+		bigEndian = true
+	case '=':
+		bigEndian = nativeEndian == binary.BigEndian
 	}
 
-	descrStr := trimMatches([]rune{'=', '<'}, d)
-
-	var descr gotch.DType
-	switch descrStr {
-	case "f2":
-		descr = gotch.Float // use Go float32 as there's no float16
-	case "f4":
-		descr = gotch.Float
-	case "f8":
-		descr = gotch.Double
-	case "i4":
-		descr = gotch.Int
-	case "i8":
-		descr = gotch.Int64
-	case "i2":
-		descr = gotch.Int16
-	case "i1":
-		descr = gotch.Int8
-	case "u1":
-		descr = gotch.Uint8
-	default:
-		err := fmt.Errorf("unrecognized descr: %v\n", descr)
+	descrStr := trimMatches([]rune{'=', '<', '>', '|'}, d)
+
+	var (
+		descr gotch.DType
+		found bool
+	)
+	for dtype, v := range npyDescr {
+		if v == descrStr {
+			descr = dtype
+			found = true
+			break
+		}
+	}
+	if !found {
+		err := fmt.Errorf("unrecognized descr: %v\n", d)
 		return nil, err
 	}
 
@@ -255,6 +263,7 @@ func ParseNpyHeader(header string) (*NpyHeader, error) {
 		descr,
 		fortranOrder,
 		shape,
+		bigEndian,
 	}, nil
 }
 
@@ -274,39 +283,96 @@ func trimMatches(matches []rune, s string) string {
 	return trimStr
 }
 
-// ReadNpy reads a .npy file and returns the stored tensor.
-func ReadNpy(filepath string) (*Tensor, error) {
-
-	f, err := os.Open(filepath)
+// readNpy reads npy header and data from the reader and returns the stored tensor.
+func readNpy(r io.Reader) (*Tensor, error) {
+	h, err := readHeader(r)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	r := bufio.NewReader(f)
+	header, err := ParseNpyHeader(h)
+	if err != nil {
+		return nil, err
+	}
 
-	h, err := readHeader(r)
+	// Read all the rest
+	data, err := ioutil.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
 
-	header, err := ParseNpyHeader(h)
+	return ofNpyData(data, header)
+}
+
+// ofNpyData creates tensor from npy data given its header.
+//
+// NOTE. data is byte-swapped in place if its byte order is not native.
+func ofNpyData(data []byte, header *NpyHeader) (*Tensor, error) {
+	if header.bigEndian != (nativeEndian == binary.BigEndian) {
+		eltSize, err := gotch.DTypeSize(header.descr)
+		if err != nil {
+			return nil, err
+		}
+		// complex numbers are pairs of real and imaginary parts.
+		if header.descr == gotch.ComplexFloat || header.descr == gotch.ComplexDouble {
+			eltSize = eltSize / 2
+		}
+		swapBytes(data, int(eltSize))
+	}
+
+	if !header.fortranOrder {
+		return OfDataSize(data, header.shape, header.descr)
+	}
+
+	// Fortran order (column-major) data is a C order (row-major) data of
+	// reversed shape. Load it with reversed shape then permute back.
+	ndims := len(header.shape)
+	rshape := make([]int64, ndims)
+	dims := make([]int64, ndims)
+	for i := 0; i < ndims; i++ {
+		rshape[i] = header.shape[ndims-1-i]
+		dims[i] = int64(ndims - 1 - i)
+	}
+
+	x, err := OfDataSize(data, rshape, header.descr)
 	if err != nil {
 		return nil, err
 	}
-	if header.fortranOrder {
-		err := fmt.Errorf("fortran order not supported.\n")
+
+	xt, err := x.Permute(dims, true)
+	if err != nil {
 		return nil, err
 	}
 
-	// Read all the rest
-	var data []byte
-	data, err = ioutil.ReadAll(r)
+	return xt.Contiguous(true)
+}
+
+// swapBytes reverses byte order of every `size` bytes element in data.
+func swapBytes(data []byte, size int) {
+	if size <= 1 {
+		return
+	}
+
+	for i := 0; i+size <= len(data); i += size {
+		elt := data[i : i+size]
+		for l, r := 0, size-1; l < r; l, r = l+1, r-1 {
+			elt[l], elt[r] = elt[r], elt[l]
+		}
+	}
+}
+
+// ReadNpy reads a .npy file and returns the stored tensor.
+func ReadNpy(filepath string) (*Tensor, error) {
+
+	f, err := os.Open(filepath)
 	if err != nil {
 		return nil, err
 	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
 
-	return OfDataSize(data, header.shape, header.descr)
+	return readNpy(r)
 }
 
 // ReadNpz reads a compressed numpy file (.npz) and returns named tensors
@@ -327,38 +393,137 @@ func ReadNpz(filePath string) ([]NamedTensor, error) {
 			return nil, err
 		}
 
-		headerStr, err := readHeader(rc)
+		tensor, err := readNpy(rc)
+		// explicitly close before next one
+		rc.Close()
 		if err != nil {
 			return nil, err
 		}
 
-		header, err := ParseNpyHeader(headerStr)
-		if err != nil {
-			return nil, err
-		}
+		namedTensors = append(namedTensors, NamedTensor{name, tensor})
+	}
 
-		if header.fortranOrder {
-			err := fmt.Errorf("fortran order not supported.\n")
-			return nil, err
-		}
+	return namedTensors, nil
+}
 
-		var data []byte
-		data, err = ioutil.ReadAll(rc)
-		if err != nil {
-			return nil, err
-		}
+// writeNpy writes npy header and data of the tensor to the writer.
+//
+// NOTE. numpy has no bfloat16 and complex32 types. BFloat16 tensor is written
+// as float32 and ComplexHalf tensor as complex64 (no loss of precision).
+func writeNpy(w io.Writer, ts *Tensor) error {
+	x := ts
+	switch ts.DType() {
+	case gotch.BFloat16:
+		x = ts.MustTotype(gotch.Float, false)
+		defer x.MustDrop()
+	case gotch.ComplexHalf:
+		x = ts.MustTotype(gotch.ComplexFloat, false)
+		defer x.MustDrop()
+	}
+
+	shape, err := x.Size()
+	if err != nil {
+		return err
+	}
+
+	header := &NpyHeader{
+		descr:        x.DType(),
+		fortranOrder: false,
+		shape:        shape,
+		bigEndian:    nativeEndian == binary.BigEndian,
+	}
+	headerStr, err := header.ToString()
+	if err != nil {
+		return err
+	}
 
-		tensor, err := OfDataSize(data, header.shape, header.descr)
+	data, err := x.rawData()
+	if err != nil {
+		return err
+	}
+
+	// Total length of magic string, version, header length and header is
+	// padded with spaces to be divisible by 64 and ends with '\n'.
+	var buf bytes.Buffer
+	buf.WriteString(NpyMagicString)
+	hLen := len(headerStr) + 1
+	switch {
+	case hLen+64 <= math.MaxUint16:
+		hLen += (64 - (len(NpyMagicString)+4+hLen)%64) % 64
+		buf.Write([]byte{1, 0})
+		binary.Write(&buf, binary.LittleEndian, uint16(hLen))
+	default:
+		hLen += (64 - (len(NpyMagicString)+6+hLen)%64) % 64
+		buf.Write([]byte{2, 0})
+		binary.Write(&buf, binary.LittleEndian, uint32(hLen))
+	}
+	buf.WriteString(headerStr)
+	buf.WriteString(strings.Repeat(" ", hLen-len(headerStr)-1))
+	buf.WriteByte('\n')
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteNpy writes the tensor to a .npy file.
+func (ts *Tensor) WriteNpy(filepath string) error {
+	f, err := os.Create(filepath)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	if err := writeNpy(w, ts); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// WriteNpz writes named tensors to a numpy .npz file. If `compressed` is
+// true, tensors are compressed with zip deflate (as numpy `savez_compressed`).
+func WriteNpz(namedTensors []NamedTensor, filepath string, compressed bool) error {
+	f, err := os.Create(filepath)
+	if err != nil {
+		return err
+	}
+
+	method := zip.Store
+	if compressed {
+		method = zip.Deflate
+	}
+
+	zw := zip.NewWriter(f)
+	for _, nt := range namedTensors {
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:   nt.Name + NpySuffix,
+			Method: method,
+		})
 		if err != nil {
-			return nil, err
+			f.Close()
+			return err
 		}
 
-		namedTensors = append(namedTensors, NamedTensor{name, tensor})
+		if err := writeNpy(w, nt.Tensor); err != nil {
+			f.Close()
+			return err
+		}
+	}
 
-		// explicitly close before next one
-		rc.Close()
-		data = make([]byte, 0)
+	if err := zw.Close(); err != nil {
+		f.Close()
+		return err
 	}
 
-	return namedTensors, nil
+	return f.Close()
 }
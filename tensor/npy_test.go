@@ -1,6 +1,12 @@
 package tensor_test
 
 import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -60,3 +66,122 @@ func testToString(t *testing.T, want string, h *ts.NpyHeader) {
 		t.Errorf("got: %+v\n", got)
 	}
 }
+
+func TestNpyRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotch-npy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	x := ts.MustOfSlice([]float64{0, 1, -1, 2.5, 3, -4}).MustView([]int64{2, 3}, true)
+	defer x.MustDrop()
+
+	dtypes := []gotch.DType{
+		gotch.Bool, gotch.Uint8, gotch.Int8, gotch.Int16, gotch.Int, gotch.Int64,
+		gotch.Half, gotch.BFloat16, gotch.Float, gotch.Double,
+		gotch.ComplexHalf, gotch.ComplexFloat, gotch.ComplexDouble,
+	}
+
+	var namedTensors []ts.NamedTensor
+	for i, dtype := range dtypes {
+		namedTensors = append(namedTensors, ts.NamedTensor{
+			Name:   fmt.Sprintf("x%v", i),
+			Tensor: x.MustTotype(dtype, false),
+		})
+	}
+
+	for _, nt := range namedTensors {
+		file := filepath.Join(dir, "tensor.npy")
+		if err := nt.Tensor.WriteNpy(file); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ts.ReadNpy(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testNpyEqual(t, nt.Tensor, got)
+		got.MustDrop()
+	}
+
+	for _, compressed := range []bool{false, true} {
+		file := filepath.Join(dir, "tensors.npz")
+		if err := ts.WriteNpz(namedTensors, file, compressed); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ts.ReadNpz(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(namedTensors) {
+			t.Fatalf("want %v tensors, got %v\n", len(namedTensors), len(got))
+		}
+		for i, nt := range namedTensors {
+			if got[i].Name != nt.Name {
+				t.Errorf("want name: %v\n", nt.Name)
+				t.Errorf("got name: %v\n", got[i].Name)
+			}
+			testNpyEqual(t, nt.Tensor, got[i].Tensor)
+			got[i].Tensor.MustDrop()
+		}
+	}
+
+	for _, nt := range namedTensors {
+		nt.Tensor.MustDrop()
+	}
+}
+
+func testNpyEqual(t *testing.T, want, got *ts.Tensor) {
+	if !reflect.DeepEqual(want.MustSize(), got.MustSize()) {
+		t.Errorf("want shape: %v\n", want.MustSize())
+		t.Errorf("got shape: %v\n", got.MustSize())
+	}
+
+	// NOTE. BFloat16 and ComplexHalf are stored as float32 and complex64.
+	if !reflect.DeepEqual(want.Vals(), got.Vals()) {
+		t.Errorf("want: %v\n", want.Vals())
+		t.Errorf("got: %v\n", got.Vals())
+	}
+}
+
+func TestReadNpyFortranBigEndian(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotch-npy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	header := "{'descr': '>i4', 'fortran_order': True, 'shape': (2, 3), }\n"
+	var buf bytes.Buffer
+	buf.WriteString(ts.NpyMagicString)
+	buf.Write([]byte{1, 0})
+	binary.Write(&buf, binary.LittleEndian, uint16(len(header)))
+	buf.WriteString(header)
+	// [[1, 2, 3], [4, 5, 6]] in column-major order.
+	binary.Write(&buf, binary.BigEndian, []int32{1, 4, 2, 5, 3, 6})
+
+	file := filepath.Join(dir, "fortran.npy")
+	if err := ioutil.WriteFile(file, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	x, err := ts.ReadNpy(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer x.MustDrop()
+
+	wantShape := []int64{2, 3}
+	gotShape := x.MustSize()
+	if !reflect.DeepEqual(wantShape, gotShape) {
+		t.Errorf("want: %v\n", wantShape)
+		t.Errorf("got: %v\n", gotShape)
+	}
+
+	want := []int32{1, 2, 3, 4, 5, 6}
+	got := x.Vals().([]int32)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("want: %v\n", want)
+		t.Errorf("got: %v\n", got)
+	}
+}
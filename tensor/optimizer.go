@@ -1,8 +1,6 @@
 package tensor
 
 import (
-	"log"
-
 	lib "github.com/sugarme/gotch/libtch"
 )
 
@@ -161,10 +159,8 @@ func (co *COptimizer) Step() error {
 }
 
 // Drop removes optimizer and frees up memory.
-func (co *COptimizer) Drop() {
+func (co *COptimizer) Drop() error {
 	lib.AtoFree(co.coptimizer)
 
-	if err := TorchErr(); err != nil {
-		log.Fatal(err)
-	}
+	return TorchErr()
 }
@@ -50,12 +50,12 @@ func (ts *Tensor) Lstm(hxData []Tensor, paramsData []Tensor, hasBiases bool, num
 	}
 
 	lib.AtgLstm(ctensorPtr1, ts.ctensor, chxData, len(hxData), cparamsData, len(paramsData), chasBiases, numLayers, dropout, ctrain, cbidirectional, cbatchFirst)
-	err = TorchErr()
+	err = torchErrOp("Lstm", ts)
 	if err != nil {
 		return output, h, c, err
 	}
 
-	return &Tensor{ctensor: *ctensorPtr1}, &Tensor{ctensor: *ctensorPtr2}, &Tensor{ctensor: *ctensorPtr3}, nil
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), newTensor(*ctensorPtr3), nil
 
 }
 
@@ -100,12 +100,12 @@ func (ts *Tensor) Gru(hx *Tensor, paramsData []Tensor, hasBiases bool, numLayers
 	}
 
 	lib.AtgGru(ctensorPtr1, ts.ctensor, hx.ctensor, cparamsData, len(paramsData), chasBiases, numLayers, dropout, ctrain, cbidirectional, cbatchFirst)
-	err = TorchErr()
+	err = torchErrOp("Gru", ts, hx)
 	if err != nil {
 		return output, h, err
 	}
 
-	return &Tensor{ctensor: *ctensorPtr1}, &Tensor{ctensor: *ctensorPtr2}, nil
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), nil
 
 }
 
@@ -134,12 +134,12 @@ func (ts *Tensor) TopK(k int64, dim int64, largest bool, sorted bool) (ts1, ts2
 	}
 
 	lib.AtgTopk(ctensorPtr1, ts.ctensor, k, dim, clargest, csorted)
-	err = TorchErr()
+	err = torchErrOp("TopK", ts)
 	if err != nil {
 		return ts1, ts2, err
 	}
 
-	return &Tensor{ctensor: *ctensorPtr1}, &Tensor{ctensor: *ctensorPtr2}, nil
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), nil
 }
 
 func (ts *Tensor) MustTopK(k int64, dim int64, largest bool, sorted bool) (ts1, ts2 *Tensor) {
@@ -165,11 +165,11 @@ func (ts *Tensor) NLLLoss(target *Tensor, del bool) (retVal *Tensor, err error)
 	// defer C.free(unsafe.Pointer(ptr))
 
 	lib.AtgNllLoss(ptr, ts.ctensor, target.ctensor, nil, reduction, ignoreIndex)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NLLLoss", ts, target); err != nil {
 		return retVal, err
 	}
 
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, nil
 }
@@ -208,19 +208,19 @@ func AlignTensors(tensors []Tensor) (retVal []Tensor, err error) {
 	}
 
 	ctensorsPtr := lib.AtgAlignTensors(ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AlignTensors"); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, newTensorValue(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, newTensorValue(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -250,19 +250,19 @@ func BroadcastTensors(tensors []Tensor) (retVal []Tensor, err error) {
 	}
 
 	ctensorsPtr := lib.AtgBroadcastTensors(ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BroadcastTensors"); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, newTensorValue(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, newTensorValue(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -287,12 +287,12 @@ func MustBroadcastTensors(tensors []Tensor, del bool) (retVal []Tensor) {
 // tensor *atg_chunk(tensor self, int64_t chunks, int64_t dim);
 func (ts *Tensor) Chunk(chunks int64, dim int64) (retVal []Tensor, err error) {
 	ctensorsPtr := lib.AtgChunk(ts.ctensor, chunks, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Chunk", ts); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, newTensorValue(*currentPtr))
 	for {
 		// calculate the next pointer value
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
@@ -300,7 +300,7 @@ func (ts *Tensor) Chunk(chunks int64, dim int64) (retVal []Tensor, err error) {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, newTensorValue(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -329,19 +329,19 @@ func (ts *Tensor) Meshgrid(tensors []Tensor) (retVal []Tensor, err error) {
 	}
 
 	ctensorsPtr := lib.AtgMeshgrid(ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Meshgrid", ts); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, newTensorValue(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, newTensorValue(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -365,19 +365,19 @@ func (ts *Tensor) MustMeshgrid(tensors []Tensor, del bool) (retVal []Tensor) {
 func (ts *Tensor) NonzeroNumpy() (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgNonzeroNumpy(ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NonzeroNumpy", ts); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, newTensorValue(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, newTensorValue(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -406,7 +406,7 @@ func (ts *Tensor) MustNonzeroNumpy(del bool) (retVal []Tensor) {
 func (ts *Tensor) Split(splitSize, dim int64) (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgSplit(ts.ctensor, splitSize, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Split", ts); err != nil {
 		return retVal, err
 	}
 
@@ -415,7 +415,7 @@ func (ts *Tensor) Split(splitSize, dim int64) (retVal []Tensor, err error) {
 	// calculated from there. The vector of tensors will end if the calculated
 	// pointer value is `null`.
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, newTensorValue(*currentPtr))
 	for {
 		// calculate the next pointer value
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
@@ -423,7 +423,7 @@ func (ts *Tensor) Split(splitSize, dim int64) (retVal []Tensor, err error) {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, newTensorValue(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -452,7 +452,7 @@ func (ts *Tensor) MustSplit(splitSize, dim int64, del bool) (retVal []Tensor) {
 func (ts *Tensor) SplitWithSizes(splitSizes []int64, dim int64) (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgSplitWithSizes(ts.ctensor, splitSizes, len(splitSizes), dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SplitWithSizes", ts); err != nil {
 		return retVal, err
 	}
 
@@ -461,7 +461,7 @@ func (ts *Tensor) SplitWithSizes(splitSizes []int64, dim int64) (retVal []Tensor
 	// calculated from there. The vector of tensors will end if the calculated
 	// pointer value is `null`.
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, newTensorValue(*currentPtr))
 	for {
 		// calculate the next pointer value
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
@@ -469,7 +469,7 @@ func (ts *Tensor) SplitWithSizes(splitSizes []int64, dim int64) (retVal []Tensor
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, newTensorValue(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -493,19 +493,19 @@ func (ts *Tensor) MustSplitWithSizes(splitSizes []int64, dim int64, del bool) (r
 func (ts *Tensor) Unbind(dim int64) (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgUnbind(ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Unbind", ts); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, newTensorValue(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, newTensorValue(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -529,19 +529,19 @@ func (ts *Tensor) MustUnbind(dim int64, del bool) (retVal []Tensor) {
 func Where(condition Tensor) (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgWhere(condition.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Where"); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, newTensorValue(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, newTensorValue(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -572,10 +572,10 @@ func (ts *Tensor) Lstsq(a *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLstsq(ptr, ts.ctensor, a.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lstsq", ts, a); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3,17 +3,16 @@ package tensor
 import (
 	"bytes"
 	"fmt"
-	"log"
+	"math"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"unsafe"
 
 	"github.com/sugarme/gotch"
 )
 
-var fmtByte = []byte("%")
-var precByte = []byte(".")
-
 func (ts *Tensor) ValueGo() interface{} {
 	dtype := ts.DType()
 	numel := ts.Numel()
@@ -33,15 +32,21 @@ func (ts *Tensor) ValueGo() interface{} {
 		dst = make([]float32, numel)
 	case gotch.Double:
 		dst = make([]float64, numel)
+	case gotch.Half, gotch.BFloat16:
+		dst = make([]float32, numel)
+	case gotch.ComplexHalf, gotch.ComplexFloat:
+		dst = make([]complex64, numel)
+	case gotch.ComplexDouble:
+		dst = make([]complex128, numel)
 	case gotch.Bool:
 		dst = make([]bool, numel)
 	default:
 		err := fmt.Errorf("Unsupported type: `dst` type: %v, tensor DType: %v", dtype, ts.DType())
-		log.Fatal(err)
+		panic(err)
 	}
 	err := ts.CopyData(dst, ts.Numel())
 	if err != nil {
-		log.Fatal(err)
+		panic(err)
 	}
 	// fmt.Println(dst)
 	return dst
@@ -61,7 +66,7 @@ func (ts *Tensor) ToSlice() reflect.Value {
 	} else {
 		gtyp, err := gotch.ToGoType(dt)
 		if err != nil {
-			log.Fatal(err)
+			panic(err)
 		}
 		typ = reflect.SliceOf(gtyp)
 		slice = reflect.MakeSlice(typ, n, n)
@@ -141,208 +146,432 @@ type sliceHeader struct {
 	Cap  int
 }
 
-// Format implements fmt.Formatter interface so that we can use
-// fmt.Print... and verbs to print out Tensor value in different formats.
-func (ts *Tensor) Format(s fmt.State, c rune) {
-	shape := ts.MustSize()
-	device := ts.MustDevice()
-	dtype := ts.DType()
-	if c == 'i' {
-		fmt.Fprintf(s, "\nTENSOR INFO:\n\tShape:\t\t%v\n\tDType:\t\t%v\n\tDevice:\t\t%v\n\tDefined:\t%v\n", shape, dtype, device, ts.MustDefined())
-		return
-	}
+// SciMode specifies whether floating point values are printed in scientific
+// notation.
+type SciMode int
 
-	data := ts.ValueGo()
+const (
+	SciModeAuto SciMode = iota // decided from tensor values (as PyTorch)
+	SciModeOn
+	SciModeOff
+)
 
-	f := newFmtState(s, c, shape)
-	f.setWidth(data)
-	f.makePad()
+// PrintOptions holds options to print tensors with `fmt` verbs.
+type PrintOptions struct {
+	Precision int     // number of digits after decimal point of floating point values
+	Threshold int     // total number of elements above which tensor is summarized
+	EdgeItems int     // number of items at the beginning and end of each dimension in summary
+	LineWidth int     // number of characters per line before inserting line breaks
+	SciMode   SciMode // whether to use scientific notation for floating point values
+}
 
-	// 0d (scalar)
-	if len(shape) == 0 {
-		fmt.Printf("%v", data)
+// DefaultPrintOptions returns default print options which are the same as
+// PyTorch's.
+func DefaultPrintOptions() PrintOptions {
+	return PrintOptions{
+		Precision: 4,
+		Threshold: 1000,
+		EdgeItems: 3,
+		LineWidth: 80,
+		SciMode:   SciModeAuto,
 	}
+}
 
-	// 1d (slice)
-	if len(shape) == 1 {
-		f.writeSlice(data)
-		return
-	}
+var (
+	printOptsMu sync.Mutex
+	printOpts   = DefaultPrintOptions()
+)
 
-	// 2d (matrix)
-	if len(shape) == 2 {
-		f.writeMatrix(data, shape)
-		return
+// SetPrintOptions sets global options for printing tensors.
+//
+// Example:
+//
+//	opts := ts.DefaultPrintOptions()
+//	opts.Precision = 2
+//	opts.Threshold = 100
+//	ts.SetPrintOptions(opts)
+//	fmt.Println(xs)
+func SetPrintOptions(opts PrintOptions) {
+	if opts.EdgeItems < 1 {
+		opts.EdgeItems = 1
 	}
-
-	// >= 3d (tensor)
-	mSize := int(shape[len(shape)-2] * shape[len(shape)-1])
-	mShape := shape[len(shape)-2:]
-	dims := shape[:len(shape)-2]
-	var rdims []int64
-	for d := len(dims) - 1; d >= 0; d-- {
-		rdims = append(rdims, dims[d])
+	if opts.Precision < 0 {
+		opts.Precision = 0
 	}
 
-	f.writeTensor(0, rdims, 0, mSize, mShape, data, "")
+	printOptsMu.Lock()
+	printOpts = opts
+	printOptsMu.Unlock()
 }
 
-// fmtState is a struct that implements fmt.State interface
-type fmtState struct {
-	fmt.State
-	c     rune   // format verb
-	pad   []byte // padding
-	w     int    // width
-	p     int    // precision
-	shape []int64
-	buf   *bytes.Buffer
+// GetPrintOptions returns current global options for printing tensors.
+func GetPrintOptions() PrintOptions {
+	printOptsMu.Lock()
+	defer printOptsMu.Unlock()
+
+	return printOpts
 }
 
-func newFmtState(s fmt.State, c rune, shape []int64) *fmtState {
+// Format implements fmt.Formatter interface so that we can use
+// fmt.Print... and verbs to print out Tensor value in PyTorch style. E.g.
+//
+//	tensor([[0.1000, 0.2000],
+//	        [0.3000, 0.4000]], dtype=float32, device=CPU, requires_grad=false)
+//
+// Tensor with more elements than print options `Threshold` is summarized
+// with `...`. Verbs:
+//   - %v, %s: values are formatted following print options.
+//   - %f, %e, %g: floating point values are formatted with the verb.
+//   - %i: prints tensor info.
+//
+// Width and precision (e.g. %8.2f) are applied to every element.
+func (ts *Tensor) Format(s fmt.State, c rune) {
+	if c == 'i' {
+		shape := ts.MustSize()
+		device := ts.MustDevice()
+		dtype := ts.DType()
+		fmt.Fprintf(s, "\nTENSOR INFO:\n\tShape:\t\t%v\n\tDType:\t\t%v\n\tDevice:\t\t%v\n\tDefined:\t%v\n", shape, dtype, device, ts.MustDefined())
+		return
+	}
+
+	opts := GetPrintOptions()
+	if p, ok := s.Precision(); ok {
+		opts.Precision = p
+	}
 	w, _ := s.Width()
-	p, _ := s.Precision()
 
-	return &fmtState{
-		State: s,
-		c:     c,
-		w:     w,
-		p:     p,
-		shape: shape,
-		buf:   bytes.NewBuffer(make([]byte, 0)),
+	str, err := ts.toString(opts, c, w)
+	if err != nil {
+		fmt.Fprintf(s, "%%!%c(tensor: %v)", c, err)
+		return
 	}
+
+	fmt.Fprint(s, str)
 }
 
-// writeTensor iterates recursively through a reversed shape of tensor starting from axis 3 and
-// and prints out matrices (of last two dims size).
-func (f *fmtState) writeTensor(d int, dims []int64, offset int, mSize int, mShape []int64, data interface{}, mName string) {
-
-	offsetSize := product(dims[:d]) * mSize
-	for i := 0; i < int(dims[d]); i++ {
-		name := fmt.Sprintf("%v,%v", i+1, mName)
-		if d >= len(dims)-1 { // last dim, let's print out
-			// write matrix name
-			nameStr := fmt.Sprintf("(%v.,.) =\n", name)
-			f.Write([]byte(nameStr))
-			// write matrix values
-			slice := reflect.ValueOf(data).Slice(offset, offset+mSize).Interface()
-			f.writeMatrix(slice, mShape)
-		} else { // recursively loop
-			f.writeTensor(d+1, dims, offset, mSize, mShape, data, name)
+// toString returns tensor string representation in PyTorch style.
+func (ts *Tensor) toString(opts PrintOptions, verb rune, width int) (string, error) {
+	shape, err := ts.Size()
+	if err != nil {
+		return "", err
+	}
+	device, err := ts.Device()
+	if err != nil {
+		return "", err
+	}
+	requiresGrad, err := ts.RequiresGrad()
+	if err != nil {
+		return "", err
+	}
+	dtype := ts.DType()
+
+	var deviceStr string = device.Name
+	if device.Name == "CUDA" {
+		deviceStr = fmt.Sprintf("CUDA:%v", device.Value)
+	}
+	suffix := fmt.Sprintf("dtype=%v, device=%v, requires_grad=%v", dtype, deviceStr, requiresGrad)
+
+	numel := ElementCount(shape)
+	if numel == 0 {
+		if len(shape) > 1 {
+			suffix = fmt.Sprintf("size=%v, %v", shape, suffix)
 		}
+		return fmt.Sprintf("tensor([], %v)", suffix), nil
+	}
 
-		// update offset
-		offset += offsetSize
+	x := ts
+	summarize := numel > int64(opts.Threshold)
+	if summarize {
+		x, err = ts.summarize(shape, int64(opts.EdgeItems))
+		if err != nil {
+			return "", err
+		}
+		defer x.MustDrop()
 	}
-}
 
-func product(dims []int64) int {
-	var p int = 1
-	if len(dims) == 0 {
-		return p
+	p := &tensorPrinter{
+		shape:     shape,
+		summarize: summarize,
+		edgeItems: int64(opts.EdgeItems),
+		lineWidth: opts.LineWidth,
+		elems:     formatElems(x.ValueGo(), opts, verb, width),
 	}
 
-	for _, d := range dims {
-		p = p * int(d)
+	var buf bytes.Buffer
+	const prefix = "tensor("
+	buf.WriteString(prefix)
+	if len(shape) == 0 {
+		buf.WriteString(p.elems[0])
+	} else {
+		p.write(&buf, 0, 0, len(prefix))
 	}
+	buf.WriteString(", ")
+	buf.WriteString(suffix)
+	buf.WriteString(")")
 
-	return p
+	return buf.String(), nil
 }
 
-func (f *fmtState) writeMatrix(data interface{}, shape []int64) {
-	n := shapeToSize(shape)
-	dataLen := reflect.ValueOf(data).Len()
-	if dataLen != n {
-		log.Fatalf("mismatched: slice data has %v elements - shape: %v\n", dataLen, n)
-	}
-	if len(shape) != 2 {
-		log.Fatal("Shape must have length of 2.\n")
+// summarize returns a tensor of only the first and last `edgeItems` items of
+// every dimension.
+func (ts *Tensor) summarize(shape []int64, edgeItems int64) (*Tensor, error) {
+	x, err := ts.ShallowClone()
+	if err != nil {
+		return nil, err
 	}
 
-	stride := int(shape[1])
-	currIdx := 0
-	nextIdx := stride
-	for i := 0; i < int(shape[0]); i++ {
-		slice := reflect.ValueOf(data).Slice(currIdx, nextIdx)
-		f.writeSlice(slice.Interface())
-		currIdx = nextIdx
-		nextIdx += stride
-	}
+	for d, size := range shape {
+		if size <= 2*edgeItems {
+			continue
+		}
 
-	// 1 line between matrix
-	f.Write([]byte("\n"))
+		head, err := x.Narrow(int64(d), 0, edgeItems, false)
+		if err != nil {
+			x.MustDrop()
+			return nil, err
+		}
+		tail, err := x.Narrow(int64(d), size-edgeItems, edgeItems, false)
+		if err != nil {
+			head.MustDrop()
+			x.MustDrop()
+			return nil, err
+		}
+		y, err := Cat([]Tensor{*head, *tail}, int64(d))
+		head.MustDrop()
+		tail.MustDrop()
+		x.MustDrop()
+		if err != nil {
+			return nil, err
+		}
+		x = y
+	}
 
+	return x, nil
 }
 
-func (f *fmtState) writeSlice(data interface{}) {
-	format := f.cleanFmt()
-	dataLen := reflect.ValueOf(data).Len()
-	for i := 0; i < dataLen; i++ {
-		el := reflect.ValueOf(data).Index(i).Interface()
+// tensorPrinter writes formatted elements of a (summarized) tensor in nested
+// brackets.
+type tensorPrinter struct {
+	shape     []int64 // original tensor shape
+	summarize bool
+	edgeItems int64
+	lineWidth int
+	elems     []string // formatted elements of summarized tensor
+}
 
-		// TODO: more format options here
-		w, _ := fmt.Fprintf(f.buf, format, el)
-		f.Write(f.buf.Bytes())
-		f.Write(f.pad[:f.w-w]) // prepad
-		f.Write(f.pad[:2])     // pad
-		f.buf.Reset()
+// size returns size of dimension `dim` of summarized tensor and whether it
+// is summarized.
+func (p *tensorPrinter) size(dim int) (int64, bool) {
+	size := p.shape[dim]
+	if p.summarize && size > 2*p.edgeItems {
+		return 2 * p.edgeItems, true
 	}
 
-	f.Write([]byte("\n"))
+	return size, false
 }
 
-func (f *fmtState) cleanFmt() string {
-	buf := bytes.NewBuffer(fmtByte)
+func (p *tensorPrinter) write(buf *bytes.Buffer, dim int, offset int, indent int) {
+	size, elided := p.size(dim)
+
+	// last dimension: elements with line breaks.
+	if dim == len(p.shape)-1 {
+		var items []string
+		for i := 0; i < int(size); i++ {
+			if elided && int64(i) == p.edgeItems {
+				items = append(items, "...")
+			}
+			items = append(items, p.elems[offset+i])
+		}
+
+		perLine := 1
+		if len(p.elems) > 0 {
+			if n := (p.lineWidth - indent) / (len(p.elems[0]) + 2); n > 1 {
+				perLine = n
+			}
+		}
 
-	// width
-	if w, ok := f.Width(); ok {
-		buf.WriteString(strconv.Itoa(w))
+		buf.WriteString("[")
+		for i, item := range items {
+			if i > 0 {
+				if i%perLine == 0 {
+					buf.WriteString(",\n")
+					buf.WriteString(strings.Repeat(" ", indent+1))
+				} else {
+					buf.WriteString(", ")
+				}
+			}
+			buf.WriteString(item)
+		}
+		buf.WriteString("]")
+		return
 	}
 
-	// precision
-	if p, ok := f.Precision(); ok {
-		buf.Write(precByte)
-		buf.WriteString(strconv.Itoa(p))
+	// number of elements of a sub-tensor
+	stride := 1
+	for d := dim + 1; d < len(p.shape); d++ {
+		s, _ := p.size(d)
+		stride *= int(s)
 	}
 
-	buf.WriteRune(f.c)
-	return buf.String()
+	sep := "," + strings.Repeat("\n", len(p.shape)-dim-1) + strings.Repeat(" ", indent+1)
+	buf.WriteString("[")
+	for i := 0; i < int(size); i++ {
+		if i > 0 {
+			buf.WriteString(sep)
+		}
+		if elided && int64(i) == p.edgeItems {
+			buf.WriteString("...")
+			buf.WriteString(sep)
+		}
+		p.write(buf, dim+1, offset+i*stride, indent+1)
+	}
+	buf.WriteString("]")
 }
 
-func (f *fmtState) makePad() {
-	f.pad = make([]byte, maxInt(f.w, 4))
-	for i := range f.pad {
-		f.pad[i] = ' '
+// formatElems formats all elements of flat data slice to strings of the same
+// width.
+func formatElems(data interface{}, opts PrintOptions, verb rune, width int) []string {
+	var elems []string
+	switch v := data.(type) {
+	case []bool:
+		for _, b := range v {
+			elems = append(elems, strconv.FormatBool(b))
+		}
+	case []float32:
+		vals := make([]float64, len(v))
+		for i, f := range v {
+			vals[i] = float64(f)
+		}
+		f := newFloatFormatter(vals, opts, verb)
+		for _, val := range vals {
+			elems = append(elems, f.format(val))
+		}
+	case []float64:
+		f := newFloatFormatter(v, opts, verb)
+		for _, val := range v {
+			elems = append(elems, f.format(val))
+		}
+	case []complex64, []complex128:
+		rv := reflect.ValueOf(data)
+		re := make([]float64, rv.Len())
+		im := make([]float64, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			c := rv.Index(i).Complex()
+			re[i], im[i] = real(c), imag(c)
+		}
+		f := newFloatFormatter(append(append([]float64{}, re...), im...), opts, verb)
+		for i := range re {
+			sign := "+"
+			if math.Signbit(im[i]) {
+				sign = "-"
+			}
+			elems = append(elems, f.format(re[i])+sign+f.format(math.Abs(im[i]))+"j")
+		}
+	default: // integers
+		rv := reflect.ValueOf(data)
+		for i := 0; i < rv.Len(); i++ {
+			elems = append(elems, fmt.Sprintf("%d", rv.Index(i).Interface()))
+		}
 	}
-}
 
-// setWidth determines maximal width from input data and set to `w` field
-func (f *fmtState) setWidth(data interface{}) {
-	format := f.cleanFmt()
-	f.w = 0
-	for i := 0; i < reflect.ValueOf(data).Len(); i++ {
-		el := reflect.ValueOf(data).Index(i).Interface()
-		w, _ := fmt.Fprintf(f.buf, format, el)
-		if w > f.w {
-			f.w = w
+	// right-align to the same width
+	w := width
+	for _, e := range elems {
+		if len(e) > w {
+			w = len(e)
 		}
-		f.buf.Reset()
 	}
+	for i, e := range elems {
+		if len(e) < w {
+			elems[i] = strings.Repeat(" ", w-len(e)) + e
+		}
+	}
+
+	return elems
 }
 
-func shapeToSize(shape []int64) int {
-	n := 1
-	for _, v := range shape {
-		if v == 0 {
+// floatFormatter formats floating point values of a tensor consistently.
+type floatFormatter struct {
+	fmt       byte // 'f', 'e' or 'g' as in strconv.FormatFloat
+	precision int
+	intMode   bool // all values are integral, printed as e.g. `3.`
+}
+
+func newFloatFormatter(vals []float64, opts PrintOptions, verb rune) *floatFormatter {
+	f := &floatFormatter{fmt: 'f', precision: opts.Precision}
+	switch verb {
+	case 'f', 'F':
+		return f
+	case 'e', 'E', 'g', 'G':
+		f.fmt = byte(verb)
+		return f
+	}
+
+	// min and max of finite non-zero absolute values
+	var (
+		minAbs, maxAbs float64
+		found          bool
+		intMode        = true
+	)
+	for _, v := range vals {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		if v != math.Trunc(v) {
+			intMode = false
+		}
+		a := math.Abs(v)
+		if a == 0 {
 			continue
 		}
-		n = n * int(v)
+		if !found || a < minAbs {
+			minAbs = a
+		}
+		if !found || a > maxAbs {
+			maxAbs = a
+		}
+		found = true
 	}
-	return n
+
+	var sciMode bool
+	switch opts.SciMode {
+	case SciModeOn:
+		sciMode = true
+	case SciModeOff:
+		sciMode = false
+	default:
+		if found {
+			if intMode {
+				sciMode = maxAbs > 1e8
+			} else {
+				sciMode = maxAbs/minAbs > 1000 || maxAbs > 1e8 || minAbs < 1e-4
+			}
+		}
+	}
+
+	switch {
+	case sciMode:
+		f.fmt = 'e'
+	case intMode:
+		f.intMode = true
+	}
+
+	return f
 }
 
-func maxInt(a, b int) int {
-	if a >= b {
-		return a
+func (f *floatFormatter) format(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "nan"
+	case math.IsInf(v, 1):
+		return "inf"
+	case math.IsInf(v, -1):
+		return "-inf"
 	}
-	return b
+
+	if f.intMode {
+		return strconv.FormatFloat(v, 'f', 0, 64) + "."
+	}
+
+	return strconv.FormatFloat(v, f.fmt, f.precision, 64)
 }
@@ -0,0 +1,62 @@
+package tensor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestPrint(t *testing.T) {
+	x := ts.MustOfSlice([]int64{1, 2, 3, 4, 5, 6}).MustView([]int64{2, 3}, true)
+	defer x.MustDrop()
+
+	want := `tensor([[1, 2, 3],
+        [4, 5, 6]], dtype=int64, device=CPU, requires_grad=false)`
+	got := fmt.Sprintf("%v", x)
+	if want != got {
+		t.Errorf("Want:\n%v\n", want)
+		t.Errorf("Got:\n%v\n", got)
+	}
+
+	b := ts.MustOfSlice([]bool{true, false})
+	defer b.MustDrop()
+	want = `tensor([ true, false], dtype=bool, device=CPU, requires_grad=false)`
+	got = fmt.Sprintf("%v", b)
+	if want != got {
+		t.Errorf("Want:\n%v\n", want)
+		t.Errorf("Got:\n%v\n", got)
+	}
+}
+
+func TestPrintOptions(t *testing.T) {
+	defer ts.SetPrintOptions(ts.DefaultPrintOptions())
+
+	opts := ts.DefaultPrintOptions()
+	opts.Precision = 2
+	opts.Threshold = 10
+	opts.EdgeItems = 2
+	ts.SetPrintOptions(opts)
+
+	x := ts.MustArange(ts.IntScalar(20), gotch.Float, gotch.CPU).MustDiv1(ts.FloatScalar(2.0), true)
+	defer x.MustDrop()
+
+	want := `tensor([0.00, 0.50, ..., 9.00, 9.50], dtype=float32, device=CPU, requires_grad=false)`
+	got := fmt.Sprintf("%v", x)
+	if want != got {
+		t.Errorf("Want:\n%v\n", want)
+		t.Errorf("Got:\n%v\n", got)
+	}
+
+	opts.SciMode = ts.SciModeOn
+	ts.SetPrintOptions(opts)
+	y := x.MustNarrow(0, 1, 2, false)
+	defer y.MustDrop()
+	want = `tensor([5.00e-01, 1.00e+00], dtype=float32, device=CPU, requires_grad=false)`
+	got = fmt.Sprintf("%v", y)
+	if want != got {
+		t.Errorf("Want:\n%v\n", want)
+		t.Errorf("Got:\n%v\n", got)
+	}
+}
@@ -0,0 +1,55 @@
+package tensor
+
+import (
+	"github.com/sugarme/gotch"
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// RNGState is a snapshot of random number generators state. Its fields are
+// exported so that it can be saved along with a checkpoint (e.g. with
+// `encoding/gob`) to resume a run with the same random numbers.
+//
+// NOTE. CUDA generators state is not included.
+type RNGState struct {
+	CPU []byte // state of libtorch default CPU generator
+	Go  uint64 // state of Go generator returned by `gotch.Rand()`
+}
+
+// GetRNGState returns current state of libtorch default CPU generator and Go
+// generator used by gotch packages.
+func GetRNGState() (*RNGState, error) {
+	ctensor := lib.AtGetRngState()
+	if err := TorchErr(); err != nil {
+		return nil, err
+	}
+	x := newTensor(ctensor)
+	defer x.MustDrop()
+
+	data, err := x.rawData()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RNGState{
+		CPU: data,
+		Go:  gotch.RandState(),
+	}, nil
+}
+
+// SetRNGState restores random number generators state from a snapshot
+// returned by `GetRNGState`.
+func SetRNGState(state *RNGState) error {
+	x, err := OfDataSize(state.CPU, []int64{int64(len(state.CPU))}, gotch.Uint8)
+	if err != nil {
+		return err
+	}
+	defer x.MustDrop()
+
+	lib.AtSetRngState(x.ctensor)
+	if err := TorchErr(); err != nil {
+		return err
+	}
+	gotch.SetRandState(state.Go)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestManualSeed(t *testing.T) {
+	gotch.ManualSeed(42)
+	x1 := ts.MustRandn([]int64{5}, gotch.Float, gotch.CPU)
+	p1 := gotch.Rand().Perm(10)
+
+	gotch.ManualSeed(42)
+	x2 := ts.MustRandn([]int64{5}, gotch.Float, gotch.CPU)
+	p2 := gotch.Rand().Perm(10)
+
+	if !reflect.DeepEqual(x1.Float64Values(), x2.Float64Values()) {
+		t.Errorf("Want: %v\n", x1.Float64Values())
+		t.Errorf("Got: %v\n", x2.Float64Values())
+	}
+	if !reflect.DeepEqual(p1, p2) {
+		t.Errorf("Want: %v\n", p1)
+		t.Errorf("Got: %v\n", p2)
+	}
+
+	x1.MustDrop()
+	x2.MustDrop()
+}
+
+func TestRNGState(t *testing.T) {
+	gotch.ManualSeed(1)
+	ts.MustRand([]int64{3}, gotch.Float, gotch.CPU).MustDrop()
+
+	state, err := ts.GetRNGState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	x1 := ts.MustRand([]int64{3}, gotch.Float, gotch.CPU)
+	n1 := gotch.Rand().Int63()
+
+	// Draw more numbers then restore.
+	ts.MustRand([]int64{10}, gotch.Float, gotch.CPU).MustDrop()
+	gotch.Rand().Int63()
+	if err := ts.SetRNGState(state); err != nil {
+		t.Fatal(err)
+	}
+
+	x2 := ts.MustRand([]int64{3}, gotch.Float, gotch.CPU)
+	n2 := gotch.Rand().Int63()
+
+	if !reflect.DeepEqual(x1.Float64Values(), x2.Float64Values()) {
+		t.Errorf("Want: %v\n", x1.Float64Values())
+		t.Errorf("Got: %v\n", x2.Float64Values())
+	}
+	if n1 != n2 {
+		t.Errorf("Want: %v\n", n1)
+		t.Errorf("Got: %v\n", n2)
+	}
+
+	x1.MustDrop()
+	x2.MustDrop()
+}
@@ -0,0 +1,307 @@
+package tensor
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/sugarme/gotch"
+)
+
+// Safetensors file format:
+//
+//   - 8 bytes: N, unsigned little-endian 64-bit integer, size of the header
+//   - N bytes: a JSON UTF-8 string representing the header. E.g.
+//     {"__metadata__": {"format": "pt"}, "weight": {"dtype": "F32", "shape": [2, 3], "data_offsets": [0, 24]}}
+//   - Rest of the file: byte buffer of all tensors data in little-endian and C order.
+//     `data_offsets` are relative to the beginning of the byte buffer.
+//
+// Ref. https://github.com/huggingface/safetensors
+const safetensorsMetadataKey string = "__metadata__"
+
+// safetensorsDType is a map of DType and safetensors dtype string.
+var safetensorsDType = map[gotch.DType]string{
+	gotch.Bool:     "BOOL",
+	gotch.Uint8:    "U8",
+	gotch.Int8:     "I8",
+	gotch.Int16:    "I16",
+	gotch.Int:      "I32",
+	gotch.Int64:    "I64",
+	gotch.Half:     "F16",
+	gotch.BFloat16: "BF16",
+	gotch.Float:    "F32",
+	gotch.Double:   "F64",
+}
+
+type safetensorsInfo struct {
+	DType       string   `json:"dtype"`
+	Shape       []int64  `json:"shape"`
+	DataOffsets [2]int64 `json:"data_offsets"`
+}
+
+// safetensorsEntry is a tensor info parsed from safetensors header.
+type safetensorsEntry struct {
+	name  string
+	dtype gotch.DType
+	shape []int64
+	begin int64 // offset from beginning of the byte buffer
+	end   int64
+}
+
+type safetensorsHeader struct {
+	entries  []safetensorsEntry // sorted by data offsets
+	metadata map[string]string
+	dataSize int64 // size of byte buffer
+	offset   int64 // offset of the byte buffer from beginning of file
+}
+
+// readSafetensorsHeader reads and validates safetensors header.
+func readSafetensorsHeader(r io.Reader) (*safetensorsHeader, error) {
+	var n uint64
+	if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+		return nil, err
+	}
+
+	// NOTE. 100MB limit is from the reference implementation.
+	if n > 100000000 {
+		err := fmt.Errorf("safetensors header is too large (%v bytes).\n", n)
+		return nil, err
+	}
+
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(buf, &raw); err != nil {
+		err = fmt.Errorf("invalid safetensors header: %v\n", err)
+		return nil, err
+	}
+
+	header := &safetensorsHeader{
+		metadata: make(map[string]string),
+		offset:   8 + int64(n),
+	}
+	for name, v := range raw {
+		if name == safetensorsMetadataKey {
+			if err := json.Unmarshal(v, &header.metadata); err != nil {
+				err = fmt.Errorf("invalid safetensors metadata: %v\n", err)
+				return nil, err
+			}
+			continue
+		}
+
+		var info safetensorsInfo
+		if err := json.Unmarshal(v, &info); err != nil {
+			err = fmt.Errorf("invalid safetensors info for tensor %q: %v\n", name, err)
+			return nil, err
+		}
+
+		var (
+			dtype gotch.DType
+			found bool
+		)
+		for k, v := range safetensorsDType {
+			if v == info.DType {
+				dtype = k
+				found = true
+				break
+			}
+		}
+		if !found {
+			err := fmt.Errorf("unsupported safetensors dtype %q for tensor %q.\n", info.DType, name)
+			return nil, err
+		}
+
+		eltSizeInBytes, err := gotch.DTypeSize(dtype)
+		if err != nil {
+			return nil, err
+		}
+		shape := info.Shape
+		if shape == nil {
+			shape = []int64{}
+		}
+		nbytes := ElementCount(shape) * int64(eltSizeInBytes)
+		begin, end := info.DataOffsets[0], info.DataOffsets[1]
+		if begin < 0 || end-begin != nbytes {
+			err := fmt.Errorf("invalid data offsets %v for tensor %q of dtype %v and shape %v.\n", info.DataOffsets, name, info.DType, shape)
+			return nil, err
+		}
+
+		header.entries = append(header.entries, safetensorsEntry{
+			name:  name,
+			dtype: dtype,
+			shape: shape,
+			begin: begin,
+			end:   end,
+		})
+	}
+
+	sort.Slice(header.entries, func(i, j int) bool {
+		return header.entries[i].begin < header.entries[j].begin
+	})
+
+	// tensors data must cover the byte buffer without holes or overlapping.
+	for _, e := range header.entries {
+		if e.begin != header.dataSize {
+			err := fmt.Errorf("invalid data offsets for tensor %q: data is not contiguous.\n", e.name)
+			return nil, err
+		}
+		header.dataSize = e.end
+	}
+
+	return header, nil
+}
+
+// ofSafetensorsData creates tensor from little-endian raw data.
+func ofSafetensorsData(data []byte, e safetensorsEntry) (*Tensor, error) {
+	if nativeEndian == binary.BigEndian {
+		eltSizeInBytes, err := gotch.DTypeSize(e.dtype)
+		if err != nil {
+			return nil, err
+		}
+		swapBytes(data, int(eltSizeInBytes))
+	}
+
+	return OfDataSize(data, e.shape, e.dtype)
+}
+
+// ReadSafetensors reads a safetensors file and returns named tensors (on CPU)
+// in the stored order and metadata of the file.
+func ReadSafetensors(filepath string) ([]NamedTensor, map[string]string, error) {
+	f, err := os.Open(filepath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	header, err := readSafetensorsHeader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var namedTensors []NamedTensor
+	fail := func(err error) ([]NamedTensor, map[string]string, error) {
+		for _, nt := range namedTensors {
+			nt.Tensor.MustDrop()
+		}
+		return nil, nil, err
+	}
+
+	for _, e := range header.entries {
+		data := make([]byte, e.end-e.begin)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return fail(err)
+		}
+
+		x, err := ofSafetensorsData(data, e)
+		if err != nil {
+			return fail(err)
+		}
+
+		namedTensors = append(namedTensors, NamedTensor{e.name, x})
+	}
+
+	return namedTensors, header.metadata, nil
+}
+
+// WriteSafetensors writes named tensors and optional metadata to a
+// safetensors file.
+//
+// NOTE. Complex tensors are not supported by safetensors format.
+func WriteSafetensors(namedTensors []NamedTensor, metadata map[string]string, filepath string) error {
+	raw := make(map[string]interface{})
+	if len(metadata) > 0 {
+		raw[safetensorsMetadataKey] = metadata
+	}
+
+	var (
+		offset int64
+		data   [][]byte
+	)
+	for _, nt := range namedTensors {
+		if nt.Name == safetensorsMetadataKey {
+			err := fmt.Errorf("invalid tensor name %q.\n", nt.Name)
+			return err
+		}
+		if _, ok := raw[nt.Name]; ok {
+			err := fmt.Errorf("duplicated tensor name %q.\n", nt.Name)
+			return err
+		}
+
+		dtype := nt.Tensor.DType()
+		dtypeStr, ok := safetensorsDType[dtype]
+		if !ok {
+			err := fmt.Errorf("unsupported dtype %v for tensor %q.\n", dtype, nt.Name)
+			return err
+		}
+
+		shape, err := nt.Tensor.Size()
+		if err != nil {
+			return err
+		}
+
+		buf, err := nt.Tensor.rawData()
+		if err != nil {
+			return err
+		}
+		if nativeEndian == binary.BigEndian {
+			eltSizeInBytes, err := gotch.DTypeSize(dtype)
+			if err != nil {
+				return err
+			}
+			swapBytes(buf, int(eltSizeInBytes))
+		}
+
+		raw[nt.Name] = safetensorsInfo{
+			DType:       dtypeStr,
+			Shape:       shape,
+			DataOffsets: [2]int64{offset, offset + int64(len(buf))},
+		}
+		offset += int64(len(buf))
+		data = append(data, buf)
+	}
+
+	headerBytes, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	// Pad header with spaces to align byte buffer to 8 bytes.
+	for len(headerBytes)%8 != 0 {
+		headerBytes = append(headerBytes, ' ')
+	}
+
+	f, err := os.Create(filepath)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	if err := binary.Write(w, binary.LittleEndian, uint64(len(headerBytes))); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := w.Write(headerBytes); err != nil {
+		f.Close()
+		return err
+	}
+	for _, buf := range data {
+		if _, err := w.Write(buf); err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
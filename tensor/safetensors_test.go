@@ -0,0 +1,116 @@
+package tensor_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestSafetensorsRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotch-safetensors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	x := ts.MustOfSlice([]float64{0, 1, -1, 2.5, 3, -4}).MustView([]int64{2, 3}, true)
+	defer x.MustDrop()
+
+	dtypes := []gotch.DType{
+		gotch.Bool, gotch.Uint8, gotch.Int8, gotch.Int16, gotch.Int, gotch.Int64,
+		gotch.Half, gotch.BFloat16, gotch.Float, gotch.Double,
+	}
+	names := []string{"bool", "u8", "i8", "i16", "i32", "i64", "f16", "bf16", "f32", "f64"}
+
+	var namedTensors []ts.NamedTensor
+	for i, dtype := range dtypes {
+		namedTensors = append(namedTensors, ts.NamedTensor{
+			Name:   names[i],
+			Tensor: x.MustTotype(dtype, false),
+		})
+	}
+	namedTensors = append(namedTensors, ts.NamedTensor{
+		Name:   "scalar",
+		Tensor: ts.MustOfSlice([]float32{3.0}).MustView([]int64{}, true),
+	})
+
+	wantMetadata := map[string]string{"format": "pt", "epoch": "10"}
+	file := filepath.Join(dir, "model.safetensors")
+	if err := ts.WriteSafetensors(namedTensors, wantMetadata, file); err != nil {
+		t.Fatal(err)
+	}
+
+	got, gotMetadata, err := ts.ReadSafetensors(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(wantMetadata, gotMetadata) {
+		t.Errorf("Want: %v\n", wantMetadata)
+		t.Errorf("Got: %v\n", gotMetadata)
+	}
+
+	if len(got) != len(namedTensors) {
+		t.Fatalf("Want %v tensors, got %v\n", len(namedTensors), len(got))
+	}
+
+	for i, nt := range namedTensors {
+		if got[i].Name != nt.Name {
+			t.Errorf("Want name: %v\n", nt.Name)
+			t.Errorf("Got name: %v\n", got[i].Name)
+		}
+		if got[i].Tensor.DType() != nt.Tensor.DType() {
+			t.Errorf("Want dtype: %v\n", nt.Tensor.DType())
+			t.Errorf("Got dtype: %v\n", got[i].Tensor.DType())
+		}
+		if !reflect.DeepEqual(nt.Tensor.MustSize(), got[i].Tensor.MustSize()) {
+			t.Errorf("Want shape: %v\n", nt.Tensor.MustSize())
+			t.Errorf("Got shape: %v\n", got[i].Tensor.MustSize())
+		}
+		if !reflect.DeepEqual(nt.Tensor.Vals(), got[i].Tensor.Vals()) {
+			t.Errorf("Want: %v\n", nt.Tensor.Vals())
+			t.Errorf("Got: %v\n", got[i].Tensor.Vals())
+		}
+
+		nt.Tensor.MustDrop()
+		got[i].Tensor.MustDrop()
+	}
+}
+
+func TestReadSafetensors_Truncated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotch-safetensors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	x := ts.MustOnes([]int64{2, 3}, gotch.Float, gotch.CPU)
+	y := ts.MustZeros([]int64{4}, gotch.Int64, gotch.CPU)
+	defer x.MustDrop()
+	defer y.MustDrop()
+
+	file := filepath.Join(dir, "model.safetensors")
+	namedTensors := []ts.NamedTensor{{Name: "x", Tensor: x}, {Name: "y", Tensor: y}}
+	if err := ts.WriteSafetensors(namedTensors, nil, file); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Cut data of the last tensor so that reading fails after the first one.
+	if err := os.Truncate(file, info.Size()-8); err != nil {
+		t.Fatal(err)
+	}
+
+	ts.CheckLeaks(t, func() {
+		if _, _, err := ts.ReadSafetensors(file); err == nil {
+			t.Errorf("Want error for truncated file\n")
+		}
+	})
+}
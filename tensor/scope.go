@@ -0,0 +1,398 @@
+package tensor
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// Scope tracks tensors created while it is the innermost open scope and
+// frees all of them (except ones explicitly escaped) when it is closed.
+//
+// Scopes belong to the goroutine which created them and can be nested. A tensor
+// belongs to the innermost scope open in the goroutine that created it, so
+// closing a scope never frees tensors created by other goroutines. Tensors
+// created in other goroutines (e.g. data loader workers) can be bound to a
+// scope explicitly with `Track`.
+// Tensors stored in long-lived structs (e.g. variables in a `nn.VarStore`)
+// should be escaped with `Untrack` so that closing a scope does not free them.
+//
+// NOTE. While any scope is open, every tensor creation looks up the id of the
+// calling goroutine from its stack trace (a few microseconds) to find its
+// innermost scope. Goroutines without open scopes do not take the scope lock,
+// but short tensor ops in hot loops of any goroutine pay this lookup.
+// See `BenchmarkScope_*`.
+//
+// Example:
+//
+//	s := ts.NewScope()
+//	defer s.Close()
+//	x := ts.MustOnes([]int64{2, 3}, gotch.Float, gotch.CPU)
+//	y := x.MustMul1(ts.FloatScalar(2.0), false)
+//	z := y.MustSum(gotch.Float, false)
+//	s.Escape(z) // z survives, x and y are freed when scope closed.
+type Scope struct {
+	tensors map[lib.Ctensor]*scopeEntry
+	parent  *Scope
+	gid     int64 // id of goroutine which created the scope
+	closed  bool
+}
+
+type scopeEntry struct {
+	scope     *Scope
+	finalizer bool
+}
+
+var (
+	scopeMu     sync.Mutex
+	scopeTops   sync.Map // goroutine id -> its innermost open *Scope, read without scopeMu
+	registry    = make(map[lib.Ctensor]*scopeEntry)
+	trackState  int32 // number of open scopes + 1 if finalizer is enabled + 1 if live tensors are tracked
+	openScopes  int32 // number of open scopes
+	registered  int32 // number of tensors in registry
+	finalizerOn int32 // 1 if finalizer is enabled
+)
+
+// NewScope creates a new scope and makes it the innermost open scope of the
+// calling goroutine. See `Scope` for the cost of open scopes.
+func NewScope() *Scope {
+	gid := goroutineID()
+
+	scopeMu.Lock()
+	defer scopeMu.Unlock()
+
+	s := &Scope{
+		tensors: make(map[lib.Ctensor]*scopeEntry),
+		parent:  loadScopeTop(gid),
+		gid:     gid,
+	}
+	scopeTops.Store(gid, s)
+	atomic.AddInt32(&trackState, 1)
+	atomic.AddInt32(&openScopes, 1)
+
+	return s
+}
+
+// Close frees all tensors still tracked by the scope and removes the scope
+// from the scope stack. Tensors of a closed scope must not be used afterward.
+//
+// Closing a scope that is not the innermost one is allowed. Its inner scopes
+// are re-attached to its parent. Calling Close more than once is a no-op.
+func (s *Scope) Close() {
+	scopeMu.Lock()
+	if s.closed {
+		scopeMu.Unlock()
+		return
+	}
+	s.closed = true
+	atomic.AddInt32(&trackState, -1)
+	atomic.AddInt32(&openScopes, -1)
+
+	// Unlink from scope stack of its goroutine.
+	switch top := loadScopeTop(s.gid); {
+	case top == s && s.parent == nil:
+		scopeTops.Delete(s.gid)
+	case top == s:
+		scopeTops.Store(s.gid, s.parent)
+	default:
+		for c := top; c != nil; c = c.parent {
+			if c.parent == s {
+				c.parent = s.parent
+				break
+			}
+		}
+	}
+
+	ctensors := make([]lib.Ctensor, 0, len(s.tensors))
+	for c, e := range s.tensors {
+		if registry[c] == e {
+			delete(registry, c)
+			atomic.AddInt32(&registered, -1)
+		}
+		removeLive(c)
+		ctensors = append(ctensors, c)
+	}
+	s.tensors = nil
+	scopeMu.Unlock()
+
+	for _, c := range ctensors {
+		lib.AtFree(c)
+	}
+}
+
+// Escape moves tensors out of the scope so that they are not freed when the
+// scope is closed. The tensors are handed over to the nearest open parent
+// scope if any, otherwise they are no longer tracked by any scope and should
+// be freed manually with `Drop` (or left to finalizer if enabled).
+func (s *Scope) Escape(tensors ...*Tensor) {
+	scopeMu.Lock()
+	defer scopeMu.Unlock()
+
+	parent := s.parent
+	for parent != nil && parent.closed {
+		parent = parent.parent
+	}
+
+	for _, x := range tensors {
+		if x == nil {
+			continue
+		}
+		e, ok := s.tensors[x.ctensor]
+		if !ok {
+			continue
+		}
+		delete(s.tensors, x.ctensor)
+		e.scope = parent
+		switch {
+		case parent != nil:
+			parent.tensors[x.ctensor] = e
+		case !e.finalizer:
+			untrack(x.ctensor)
+		}
+	}
+}
+
+// Track binds tensors to the scope so that they are freed when the scope is
+// closed. Tensors tracked by another scope are moved to this scope.
+//
+// It is used for tensors created in other goroutines, which are not tracked
+// by scopes of the calling goroutine. Tracking tensors to a closed scope is a
+// no-op.
+func (s *Scope) Track(tensors ...*Tensor) {
+	scopeMu.Lock()
+	defer scopeMu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	for _, x := range tensors {
+		if x == nil {
+			continue
+		}
+		e, ok := registry[x.ctensor]
+		if !ok {
+			e = &scopeEntry{}
+			registry[x.ctensor] = e
+			atomic.AddInt32(&registered, 1)
+		}
+		if e.scope != nil && e.scope.tensors[x.ctensor] == e {
+			delete(e.scope.tensors, x.ctensor)
+		}
+		e.scope = s
+		s.tensors[x.ctensor] = e
+	}
+}
+
+// Len returns number of tensors currently tracked by the scope.
+func (s *Scope) Len() int {
+	scopeMu.Lock()
+	defer scopeMu.Unlock()
+
+	return len(s.tensors)
+}
+
+// WithScope runs `fn` inside a new scope and frees all tensors created in
+// `fn` when it returns. See `Scope` for the cost of open scopes.
+func WithScope(fn func()) {
+	s := NewScope()
+	defer s.Close()
+
+	fn()
+}
+
+// WithScopeT runs `fn` inside a new scope and frees all tensors created in
+// `fn` except the returned one.
+func WithScopeT(fn func() *Tensor) *Tensor {
+	s := NewScope()
+	defer s.Close()
+
+	retVal := fn()
+	s.Escape(retVal)
+
+	return retVal
+}
+
+// Untrack removes tensor from its scope (if any) so that it will not be freed
+// when the scope is closed nor by finalizer. It returns the input tensor.
+//
+// It should be used for tensors stored in long-lived objects such as model
+// weights.
+func Untrack(x *Tensor) *Tensor {
+	if x == nil || atomic.LoadInt32(&registered) == 0 {
+		return x
+	}
+
+	scopeMu.Lock()
+	untrack(x.ctensor)
+	scopeMu.Unlock()
+
+	return x
+}
+
+// FinalizerSetEnabled sets whether tensors created from now on are freed by
+// Go garbage collector when they become unreachable. This is a safety net for
+// tensors which are neither dropped nor tracked by a scope.
+// It returns PREVIOUS state.
+//
+// NOTE. Tensors returned as values (e.g. in `[]Tensor` slices) are not
+// covered by finalizer.
+func FinalizerSetEnabled(b bool) bool {
+	scopeMu.Lock()
+	defer scopeMu.Unlock()
+
+	prev := atomic.LoadInt32(&finalizerOn) == 1
+	if b != prev {
+		if b {
+			atomic.StoreInt32(&finalizerOn, 1)
+			atomic.AddInt32(&trackState, 1)
+		} else {
+			atomic.StoreInt32(&finalizerOn, 0)
+			atomic.AddInt32(&trackState, -1)
+		}
+	}
+
+	return prev
+}
+
+// untrack removes ctensor from registry and its scope.
+// NOTE. caller must hold `scopeMu`.
+func untrack(c lib.Ctensor) {
+	e, ok := registry[c]
+	if !ok {
+		return
+	}
+	delete(registry, c)
+	atomic.AddInt32(&registered, -1)
+	if e.scope != nil && e.scope.tensors[c] == e {
+		delete(e.scope.tensors, c)
+	}
+}
+
+// track registers ctensor with scope `top` (can be nil).
+// NOTE. caller must hold `scopeMu`.
+func track(c lib.Ctensor, top *Scope) *scopeEntry {
+	e := &scopeEntry{scope: top}
+	if _, ok := registry[c]; !ok {
+		atomic.AddInt32(&registered, 1)
+	}
+	registry[c] = e
+	if top != nil {
+		top.tensors[c] = e
+	}
+
+	return e
+}
+
+// goroutineID returns id of the calling goroutine parsed from the header of
+// its stack trace, i.e. "goroutine 18 [running]:".
+func goroutineID() int64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	b := bytes.TrimPrefix(buf[:n], []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i > 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseInt(string(b), 10, 64)
+
+	return id
+}
+
+// loadScopeTop returns the innermost open scope of goroutine gid if any.
+func loadScopeTop(gid int64) *Scope {
+	top, ok := scopeTops.Load(gid)
+	if !ok {
+		return nil
+	}
+
+	return top.(*Scope)
+}
+
+// innermostScope returns the innermost open scope of the calling goroutine.
+// It does not lock and skips goroutine id lookup if there is no open scope.
+func innermostScope() *Scope {
+	if atomic.LoadInt32(&openScopes) == 0 {
+		return nil
+	}
+
+	return loadScopeTop(goroutineID())
+}
+
+// newTensor wraps a newly created ctensor and registers it with the innermost
+// scope of the calling goroutine and finalizer if enabled.
+func newTensor(ctensor lib.Ctensor) *Tensor {
+	x := &Tensor{ctensor}
+	if atomic.LoadInt32(&trackState) == 0 {
+		return x
+	}
+
+	live := newLiveEntry(x)
+	top := innermostScope()
+	if live == nil && top == nil && atomic.LoadInt32(&finalizerOn) == 0 {
+		return x
+	}
+	scopeMu.Lock()
+	if live != nil {
+		addLive(ctensor, live)
+	}
+	// NOTE. scope can be closed by another goroutine in the meantime.
+	if top != nil && top.closed {
+		top = nil
+	}
+	finalizer := atomic.LoadInt32(&finalizerOn) == 1
+	if top == nil && !finalizer {
+		scopeMu.Unlock()
+		return x
+	}
+	e := track(ctensor, top)
+	e.finalizer = finalizer
+	scopeMu.Unlock()
+
+	if e.finalizer {
+		runtime.SetFinalizer(x, func(x *Tensor) {
+			scopeMu.Lock()
+			// NOTE. ctensor can be freed and its address reused by another
+			// tensor. Only free if it is still the same registry entry.
+			if registry[x.ctensor] != e {
+				scopeMu.Unlock()
+				return
+			}
+			untrack(x.ctensor)
+			removeLive(x.ctensor)
+			scopeMu.Unlock()
+
+			lib.AtFree(x.ctensor)
+		})
+	}
+
+	return x
+}
+
+// newTensorValue is like newTensor but for tensors returned as values.
+// It registers ctensor with the innermost scope of the calling goroutine
+// without finalizer.
+func newTensorValue(ctensor lib.Ctensor) Tensor {
+	if atomic.LoadInt32(&trackState) == 0 {
+		return Tensor{ctensor}
+	}
+
+	live := newLiveEntry(&Tensor{ctensor})
+	top := innermostScope()
+	if live == nil && top == nil {
+		return Tensor{ctensor}
+	}
+	scopeMu.Lock()
+	if live != nil {
+		addLive(ctensor, live)
+	}
+	if top != nil && !top.closed {
+		track(ctensor, top)
+	}
+	scopeMu.Unlock()
+
+	return Tensor{ctensor}
+}
@@ -0,0 +1,163 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestScope(t *testing.T) {
+	s := ts.NewScope()
+
+	x := ts.MustOnes([]int64{2, 3}, gotch.Float, gotch.CPU)
+	y := x.MustMul1(ts.FloatScalar(2.0), false)
+	z := y.MustSum(gotch.Float, false)
+
+	if got := s.Len(); got != 3 {
+		t.Errorf("Want: %v\n", 3)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	y.MustDrop()
+	s.Escape(z)
+	if got := s.Len(); got != 1 {
+		t.Errorf("Want: %v\n", 1)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	s.Close()
+
+	want := []float64{12}
+	got := z.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+	z.MustDrop()
+}
+
+func TestScope_Nested(t *testing.T) {
+	outer := ts.NewScope()
+	defer outer.Close()
+
+	inner := ts.NewScope()
+	x := ts.MustOnes([]int64{3}, gotch.Float, gotch.CPU)
+	y := x.MustAdd1(ts.FloatScalar(1.0), false)
+	inner.Escape(y)
+	inner.Close()
+
+	// `y` is handed over to the outer scope.
+	if got := outer.Len(); got != 1 {
+		t.Errorf("Want: %v\n", 1)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	want := []float64{2, 2, 2}
+	got := y.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	// Untracked tensor survives the scope.
+	w := ts.WithScopeT(func() *ts.Tensor {
+		a := ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+		return a.MustMul1(ts.FloatScalar(3.0), true)
+	})
+	ts.Untrack(w)
+	if got := outer.Len(); got != 1 {
+		t.Errorf("Want: %v\n", 1)
+		t.Errorf("Got: %v\n", got)
+	}
+	w.MustDrop()
+}
+
+func TestScope_Goroutine(t *testing.T) {
+	s := ts.NewScope()
+
+	// Tensors created by another goroutine are not tracked by the scope.
+	done := make(chan *ts.Tensor)
+	go func() {
+		done <- ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+	}()
+	x := <-done
+	if got := s.Len(); got != 0 {
+		t.Errorf("Want: %v\n", 0)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	// A scope opened in another goroutine does not track tensors of this one.
+	opened, closed := make(chan struct{}), make(chan struct{})
+	go func() {
+		other := ts.NewScope()
+		opened <- struct{}{}
+		<-closed
+		other.Close()
+		closed <- struct{}{}
+	}()
+	<-opened
+	y := x.MustMul1(ts.FloatScalar(2.0), false)
+	closed <- struct{}{}
+	<-closed
+
+	want := []float64{2, 2}
+	got := y.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+	if got := s.Len(); got != 1 {
+		t.Errorf("Want: %v\n", 1)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	// Tensors can be bound explicitly.
+	s.Track(x)
+	if got := s.Len(); got != 2 {
+		t.Errorf("Want: %v\n", 2)
+		t.Errorf("Got: %v\n", got)
+	}
+	s.Close()
+}
+
+func benchmarkScopeOnes(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x := ts.MustOnes([]int64{1}, gotch.Float, gotch.CPU)
+		x.MustDrop()
+	}
+}
+
+func BenchmarkScope_None(b *testing.B) {
+	benchmarkScopeOnes(b)
+}
+
+// Tensor creation pays the goroutine id lookup while a scope is open in
+// another goroutine.
+func BenchmarkScope_OtherGoroutine(b *testing.B) {
+	opened, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		s := ts.NewScope()
+		opened <- struct{}{}
+		<-done
+		s.Close()
+		done <- struct{}{}
+	}()
+	<-opened
+
+	b.ResetTimer()
+	benchmarkScopeOnes(b)
+	b.StopTimer()
+
+	done <- struct{}{}
+	<-done
+}
+
+func BenchmarkScope_Inner(b *testing.B) {
+	s := ts.NewScope()
+	defer s.Close()
+
+	benchmarkScopeOnes(b)
+}
@@ -0,0 +1,141 @@
+package tensor
+
+import (
+	"fmt"
+	"log"
+)
+
+// Sparse tensors:
+//
+// Sparse tensors are stored in COO (coordinate) format: an Int64 `indices`
+// tensor of shape [sparseDims, nnz] holding coordinates of non-zero elements
+// and a `values` tensor of shape [nnz, denseDims...]. Use `ToDense` and
+// `ToSparse` to convert between dense and sparse tensors, `Coalesce` to sum
+// duplicated coordinates (and sort them), `Indices` and `Values` to get
+// indices and values of a coalesced sparse tensor.
+//
+// NOTE. Libtorch 1.7 has no CSR layout. `SparseCSR` builds a COO tensor from
+// CSR arrays.
+
+// SparseCOO creates a sparse tensor of given shape from `indices` (Int64, of
+// shape [sparseDims, nnz]) and `values` (of shape [nnz, denseDims...]). The
+// tensor has dtype and device of values. Indices are not checked to be in
+// bounds of shape and can be duplicated (see `Coalesce`).
+//
+// Example:
+//
+//	// [[0, 2, 0],
+//	//  [3, 0, 4]]
+//	indices := ts.MustOfSlice([]int64{0, 1, 1, 1, 0, 2}).MustView([]int64{2, 3}, true)
+//	values := ts.MustOfSlice([]float32{2, 3, 4})
+//	x := ts.MustSparseCOO(indices, values, []int64{2, 3})
+func SparseCOO(indices, values *Tensor, shape []int64) (*Tensor, error) {
+	device, err := values.Device()
+	if err != nil {
+		return nil, err
+	}
+
+	x, err := SparseCooTensor2(indices, values, shape, values.DType(), device)
+	if err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+// MustSparseCOO creates a sparse tensor from indices and values. It panics if
+// error occurred.
+func MustSparseCOO(indices, values *Tensor, shape []int64) *Tensor {
+	x, err := SparseCOO(indices, values, shape)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
+// SparseCSR creates a sparse matrix of given shape ([rows, cols]) from CSR
+// (compressed sparse row) arrays: `crowIndices` (Int64, of length rows + 1)
+// where non-zero elements of row i are at crowIndices[i] to
+// crowIndices[i+1] - 1 in `colIndices` (Int64) and `values`.
+//
+// The returned tensor is in COO format (see "Sparse tensors").
+//
+// Example:
+//
+//	// [[0, 2, 0],
+//	//  [3, 0, 4]]
+//	crow := ts.MustOfSlice([]int64{0, 1, 3})
+//	col := ts.MustOfSlice([]int64{1, 0, 2})
+//	values := ts.MustOfSlice([]float32{2, 3, 4})
+//	x := ts.MustSparseCSR(crow, col, values, []int64{2, 3})
+func SparseCSR(crowIndices, colIndices, values *Tensor, shape []int64) (*Tensor, error) {
+	if len(shape) != 2 {
+		err := fmt.Errorf("SparseCSR() failed: expected shape of 2 dimensions, got %v.\n", shape)
+		return nil, err
+	}
+	ncrow := int64(crowIndices.Numel())
+	if ncrow != shape[0]+1 {
+		err := fmt.Errorf("SparseCSR() failed: expected %v crowIndices for %v rows, got %v.\n", shape[0]+1, shape[0], ncrow)
+		return nil, err
+	}
+
+	// Row of each element: row i repeated crow[i+1] - crow[i] times.
+	ends, err := crowIndices.Narrow(0, 1, shape[0], false)
+	if err != nil {
+		return nil, err
+	}
+	starts, err := crowIndices.Narrow(0, 0, shape[0], false)
+	if err != nil {
+		ends.MustDrop()
+		return nil, err
+	}
+	counts, err := ends.Sub(starts, true)
+	starts.MustDrop()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := RepeatInterleave(counts)
+	counts.MustDrop()
+	if err != nil {
+		return nil, err
+	}
+
+	indices, err := Stack([]Tensor{*rows, *colIndices}, 0)
+	rows.MustDrop()
+	if err != nil {
+		return nil, err
+	}
+	defer indices.MustDrop()
+
+	return SparseCOO(indices, values, shape)
+}
+
+// MustSparseCSR creates a sparse matrix from CSR arrays. It panics if error
+// occurred.
+func MustSparseCSR(crowIndices, colIndices, values *Tensor, shape []int64) *Tensor {
+	x, err := SparseCSR(crowIndices, colIndices, values, shape)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
+
+// SparseMm multiplies a sparse matrix by a dense matrix and returns a dense
+// matrix. Unlike `Mm`, it supports backward pass to the sparse matrix with a
+// sparse gradient.
+func SparseMm(sparse, dense *Tensor) (*Tensor, error) {
+	return _SparseMm(sparse, dense)
+}
+
+// MustSparseMm multiplies a sparse matrix by a dense matrix. It panics if
+// error occurred.
+func MustSparseMm(sparse, dense *Tensor) *Tensor {
+	x, err := SparseMm(sparse, dense)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return x
+}
@@ -0,0 +1,98 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// Dense values of the sparse matrix [[0, 2, 0], [3, 0, 4]] used in tests.
+var sparseDense = []float32{0, 2, 0, 3, 0, 4}
+
+func TestSparseCOO(t *testing.T) {
+	indices := ts.MustOfSlice([]int64{0, 1, 1, 1, 0, 2}).MustView([]int64{2, 3}, true)
+	values := ts.MustOfSlice([]float32{2, 3, 4})
+	x := ts.MustSparseCOO(indices, values, []int64{2, 3})
+
+	if sparse, err := x.IsSparse(); err != nil || !sparse {
+		t.Errorf("Want sparse tensor, got error: %v\n", err)
+	}
+	dense := x.MustToDense(false)
+	if !reflect.DeepEqual(sparseDense, dense.Vals()) {
+		t.Errorf("Want: %v\n", sparseDense)
+		t.Errorf("Got: %v\n", dense.Vals())
+	}
+
+	// Duplicated indices are summed up by coalescing.
+	dupIndices := ts.MustOfSlice([]int64{0, 0, 1, 1}).MustView([]int64{2, 2}, true)
+	dupValues := ts.MustOfSlice([]float32{1, 2})
+	dup := ts.MustSparseCOO(dupIndices, dupValues, []int64{2, 2}).MustCoalesce(true)
+	wantValues := []float32{3}
+	if !reflect.DeepEqual(wantValues, dup.MustValues(false).Vals()) {
+		t.Errorf("Want: %v\n", wantValues)
+		t.Errorf("Got: %v\n", dup.MustValues(false).Vals())
+	}
+
+	// Dense to sparse round trip.
+	sparse := dense.MustToSparse(false).MustCoalesce(true)
+	wantIndices := []int64{0, 1, 1, 1, 0, 2}
+	if !reflect.DeepEqual(wantIndices, sparse.MustIndices(false).Vals()) {
+		t.Errorf("Want: %v\n", wantIndices)
+		t.Errorf("Got: %v\n", sparse.MustIndices(false).Vals())
+	}
+
+	for _, x := range []*ts.Tensor{sparse, dup, dupValues, dupIndices, dense, x, values, indices} {
+		x.MustDrop()
+	}
+}
+
+func TestSparseCSR(t *testing.T) {
+	crow := ts.MustOfSlice([]int64{0, 1, 3})
+	col := ts.MustOfSlice([]int64{1, 0, 2})
+	values := ts.MustOfSlice([]float32{2, 3, 4})
+	x := ts.MustSparseCSR(crow, col, values, []int64{2, 3})
+
+	dense := x.MustToDense(false)
+	if !reflect.DeepEqual(sparseDense, dense.Vals()) {
+		t.Errorf("Want: %v\n", sparseDense)
+		t.Errorf("Got: %v\n", dense.Vals())
+	}
+
+	if _, err := ts.SparseCSR(crow, col, values, []int64{3, 3}); err == nil {
+		t.Errorf("Want error for mismatched crowIndices\n")
+	}
+
+	for _, x := range []*ts.Tensor{dense, x, values, col, crow} {
+		x.MustDrop()
+	}
+}
+
+func TestSparseMm(t *testing.T) {
+	indices := ts.MustOfSlice([]int64{0, 1, 1, 1, 0, 2}).MustView([]int64{2, 3}, true)
+	values := ts.MustOfSlice([]float32{2, 3, 4}).MustSetRequiresGrad(true, true)
+	x := ts.MustSparseCOO(indices, values, []int64{2, 3})
+	y := ts.MustOnes([]int64{3, 2}, gotch.Float, gotch.CPU)
+
+	z := ts.MustSparseMm(x, y)
+	want := []float32{2, 2, 7, 7}
+	if !reflect.DeepEqual(want, z.Vals()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", z.Vals())
+	}
+
+	// Gradient flows to values of the sparse matrix.
+	loss := z.MustSum(gotch.Float, false)
+	loss.MustBackward()
+	grad := values.MustGrad(false)
+	wantGrad := []float32{2, 2, 2}
+	if !reflect.DeepEqual(wantGrad, grad.Vals()) {
+		t.Errorf("Want: %v\n", wantGrad)
+		t.Errorf("Got: %v\n", grad.Vals())
+	}
+
+	for _, x := range []*ts.Tensor{grad, loss, z, y, x, values, indices} {
+		x.MustDrop()
+	}
+}
@@ -16,7 +16,7 @@ func (ts *Tensor) __And_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__And_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__And_", ts); err != nil {
 		return err
 	}
 
@@ -27,7 +27,7 @@ func (ts *Tensor) __And1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__And1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__And1", ts, other); err != nil {
 		return err
 	}
 
@@ -38,7 +38,7 @@ func (ts *Tensor) __Iand_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Iand_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Iand_", ts); err != nil {
 		return err
 	}
 
@@ -49,7 +49,7 @@ func (ts *Tensor) __Iand1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Iand1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Iand1", ts, other); err != nil {
 		return err
 	}
 
@@ -60,7 +60,7 @@ func (ts *Tensor) __Ilshift_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ilshift_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Ilshift_", ts); err != nil {
 		return err
 	}
 
@@ -71,7 +71,7 @@ func (ts *Tensor) __Ilshift1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ilshift1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Ilshift1", ts, other); err != nil {
 		return err
 	}
 
@@ -82,7 +82,7 @@ func (ts *Tensor) __Ior_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ior_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Ior_", ts); err != nil {
 		return err
 	}
 
@@ -93,7 +93,7 @@ func (ts *Tensor) __Ior1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ior1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Ior1", ts, other); err != nil {
 		return err
 	}
 
@@ -104,7 +104,7 @@ func (ts *Tensor) __Irshift_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Irshift_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Irshift_", ts); err != nil {
 		return err
 	}
 
@@ -115,7 +115,7 @@ func (ts *Tensor) __Irshift1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Irshift1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Irshift1", ts, other); err != nil {
 		return err
 	}
 
@@ -126,7 +126,7 @@ func (ts *Tensor) __Ixor_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ixor_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Ixor_", ts); err != nil {
 		return err
 	}
 
@@ -137,7 +137,7 @@ func (ts *Tensor) __Ixor1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ixor1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Ixor1", ts, other); err != nil {
 		return err
 	}
 
@@ -148,7 +148,7 @@ func (ts *Tensor) __Lshift_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Lshift_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Lshift_", ts); err != nil {
 		return err
 	}
 
@@ -159,7 +159,7 @@ func (ts *Tensor) __Lshift1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Lshift1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Lshift1", ts, other); err != nil {
 		return err
 	}
 
@@ -170,7 +170,7 @@ func (ts *Tensor) __Or_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Or_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Or_", ts); err != nil {
 		return err
 	}
 
@@ -181,7 +181,7 @@ func (ts *Tensor) __Or1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Or1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Or1", ts, other); err != nil {
 		return err
 	}
 
@@ -192,7 +192,7 @@ func (ts *Tensor) __Rshift_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Rshift_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Rshift_", ts); err != nil {
 		return err
 	}
 
@@ -203,7 +203,7 @@ func (ts *Tensor) __Rshift1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Rshift1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Rshift1", ts, other); err != nil {
 		return err
 	}
 
@@ -214,7 +214,7 @@ func (ts *Tensor) __Xor_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Xor_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Xor_", ts); err != nil {
 		return err
 	}
 
@@ -225,7 +225,7 @@ func (ts *Tensor) __Xor1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Xor1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("__Xor1", ts, other); err != nil {
 		return err
 	}
 
@@ -239,10 +239,10 @@ func (ts *Tensor) _AdaptiveAvgPool2d(outputSize []int64, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AdaptiveAvgPool2d(ptr, ts.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_AdaptiveAvgPool2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -254,10 +254,10 @@ func (ts *Tensor) _AdaptiveAvgPool2dBackward(gradOutput *Tensor, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AdaptiveAvgPool2dBackward(ptr, gradOutput.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_AdaptiveAvgPool2dBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -269,10 +269,10 @@ func (ts *Tensor) _AddBatchDim(batchDim int64, level int64, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddBatchDim(ptr, ts.ctensor, batchDim, level)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_AddBatchDim", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -284,10 +284,10 @@ func (ts *Tensor) _AddRelu(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddRelu(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_AddRelu", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -296,7 +296,7 @@ func (ts *Tensor) _AddRelu_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddRelu_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_AddRelu_", ts, other); err != nil {
 		return err
 	}
 
@@ -310,10 +310,10 @@ func (ts *Tensor) _AddReluOut(out *Tensor, other *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddReluOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_AddReluOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -322,7 +322,7 @@ func (ts *Tensor) _AddmvImpl_(self2 *Tensor, mat *Tensor, vec *Tensor) (err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddmvImpl_(ptr, ts.ctensor, self2.ctensor, mat.ctensor, vec.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_AddmvImpl_", ts, self2, mat, vec); err != nil {
 		return err
 	}
 
@@ -333,10 +333,10 @@ func _AmpUpdateScale(growthTracker *Tensor, currentScale *Tensor, foundInf *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AmpUpdateScale(ptr, growthTracker.ctensor, currentScale.ctensor, foundInf.ctensor, scaleGrowthFactor, scaleBackoffFactor, growthInterval)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_AmpUpdateScale", growthTracker, currentScale, foundInf); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -345,7 +345,7 @@ func (ts *Tensor) _BaddbmmMkl_(batch1 *Tensor, batch2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_BaddbmmMkl_(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_BaddbmmMkl_", ts, batch1, batch2); err != nil {
 		return err
 	}
 
@@ -363,10 +363,10 @@ func (ts *Tensor) _Bmm(mat2 *Tensor, deterministic bool, del bool) (retVal *Tens
 		cdeterministic = int32(1)
 	}
 	lib.Atg_Bmm(ptr, ts.ctensor, mat2.ctensor, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Bmm", ts, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -382,10 +382,10 @@ func (ts *Tensor) _BmmOut(out *Tensor, mat2 *Tensor, deterministic bool, del boo
 		cdeterministic = int32(1)
 	}
 	lib.Atg_BmmOut(ptr, out.ctensor, ts.ctensor, mat2.ctensor, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_BmmOut", ts, out, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -401,10 +401,10 @@ func (ts *Tensor) _CastByte(nonBlocking bool, del bool) (retVal *Tensor, err err
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastByte(ptr, ts.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CastByte", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -420,10 +420,10 @@ func (ts *Tensor) _CastChar(nonBlocking bool, del bool) (retVal *Tensor, err err
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastChar(ptr, ts.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CastChar", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -439,10 +439,10 @@ func (ts *Tensor) _CastDouble(nonBlocking bool, del bool) (retVal *Tensor, err e
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastDouble(ptr, ts.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CastDouble", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -458,10 +458,10 @@ func (ts *Tensor) _CastFloat(nonBlocking bool, del bool) (retVal *Tensor, err er
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastFloat(ptr, ts.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CastFloat", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -477,10 +477,10 @@ func (ts *Tensor) _CastHalf(nonBlocking bool, del bool) (retVal *Tensor, err err
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastHalf(ptr, ts.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CastHalf", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -496,10 +496,10 @@ func (ts *Tensor) _CastInt(nonBlocking bool, del bool) (retVal *Tensor, err erro
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastInt(ptr, ts.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CastInt", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -515,10 +515,10 @@ func (ts *Tensor) _CastLong(nonBlocking bool, del bool) (retVal *Tensor, err err
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastLong(ptr, ts.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CastLong", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -534,10 +534,10 @@ func (ts *Tensor) _CastShort(nonBlocking bool, del bool) (retVal *Tensor, err er
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastShort(ptr, ts.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CastShort", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -550,10 +550,10 @@ func _Cat(tensors []Tensor, dim int64) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.Atg_Cat(ptr, ctensors, len(ctensors), dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Cat"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -566,10 +566,10 @@ func _CatOut(out *Tensor, tensors []Tensor, dim int64) (retVal *Tensor, err erro
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.Atg_CatOut(ptr, out.ctensor, ctensors, len(ctensors), dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CatOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -578,10 +578,10 @@ func _CdistBackward(grad *Tensor, x1 *Tensor, x2 *Tensor, p float64, cdist *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_CdistBackward(ptr, grad.ctensor, x1.ctensor, x2.ctensor, p, cdist.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CdistBackward", grad, x1, x2, cdist); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -597,10 +597,10 @@ func (ts *Tensor) _CholeskyHelper(upper bool, del bool) (retVal *Tensor, err err
 		cupper = int32(1)
 	}
 	lib.Atg_CholeskyHelper(ptr, ts.ctensor, cupper)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CholeskyHelper", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -616,10 +616,10 @@ func (ts *Tensor) _CholeskySolveHelper(a *Tensor, upper bool, del bool) (retVal
 		cupper = int32(1)
 	}
 	lib.Atg_CholeskySolveHelper(ptr, ts.ctensor, a.ctensor, cupper)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CholeskySolveHelper", ts, a); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -632,7 +632,7 @@ func (ts *Tensor) _Coalesced_(coalesced bool) (err error) {
 		ccoalesced = int32(1)
 	}
 	lib.Atg_Coalesced_(ptr, ts.ctensor, ccoalesced)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Coalesced_", ts); err != nil {
 		return err
 	}
 
@@ -643,10 +643,10 @@ func _ComputeLinearCombination(input *Tensor, coefficients *Tensor) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_ComputeLinearCombination(ptr, input.ctensor, coefficients.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_ComputeLinearCombination", input, coefficients); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -655,10 +655,10 @@ func _ComputeLinearCombinationOut(out *Tensor, input *Tensor, coefficients *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_ComputeLinearCombinationOut(ptr, out.ctensor, input.ctensor, coefficients.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_ComputeLinearCombinationOut", out, input, coefficients); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -670,10 +670,10 @@ func (ts *Tensor) _Conj(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Conj(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Conj", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -698,10 +698,10 @@ func _Convolution(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, p
 		ccudnnEnabled = int32(1)
 	}
 	lib.Atg_Convolution(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding), groups, cbenchmark, cdeterministic, ccudnnEnabled)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Convolution", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -730,10 +730,10 @@ func _Convolution1(input *Tensor, weight *Tensor, bias *Tensor, stride []int64,
 		callowTf32 = int32(1)
 	}
 	lib.Atg_Convolution1(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding), groups, cbenchmark, cdeterministic, ccudnnEnabled, callowTf32)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Convolution1", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -746,10 +746,10 @@ func _ConvolutionNogroup(input *Tensor, weight *Tensor, bias *Tensor, stride []i
 		ctransposed = int32(1)
 	}
 	lib.Atg_ConvolutionNogroup(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_ConvolutionNogroup", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -765,10 +765,10 @@ func (ts *Tensor) _CopyFrom(dst *Tensor, nonBlocking bool, del bool) (retVal *Te
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CopyFrom(ptr, ts.ctensor, dst.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CopyFrom", ts, dst); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -781,10 +781,10 @@ func _CtcLossBackward(grad *Tensor, logProbs *Tensor, targets *Tensor, inputLeng
 		czeroInfinity = int32(1)
 	}
 	lib.Atg_CtcLossBackward(ptr, grad.ctensor, logProbs.ctensor, targets.ctensor, inputLengths, len(inputLengths), targetLengths, len(targetLengths), negLogLikelihood.ctensor, logAlpha.ctensor, blank, czeroInfinity)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CtcLossBackward", grad, logProbs, targets, negLogLikelihood, logAlpha); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -797,10 +797,10 @@ func _CudnnInitDropoutState(dropout float64, train bool, dropoutSeed int64, opti
 		ctrain = int32(1)
 	}
 	lib.Atg_CudnnInitDropoutState(ptr, dropout, ctrain, dropoutSeed, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CudnnInitDropoutState"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -821,10 +821,10 @@ func _CudnnRnnFlattenWeight(weightArr []Tensor, weightStride0 int64, inputSize i
 		cbidirectional = int32(1)
 	}
 	lib.Atg_CudnnRnnFlattenWeight(ptr, cweightArr, len(cweightArr), weightStride0, inputSize, mode, hiddenSize, numLayers, cbatchFirst, cbidirectional)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CudnnRnnFlattenWeight"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -836,10 +836,10 @@ func (ts *Tensor) _Cumprod(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Cumprod(ptr, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Cumprod", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -851,10 +851,10 @@ func (ts *Tensor) _CumprodOut(out *Tensor, dim int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_CumprodOut(ptr, out.ctensor, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CumprodOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -866,10 +866,10 @@ func (ts *Tensor) _Cumsum(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Cumsum(ptr, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Cumsum", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -881,10 +881,10 @@ func (ts *Tensor) _CumsumOut(out *Tensor, dim int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_CumsumOut(ptr, out.ctensor, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_CumsumOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -893,10 +893,10 @@ func _DimArange(like *Tensor, dim int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_DimArange(ptr, like.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_DimArange", like); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -905,10 +905,10 @@ func _DirichletGrad(x *Tensor, alpha *Tensor, total *Tensor) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_DirichletGrad(ptr, x.ctensor, alpha.ctensor, total.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_DirichletGrad", x, alpha, total); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -925,10 +925,10 @@ func _EmbeddingBagBackward(grad *Tensor, indices *Tensor, offsets *Tensor, offse
 		csparse = int32(1)
 	}
 	lib.Atg_EmbeddingBagBackward(ptr, grad.ctensor, indices.ctensor, offsets.ctensor, offset2bag.ctensor, bagSize.ctensor, maximumIndices.ctensor, numWeights, cscaleGradByFreq, mode, csparse, perSampleWeights.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_EmbeddingBagBackward", grad, indices, offsets, offset2bag, bagSize, maximumIndices, perSampleWeights); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -941,10 +941,10 @@ func _EmbeddingBagDenseBackward(grad *Tensor, indices *Tensor, offsets *Tensor,
 		cscaleGradByFreq = int32(1)
 	}
 	lib.Atg_EmbeddingBagDenseBackward(ptr, grad.ctensor, indices.ctensor, offsets.ctensor, offset2bag.ctensor, bagSize.ctensor, maximumIndices.ctensor, numWeights, cscaleGradByFreq, mode, perSampleWeights.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_EmbeddingBagDenseBackward", grad, indices, offsets, offset2bag, bagSize, maximumIndices, perSampleWeights); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -953,10 +953,10 @@ func _EmbeddingBagPerSampleWeightsBackward(grad *Tensor, weight *Tensor, indices
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_EmbeddingBagPerSampleWeightsBackward(ptr, grad.ctensor, weight.ctensor, indices.ctensor, offsets.ctensor, offset2bag.ctensor, mode)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_EmbeddingBagPerSampleWeightsBackward", grad, weight, indices, offsets, offset2bag); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -969,10 +969,10 @@ func _EmbeddingBagSparseBackward(grad *Tensor, indices *Tensor, offsets *Tensor,
 		cscaleGradByFreq = int32(1)
 	}
 	lib.Atg_EmbeddingBagSparseBackward(ptr, grad.ctensor, indices.ctensor, offsets.ctensor, offset2bag.ctensor, bagSize.ctensor, numWeights, cscaleGradByFreq, mode, perSampleWeights.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_EmbeddingBagSparseBackward", grad, indices, offsets, offset2bag, bagSize, perSampleWeights); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -981,10 +981,10 @@ func _EmptyAffineQuantized(size []int64, optionsKind gotch.DType, optionsDevice
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_EmptyAffineQuantized(ptr, size, len(size), optionsKind.CInt(), optionsDevice.CInt(), scale, zeroPoint)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_EmptyAffineQuantized"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -993,10 +993,10 @@ func _EmptyPerChannelAffineQuantized(size []int64, scales *Tensor, zeroPoints *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_EmptyPerChannelAffineQuantized(ptr, size, len(size), scales.ctensor, zeroPoints.ctensor, axis, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_EmptyPerChannelAffineQuantized", scales, zeroPoints); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1005,10 +1005,10 @@ func _EuclideanDist(x1 *Tensor, x2 *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_EuclideanDist(ptr, x1.ctensor, x2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_EuclideanDist", x1, x2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1020,10 +1020,10 @@ func (ts *Tensor) _FakeQuantizeLearnablePerChannelAffine(scale *Tensor, zeroPoin
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_FakeQuantizeLearnablePerChannelAffine(ptr, ts.ctensor, scale.ctensor, zeroPoint.ctensor, axis, quantMin, quantMax)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_FakeQuantizeLearnablePerChannelAffine", ts, scale, zeroPoint); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1035,10 +1035,10 @@ func (ts *Tensor) _FakeQuantizeLearnablePerTensorAffine(scale *Tensor, zeroPoint
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_FakeQuantizeLearnablePerTensorAffine(ptr, ts.ctensor, scale.ctensor, zeroPoint.ctensor, quantMin, quantMax)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_FakeQuantizeLearnablePerTensorAffine", ts, scale, zeroPoint); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1070,10 +1070,10 @@ func (ts *Tensor) _FftWithSize(signalNdim int64, complexInput bool, complexOutpu
 		conesided = int32(1)
 	}
 	lib.Atg_FftWithSize(ptr, ts.ctensor, signalNdim, ccomplexInput, ccomplexOutput, cinverse, checkedSignalSizes, len(checkedSignalSizes), cnormalized, conesided, outputSizes, len(outputSizes))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_FftWithSize", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1101,10 +1101,10 @@ func (ts *Tensor) _FftWithSize1(signalNdim int64, complexInput bool, complexOutp
 		conesided = int32(1)
 	}
 	lib.Atg_FftWithSize1(ptr, ts.ctensor, signalNdim, ccomplexInput, ccomplexOutput, cinverse, checkedSignalSizes, len(checkedSignalSizes), normalization, conesided, outputSizes, len(outputSizes))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_FftWithSize1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1116,10 +1116,10 @@ func (ts *Tensor) _GatherSparseBackward(dim int64, index *Tensor, grad *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_GatherSparseBackward(ptr, ts.ctensor, dim, index.ctensor, grad.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_GatherSparseBackward", ts, index, grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1132,10 +1132,10 @@ func _GridSampler2dCpuFallback(input *Tensor, grid *Tensor, interpolationMode in
 		calignCorners = int32(1)
 	}
 	lib.Atg_GridSampler2dCpuFallback(ptr, input.ctensor, grid.ctensor, interpolationMode, paddingMode, calignCorners)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_GridSampler2dCpuFallback", input, grid); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1144,7 +1144,7 @@ func (ts *Tensor) _IndexCopy_(dim int64, index *Tensor, source *Tensor) (err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_IndexCopy_(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_IndexCopy_", ts, index, source); err != nil {
 		return err
 	}
 
@@ -1167,7 +1167,7 @@ func (ts *Tensor) _IndexPutImpl_(indices []Tensor, values *Tensor, accumulate bo
 		cunsafety = int32(1)
 	}
 	lib.Atg_IndexPutImpl_(ptr, ts.ctensor, cindices, len(cindices), values.ctensor, caccumulate, cunsafety)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_IndexPutImpl_", ts, values); err != nil {
 		return err
 	}
 
@@ -1181,10 +1181,10 @@ func (ts *Tensor) _Indices(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Indices(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Indices", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1196,10 +1196,10 @@ func (ts *Tensor) _InverseHelper(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_InverseHelper(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_InverseHelper", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1215,10 +1215,10 @@ func (ts *Tensor) _LogSoftmax(dim int64, halfToFloat bool, del bool) (retVal *Te
 		chalfToFloat = int32(1)
 	}
 	lib.Atg_LogSoftmax(ptr, ts.ctensor, dim, chalfToFloat)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_LogSoftmax", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1230,10 +1230,10 @@ func (ts *Tensor) _LogSoftmaxBackwardData(gradOutput *Tensor, output *Tensor, di
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_LogSoftmaxBackwardData(ptr, gradOutput.ctensor, output.ctensor, dim, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_LogSoftmaxBackwardData", ts, gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1245,10 +1245,10 @@ func (ts *Tensor) _Logcumsumexp(dim int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Logcumsumexp(ptr, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Logcumsumexp", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1260,10 +1260,10 @@ func (ts *Tensor) _LogcumsumexpOut(out *Tensor, dim int64, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_LogcumsumexpOut(ptr, out.ctensor, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_LogcumsumexpOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1275,10 +1275,10 @@ func (ts *Tensor) _LuSolveHelper(lUData *Tensor, lUPivots *Tensor, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_LuSolveHelper(ptr, ts.ctensor, lUData.ctensor, lUPivots.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_LuSolveHelper", ts, lUData, lUPivots); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1290,10 +1290,10 @@ func (ts *Tensor) _MakePerChannelQuantizedTensor(scale *Tensor, zeroPoint *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MakePerChannelQuantizedTensor(ptr, ts.ctensor, scale.ctensor, zeroPoint.ctensor, axis)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_MakePerChannelQuantizedTensor", ts, scale, zeroPoint); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1305,10 +1305,10 @@ func (ts *Tensor) _MakePerTensorQuantizedTensor(scale float64, zeroPoint int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MakePerTensorQuantizedTensor(ptr, ts.ctensor, scale, zeroPoint)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_MakePerTensorQuantizedTensor", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1320,10 +1320,10 @@ func (ts *Tensor) _MaskedScale(mask *Tensor, scale float64, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MaskedScale(ptr, ts.ctensor, mask.ctensor, scale)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_MaskedScale", ts, mask); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1335,10 +1335,10 @@ func (ts *Tensor) _MkldnnReshape(shape []int64, del bool) (retVal *Tensor, err e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MkldnnReshape(ptr, ts.ctensor, shape, len(shape))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_MkldnnReshape", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1350,10 +1350,10 @@ func (ts *Tensor) _MkldnnTranspose(dim0 int64, dim1 int64, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MkldnnTranspose(ptr, ts.ctensor, dim0, dim1)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_MkldnnTranspose", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1362,7 +1362,7 @@ func (ts *Tensor) _MkldnnTranspose_(dim0 int64, dim1 int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MkldnnTranspose_(ptr, ts.ctensor, dim0, dim1)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_MkldnnTranspose_", ts); err != nil {
 		return err
 	}
 
@@ -1373,10 +1373,10 @@ func _MultinomialAliasDraw(j *Tensor, q *Tensor, numSamples int64) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MultinomialAliasDraw(ptr, j.ctensor, q.ctensor, numSamples)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_MultinomialAliasDraw", j, q); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1385,10 +1385,10 @@ func _NnpackSpatialConvolution(input *Tensor, weight *Tensor, bias *Tensor, padd
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_NnpackSpatialConvolution(ptr, input.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_NnpackSpatialConvolution", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1397,10 +1397,10 @@ func _NnpackSpatialConvolutionBackwardInput(input *Tensor, gradOutput *Tensor, w
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_NnpackSpatialConvolutionBackwardInput(ptr, input.ctensor, gradOutput.ctensor, weight.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_NnpackSpatialConvolutionBackwardInput", input, gradOutput, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1409,10 +1409,10 @@ func _NnpackSpatialConvolutionBackwardWeight(input *Tensor, weightsize []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_NnpackSpatialConvolutionBackwardWeight(ptr, input.ctensor, weightsize, len(weightsize), gradOutput.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_NnpackSpatialConvolutionBackwardWeight", input, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1425,10 +1425,10 @@ func _PackPaddedSequenceBackward(grad *Tensor, inputSize []int64, batchSizes *Te
 		cbatchFirst = int32(1)
 	}
 	lib.Atg_PackPaddedSequenceBackward(ptr, grad.ctensor, inputSize, len(inputSize), batchSizes.ctensor, cbatchFirst)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_PackPaddedSequenceBackward", grad, batchSizes); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1440,10 +1440,10 @@ func (ts *Tensor) _PdistBackward(grad *Tensor, p float64, pdist *Tensor, del boo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_PdistBackward(ptr, grad.ctensor, ts.ctensor, p, pdist.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_PdistBackward", ts, grad, pdist); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1455,10 +1455,10 @@ func (ts *Tensor) _RemoveBatchDim(level int64, batchSize int64, outDim int64, de
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_RemoveBatchDim(ptr, ts.ctensor, level, batchSize, outDim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_RemoveBatchDim", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1470,10 +1470,10 @@ func (ts *Tensor) _ReshapeFromTensor(shape *Tensor, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_ReshapeFromTensor(ptr, ts.ctensor, shape.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_ReshapeFromTensor", ts, shape); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1485,10 +1485,10 @@ func (ts *Tensor) _SWhere(condition *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SWhere(ptr, condition.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SWhere", ts, condition, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1500,10 +1500,10 @@ func (ts *Tensor) _SampleDirichlet(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SampleDirichlet(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SampleDirichlet", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1512,10 +1512,10 @@ func _SaturateWeightToFp16(weight *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SaturateWeightToFp16(ptr, weight.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SaturateWeightToFp16", weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1527,10 +1527,10 @@ func (ts *Tensor) _ShapeAsTensor(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_ShapeAsTensor(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_ShapeAsTensor", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1539,7 +1539,7 @@ func (ts *Tensor) _SobolEngineFf_(n int64, sobolstate *Tensor, dimension int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SobolEngineFf_(ptr, ts.ctensor, n, sobolstate.ctensor, dimension, numGenerated)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SobolEngineFf_", ts, sobolstate); err != nil {
 		return err
 	}
 
@@ -1550,7 +1550,7 @@ func (ts *Tensor) _SobolEngineInitializeState_(dimension int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SobolEngineInitializeState_(ptr, ts.ctensor, dimension)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SobolEngineInitializeState_", ts); err != nil {
 		return err
 	}
 
@@ -1561,7 +1561,7 @@ func (ts *Tensor) _SobolEngineScramble_(ltm *Tensor, dimension int64) (err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SobolEngineScramble_(ptr, ts.ctensor, ltm.ctensor, dimension)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SobolEngineScramble_", ts, ltm); err != nil {
 		return err
 	}
 
@@ -1579,10 +1579,10 @@ func (ts *Tensor) _Softmax(dim int64, halfToFloat bool, del bool) (retVal *Tenso
 		chalfToFloat = int32(1)
 	}
 	lib.Atg_Softmax(ptr, ts.ctensor, dim, chalfToFloat)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Softmax", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1594,10 +1594,10 @@ func (ts *Tensor) _SoftmaxBackwardData(gradOutput *Tensor, output *Tensor, dim i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SoftmaxBackwardData(ptr, gradOutput.ctensor, output.ctensor, dim, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SoftmaxBackwardData", ts, gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1609,10 +1609,10 @@ func (ts *Tensor) _SparseAddmm(sparse *Tensor, dense *Tensor, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseAddmm(ptr, ts.ctensor, sparse.ctensor, dense.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseAddmm", ts, sparse, dense); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1621,10 +1621,10 @@ func _SparseCooTensorUnsafe(indices *Tensor, values *Tensor, size []int64, optio
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseCooTensorUnsafe(ptr, indices.ctensor, values.ctensor, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseCooTensorUnsafe", indices, values); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1633,10 +1633,10 @@ func _SparseCooTensorWithDims(sparseDim int64, denseDim int64, size []int64, opt
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseCooTensorWithDims(ptr, sparseDim, denseDim, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseCooTensorWithDims"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1645,10 +1645,10 @@ func _SparseCooTensorWithDimsAndTensors(sparseDim int64, denseDim int64, size []
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseCooTensorWithDimsAndTensors(ptr, sparseDim, denseDim, size, len(size), indices.ctensor, values.ctensor, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseCooTensorWithDimsAndTensors", indices, values); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1660,10 +1660,10 @@ func (ts *Tensor) _SparseLogSoftmax(dim int64, dtype gotch.DType, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseLogSoftmax(ptr, ts.ctensor, dim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseLogSoftmax", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1679,10 +1679,10 @@ func (ts *Tensor) _SparseLogSoftmax1(dim int64, halfToFloat bool, del bool) (ret
 		chalfToFloat = int32(1)
 	}
 	lib.Atg_SparseLogSoftmax1(ptr, ts.ctensor, dim, chalfToFloat)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseLogSoftmax1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1694,10 +1694,10 @@ func (ts *Tensor) _SparseLogSoftmaxBackwardData(gradOutput *Tensor, output *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseLogSoftmaxBackwardData(ptr, gradOutput.ctensor, output.ctensor, dim, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseLogSoftmaxBackwardData", ts, gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1706,10 +1706,10 @@ func _SparseMm(sparse *Tensor, dense *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseMm(ptr, sparse.ctensor, dense.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseMm", sparse, dense); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1721,10 +1721,10 @@ func (ts *Tensor) _SparseSoftmax(dim int64, dtype gotch.DType, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSoftmax(ptr, ts.ctensor, dim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseSoftmax", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1740,10 +1740,10 @@ func (ts *Tensor) _SparseSoftmax1(dim int64, halfToFloat bool, del bool) (retVal
 		chalfToFloat = int32(1)
 	}
 	lib.Atg_SparseSoftmax1(ptr, ts.ctensor, dim, chalfToFloat)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseSoftmax1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1755,10 +1755,10 @@ func (ts *Tensor) _SparseSoftmaxBackwardData(gradOutput *Tensor, output *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSoftmaxBackwardData(ptr, gradOutput.ctensor, output.ctensor, dim, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseSoftmaxBackwardData", ts, gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1770,10 +1770,10 @@ func (ts *Tensor) _SparseSum(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSum(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseSum", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1785,10 +1785,10 @@ func (ts *Tensor) _SparseSum1(dtype gotch.DType, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSum1(ptr, ts.ctensor, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseSum1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1800,10 +1800,10 @@ func (ts *Tensor) _SparseSum2(dim []int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSum2(ptr, ts.ctensor, dim, len(dim))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseSum2", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1815,10 +1815,10 @@ func (ts *Tensor) _SparseSum3(dim []int64, dtype gotch.DType, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSum3(ptr, ts.ctensor, dim, len(dim), dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseSum3", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1830,10 +1830,10 @@ func (ts *Tensor) _SparseSumBackward(grad *Tensor, dim []int64, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSumBackward(ptr, grad.ctensor, ts.ctensor, dim, len(dim))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_SparseSumBackward", ts, grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1845,10 +1845,10 @@ func (ts *Tensor) _StandardGamma(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_StandardGamma(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_StandardGamma", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1860,10 +1860,10 @@ func (ts *Tensor) _StandardGammaGrad(output *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_StandardGammaGrad(ptr, ts.ctensor, output.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_StandardGammaGrad", ts, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1879,10 +1879,10 @@ func (ts *Tensor) _Std(unbiased bool, del bool) (retVal *Tensor, err error) {
 		cunbiased = int32(1)
 	}
 	lib.Atg_Std(ptr, ts.ctensor, cunbiased)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Std", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1891,10 +1891,10 @@ func _TestOptionalFilledIntlist(values *Tensor, addends []int64) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_TestOptionalFilledIntlist(ptr, values.ctensor, addends, len(addends))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_TestOptionalFilledIntlist", values); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1903,10 +1903,10 @@ func _TestOptionalIntlist(values *Tensor, addends []int64) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_TestOptionalIntlist(ptr, values.ctensor, addends, len(addends))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_TestOptionalIntlist", values); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1918,10 +1918,10 @@ func (ts *Tensor) _TestSerializationSubcmul(other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_TestSerializationSubcmul(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_TestSerializationSubcmul", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1930,10 +1930,10 @@ func _Trilinear(i1 *Tensor, i2 *Tensor, i3 *Tensor, expand1 []int64, expand2 []i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Trilinear(ptr, i1.ctensor, i2.ctensor, i3.ctensor, expand1, len(expand1), expand2, len(expand2), expand3, len(expand3), sumdim, len(sumdim), unrollDim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Trilinear", i1, i2, i3); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1945,10 +1945,10 @@ func (ts *Tensor) _UnsafeView(size []int64, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_UnsafeView(ptr, ts.ctensor, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_UnsafeView", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1960,10 +1960,10 @@ func (ts *Tensor) _Values(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Values(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Values", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1979,10 +1979,10 @@ func (ts *Tensor) _Var(unbiased bool, del bool) (retVal *Tensor, err error) {
 		cunbiased = int32(1)
 	}
 	lib.Atg_Var(ptr, ts.ctensor, cunbiased)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_Var", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1991,10 +1991,10 @@ func _WeightNorm(v *Tensor, g *Tensor, dim int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_WeightNorm(ptr, v.ctensor, g.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("_WeightNorm", v, g); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2006,10 +2006,10 @@ func (ts *Tensor) Abs(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbs(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Abs", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2018,7 +2018,7 @@ func (ts *Tensor) Abs_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbs_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Abs_", ts); err != nil {
 		return err
 	}
 
@@ -2032,10 +2032,10 @@ func (ts *Tensor) AbsOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbsOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AbsOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2047,10 +2047,10 @@ func (ts *Tensor) Absolute(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbsolute(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Absolute", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2059,7 +2059,7 @@ func (ts *Tensor) Absolute_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbsolute_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Absolute_", ts); err != nil {
 		return err
 	}
 
@@ -2073,10 +2073,10 @@ func (ts *Tensor) AbsoluteOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbsoluteOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AbsoluteOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2088,10 +2088,10 @@ func (ts *Tensor) Acos(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcos(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Acos", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2100,7 +2100,7 @@ func (ts *Tensor) Acos_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcos_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Acos_", ts); err != nil {
 		return err
 	}
 
@@ -2114,10 +2114,10 @@ func (ts *Tensor) AcosOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcosOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AcosOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2129,10 +2129,10 @@ func (ts *Tensor) Acosh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcosh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Acosh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2141,7 +2141,7 @@ func (ts *Tensor) Acosh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcosh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Acosh_", ts); err != nil {
 		return err
 	}
 
@@ -2155,10 +2155,10 @@ func (ts *Tensor) AcoshOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcoshOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AcoshOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2170,10 +2170,10 @@ func (ts *Tensor) AdaptiveAvgPool1d(outputSize []int64, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool1d(ptr, ts.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveAvgPool1d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2185,10 +2185,10 @@ func (ts *Tensor) AdaptiveAvgPool2d(outputSize []int64, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool2d(ptr, ts.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveAvgPool2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2200,10 +2200,10 @@ func (ts *Tensor) AdaptiveAvgPool2dOut(out *Tensor, outputSize []int64, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool2dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveAvgPool2dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2215,10 +2215,10 @@ func (ts *Tensor) AdaptiveAvgPool3d(outputSize []int64, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool3d(ptr, ts.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveAvgPool3d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2230,10 +2230,10 @@ func (ts *Tensor) AdaptiveAvgPool3dBackward(gradOutput *Tensor, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool3dBackward(ptr, gradOutput.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveAvgPool3dBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2245,10 +2245,10 @@ func (ts *Tensor) AdaptiveAvgPool3dBackwardOut(gradInput *Tensor, gradOutput *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveAvgPool3dBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2260,10 +2260,10 @@ func (ts *Tensor) AdaptiveAvgPool3dOut(out *Tensor, outputSize []int64, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool3dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveAvgPool3dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2275,10 +2275,10 @@ func (ts *Tensor) AdaptiveMaxPool2dBackward(gradOutput *Tensor, indices *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveMaxPool2dBackward(ptr, gradOutput.ctensor, ts.ctensor, indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveMaxPool2dBackward", ts, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2290,10 +2290,10 @@ func (ts *Tensor) AdaptiveMaxPool2dBackwardOut(gradInput *Tensor, gradOutput *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveMaxPool2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveMaxPool2dBackwardOut", ts, gradInput, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2305,10 +2305,10 @@ func (ts *Tensor) AdaptiveMaxPool3dBackward(gradOutput *Tensor, indices *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveMaxPool3dBackward(ptr, gradOutput.ctensor, ts.ctensor, indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveMaxPool3dBackward", ts, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2320,10 +2320,10 @@ func (ts *Tensor) AdaptiveMaxPool3dBackwardOut(gradInput *Tensor, gradOutput *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveMaxPool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AdaptiveMaxPool3dBackwardOut", ts, gradInput, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2335,10 +2335,10 @@ func (ts *Tensor) Add(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdd(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Add", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2350,10 +2350,10 @@ func (ts *Tensor) Add1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdd1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Add1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2362,7 +2362,7 @@ func (ts *Tensor) Add_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdd_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Add_", ts, other); err != nil {
 		return err
 	}
 
@@ -2373,7 +2373,7 @@ func (ts *Tensor) Add1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdd1_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Add1_", ts); err != nil {
 		return err
 	}
 
@@ -2387,10 +2387,10 @@ func (ts *Tensor) AddOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AddOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2402,10 +2402,10 @@ func (ts *Tensor) Addbmm(batch1 *Tensor, batch2 *Tensor, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddbmm(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addbmm", ts, batch1, batch2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2414,7 +2414,7 @@ func (ts *Tensor) Addbmm_(batch1 *Tensor, batch2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddbmm_(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addbmm_", ts, batch1, batch2); err != nil {
 		return err
 	}
 
@@ -2428,10 +2428,10 @@ func (ts *Tensor) AddbmmOut(out *Tensor, batch1 *Tensor, batch2 *Tensor, del boo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddbmmOut(ptr, out.ctensor, ts.ctensor, batch1.ctensor, batch2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AddbmmOut", ts, out, batch1, batch2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2443,10 +2443,10 @@ func (ts *Tensor) Addcdiv(tensor1 *Tensor, tensor2 *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcdiv(ptr, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addcdiv", ts, tensor1, tensor2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2455,7 +2455,7 @@ func (ts *Tensor) Addcdiv_(tensor1 *Tensor, tensor2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcdiv_(ptr, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addcdiv_", ts, tensor1, tensor2); err != nil {
 		return err
 	}
 
@@ -2469,10 +2469,10 @@ func (ts *Tensor) AddcdivOut(out *Tensor, tensor1 *Tensor, tensor2 *Tensor, del
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcdivOut(ptr, out.ctensor, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AddcdivOut", ts, out, tensor1, tensor2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2484,10 +2484,10 @@ func (ts *Tensor) Addcmul(tensor1 *Tensor, tensor2 *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcmul(ptr, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addcmul", ts, tensor1, tensor2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2496,7 +2496,7 @@ func (ts *Tensor) Addcmul_(tensor1 *Tensor, tensor2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcmul_(ptr, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addcmul_", ts, tensor1, tensor2); err != nil {
 		return err
 	}
 
@@ -2510,10 +2510,10 @@ func (ts *Tensor) AddcmulOut(out *Tensor, tensor1 *Tensor, tensor2 *Tensor, del
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcmulOut(ptr, out.ctensor, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AddcmulOut", ts, out, tensor1, tensor2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2525,10 +2525,10 @@ func (ts *Tensor) Addmm(mat1 *Tensor, mat2 *Tensor, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmm(ptr, ts.ctensor, mat1.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addmm", ts, mat1, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2537,7 +2537,7 @@ func (ts *Tensor) Addmm_(mat1 *Tensor, mat2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmm_(ptr, ts.ctensor, mat1.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addmm_", ts, mat1, mat2); err != nil {
 		return err
 	}
 
@@ -2551,10 +2551,10 @@ func (ts *Tensor) AddmmOut(out *Tensor, mat1 *Tensor, mat2 *Tensor, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmmOut(ptr, out.ctensor, ts.ctensor, mat1.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AddmmOut", ts, out, mat1, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2566,10 +2566,10 @@ func (ts *Tensor) Addmv(mat *Tensor, vec *Tensor, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmv(ptr, ts.ctensor, mat.ctensor, vec.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addmv", ts, mat, vec); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2578,7 +2578,7 @@ func (ts *Tensor) Addmv_(mat *Tensor, vec *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmv_(ptr, ts.ctensor, mat.ctensor, vec.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addmv_", ts, mat, vec); err != nil {
 		return err
 	}
 
@@ -2592,10 +2592,10 @@ func (ts *Tensor) AddmvOut(out *Tensor, mat *Tensor, vec *Tensor, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmvOut(ptr, out.ctensor, ts.ctensor, mat.ctensor, vec.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AddmvOut", ts, out, mat, vec); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2607,10 +2607,10 @@ func (ts *Tensor) Addr(vec1 *Tensor, vec2 *Tensor, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddr(ptr, ts.ctensor, vec1.ctensor, vec2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addr", ts, vec1, vec2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2619,7 +2619,7 @@ func (ts *Tensor) Addr_(vec1 *Tensor, vec2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddr_(ptr, ts.ctensor, vec1.ctensor, vec2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Addr_", ts, vec1, vec2); err != nil {
 		return err
 	}
 
@@ -2633,10 +2633,10 @@ func (ts *Tensor) AddrOut(out *Tensor, vec1 *Tensor, vec2 *Tensor, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddrOut(ptr, out.ctensor, ts.ctensor, vec1.ctensor, vec2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AddrOut", ts, out, vec1, vec2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2649,10 +2649,10 @@ func AffineGridGenerator(theta *Tensor, size []int64, alignCorners bool) (retVal
 		calignCorners = int32(1)
 	}
 	lib.AtgAffineGridGenerator(ptr, theta.ctensor, size, len(size), calignCorners)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AffineGridGenerator", theta); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2665,10 +2665,10 @@ func AffineGridGeneratorBackward(grad *Tensor, size []int64, alignCorners bool)
 		calignCorners = int32(1)
 	}
 	lib.AtgAffineGridGeneratorBackward(ptr, grad.ctensor, size, len(size), calignCorners)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AffineGridGeneratorBackward", grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2680,10 +2680,10 @@ func (ts *Tensor) Alias(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAlias(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Alias", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2695,10 +2695,10 @@ func (ts *Tensor) AlignAs(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAlignAs(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AlignAs", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2710,10 +2710,10 @@ func (ts *Tensor) All(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAll(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("All", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2729,10 +2729,10 @@ func (ts *Tensor) All1(dim int64, keepdim bool, del bool) (retVal *Tensor, err e
 		ckeepdim = int32(1)
 	}
 	lib.AtgAll1(ptr, ts.ctensor, dim, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("All1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2748,10 +2748,10 @@ func (ts *Tensor) AllOut(out *Tensor, dim int64, keepdim bool, del bool) (retVal
 		ckeepdim = int32(1)
 	}
 	lib.AtgAllOut(ptr, out.ctensor, ts.ctensor, dim, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AllOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2764,10 +2764,10 @@ func AlphaDropout(input *Tensor, p float64, train bool) (retVal *Tensor, err err
 		ctrain = int32(1)
 	}
 	lib.AtgAlphaDropout(ptr, input.ctensor, p, ctrain)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AlphaDropout", input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2780,7 +2780,7 @@ func (ts *Tensor) AlphaDropout_(p float64, train bool) (err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgAlphaDropout_(ptr, ts.ctensor, p, ctrain)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AlphaDropout_", ts); err != nil {
 		return err
 	}
 
@@ -2798,10 +2798,10 @@ func (ts *Tensor) Amax(dim []int64, keepdim bool, del bool) (retVal *Tensor, err
 		ckeepdim = int32(1)
 	}
 	lib.AtgAmax(ptr, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Amax", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2817,10 +2817,10 @@ func (ts *Tensor) AmaxOut(out *Tensor, dim []int64, keepdim bool, del bool) (ret
 		ckeepdim = int32(1)
 	}
 	lib.AtgAmaxOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AmaxOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2836,10 +2836,10 @@ func (ts *Tensor) Amin(dim []int64, keepdim bool, del bool) (retVal *Tensor, err
 		ckeepdim = int32(1)
 	}
 	lib.AtgAmin(ptr, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Amin", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2855,10 +2855,10 @@ func (ts *Tensor) AminOut(out *Tensor, dim []int64, keepdim bool, del bool) (ret
 		ckeepdim = int32(1)
 	}
 	lib.AtgAminOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AminOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2870,10 +2870,10 @@ func (ts *Tensor) Angle(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAngle(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Angle", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2885,10 +2885,10 @@ func (ts *Tensor) AngleOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAngleOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AngleOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2900,10 +2900,10 @@ func (ts *Tensor) Any(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAny(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Any", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2919,10 +2919,10 @@ func (ts *Tensor) Any1(dim int64, keepdim bool, del bool) (retVal *Tensor, err e
 		ckeepdim = int32(1)
 	}
 	lib.AtgAny1(ptr, ts.ctensor, dim, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Any1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2938,10 +2938,10 @@ func (ts *Tensor) AnyOut(out *Tensor, dim int64, keepdim bool, del bool) (retVal
 		ckeepdim = int32(1)
 	}
 	lib.AtgAnyOut(ptr, out.ctensor, ts.ctensor, dim, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AnyOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2950,10 +2950,10 @@ func Arange(end *Scalar, optionsKind gotch.DType, optionsDevice gotch.Device) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArange(ptr, end.cscalar, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arange"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2962,10 +2962,10 @@ func Arange1(start *Scalar, end *Scalar, optionsKind gotch.DType, optionsDevice
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArange1(ptr, start.cscalar, end.cscalar, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arange1"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2974,10 +2974,10 @@ func Arange2(start *Scalar, end *Scalar, step *Scalar, optionsKind gotch.DType,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArange2(ptr, start.cscalar, end.cscalar, step.cscalar, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arange2"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2986,10 +2986,10 @@ func ArangeOut(out *Tensor, end *Scalar) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArangeOut(ptr, out.ctensor, end.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ArangeOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2998,10 +2998,10 @@ func ArangeOut1(out *Tensor, start *Scalar, end *Scalar) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArangeOut1(ptr, out.ctensor, start.cscalar, end.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ArangeOut1", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3013,10 +3013,10 @@ func (ts *Tensor) Arccos(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccos(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arccos", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3025,7 +3025,7 @@ func (ts *Tensor) Arccos_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccos_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arccos_", ts); err != nil {
 		return err
 	}
 
@@ -3039,10 +3039,10 @@ func (ts *Tensor) ArccosOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccosOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ArccosOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3054,10 +3054,10 @@ func (ts *Tensor) Arccosh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccosh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arccosh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3066,7 +3066,7 @@ func (ts *Tensor) Arccosh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccosh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arccosh_", ts); err != nil {
 		return err
 	}
 
@@ -3080,10 +3080,10 @@ func (ts *Tensor) ArccoshOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccoshOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ArccoshOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3095,10 +3095,10 @@ func (ts *Tensor) Arcsin(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsin(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arcsin", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3107,7 +3107,7 @@ func (ts *Tensor) Arcsin_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsin_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arcsin_", ts); err != nil {
 		return err
 	}
 
@@ -3121,10 +3121,10 @@ func (ts *Tensor) ArcsinOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsinOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ArcsinOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3136,10 +3136,10 @@ func (ts *Tensor) Arcsinh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsinh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arcsinh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3148,7 +3148,7 @@ func (ts *Tensor) Arcsinh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsinh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arcsinh_", ts); err != nil {
 		return err
 	}
 
@@ -3162,10 +3162,10 @@ func (ts *Tensor) ArcsinhOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsinhOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ArcsinhOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3177,10 +3177,10 @@ func (ts *Tensor) Arctan(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctan(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arctan", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3189,7 +3189,7 @@ func (ts *Tensor) Arctan_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctan_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arctan_", ts); err != nil {
 		return err
 	}
 
@@ -3203,10 +3203,10 @@ func (ts *Tensor) ArctanOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctanOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ArctanOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3218,10 +3218,10 @@ func (ts *Tensor) Arctanh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctanh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arctanh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3230,7 +3230,7 @@ func (ts *Tensor) Arctanh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctanh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Arctanh_", ts); err != nil {
 		return err
 	}
 
@@ -3244,10 +3244,10 @@ func (ts *Tensor) ArctanhOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctanhOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ArctanhOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3269,10 +3269,10 @@ func (ts *Tensor) Argmax(dim []int64, keepdim bool, del bool) (retVal *Tensor, e
 		ckeepdim = int32(1)
 	}
 	lib.AtgArgmax(ptr, ts.ctensor, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Argmax", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3294,10 +3294,10 @@ func (ts *Tensor) Argmin(dim []int64, keepdim bool, del bool) (retVal *Tensor, e
 		ckeepdim = int32(1)
 	}
 	lib.AtgArgmin(ptr, ts.ctensor, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Argmin", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3313,10 +3313,10 @@ func (ts *Tensor) Argsort(dim int64, descending bool, del bool) (retVal *Tensor,
 		cdescending = int32(1)
 	}
 	lib.AtgArgsort(ptr, ts.ctensor, dim, cdescending)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Argsort", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3334,10 +3334,10 @@ func (ts *Tensor) AsStrided(size []int64, stride []int64, storageOffset []int64,
 		cstorageOffsetNull = 0
 	}
 	lib.AtgAsStrided(ptr, ts.ctensor, size, len(size), stride, len(stride), cstorageOffsetVal, cstorageOffsetNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AsStrided", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3352,7 +3352,7 @@ func (ts *Tensor) AsStrided_(size []int64, stride []int64, storageOffset []int64
 		cstorageOffsetNull = 0
 	}
 	lib.AtgAsStrided_(ptr, ts.ctensor, size, len(size), stride, len(stride), cstorageOffsetVal, cstorageOffsetNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AsStrided_", ts); err != nil {
 		return err
 	}
 
@@ -3366,10 +3366,10 @@ func (ts *Tensor) Asin(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsin(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Asin", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3378,7 +3378,7 @@ func (ts *Tensor) Asin_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsin_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Asin_", ts); err != nil {
 		return err
 	}
 
@@ -3392,10 +3392,10 @@ func (ts *Tensor) AsinOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsinOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AsinOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3407,10 +3407,10 @@ func (ts *Tensor) Asinh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsinh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Asinh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3419,7 +3419,7 @@ func (ts *Tensor) Asinh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsinh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Asinh_", ts); err != nil {
 		return err
 	}
 
@@ -3433,10 +3433,10 @@ func (ts *Tensor) AsinhOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsinhOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AsinhOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3448,10 +3448,10 @@ func (ts *Tensor) Atan(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atan", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3463,10 +3463,10 @@ func (ts *Tensor) Atan2(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan2(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atan2", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3475,7 +3475,7 @@ func (ts *Tensor) Atan2_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan2_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atan2_", ts, other); err != nil {
 		return err
 	}
 
@@ -3489,10 +3489,10 @@ func (ts *Tensor) Atan2Out(out *Tensor, other *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan2Out(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atan2Out", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3501,7 +3501,7 @@ func (ts *Tensor) Atan_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atan_", ts); err != nil {
 		return err
 	}
 
@@ -3515,10 +3515,10 @@ func (ts *Tensor) AtanOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtanOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AtanOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3530,10 +3530,10 @@ func (ts *Tensor) Atanh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtanh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atanh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3542,7 +3542,7 @@ func (ts *Tensor) Atanh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtanh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atanh_", ts); err != nil {
 		return err
 	}
 
@@ -3556,10 +3556,10 @@ func (ts *Tensor) AtanhOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtanhOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AtanhOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3571,10 +3571,10 @@ func (ts *Tensor) Atleast1d(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtleast1d(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atleast1d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3586,10 +3586,10 @@ func (ts *Tensor) Atleast2d(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtleast2d(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atleast2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3601,10 +3601,10 @@ func (ts *Tensor) Atleast3d(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtleast3d(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Atleast3d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3624,10 +3624,10 @@ func (ts *Tensor) AvgPool1d(kernelSize []int64, stride []int64, padding []int64,
 		ccountIncludePad = int32(1)
 	}
 	lib.AtgAvgPool1d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AvgPool1d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3653,10 +3653,10 @@ func (ts *Tensor) AvgPool2d(kernelSize []int64, stride []int64, padding []int64,
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool2d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AvgPool2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3682,10 +3682,10 @@ func (ts *Tensor) AvgPool2dBackward(gradOutput *Tensor, kernelSize []int64, stri
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool2dBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AvgPool2dBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3711,10 +3711,10 @@ func (ts *Tensor) AvgPool2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, ke
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AvgPool2dBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3740,10 +3740,10 @@ func (ts *Tensor) AvgPool2dOut(out *Tensor, kernelSize []int64, stride []int64,
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool2dOut(ptr, out.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AvgPool2dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3769,10 +3769,10 @@ func (ts *Tensor) AvgPool3d(kernelSize []int64, stride []int64, padding []int64,
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool3d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AvgPool3d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3798,10 +3798,10 @@ func (ts *Tensor) AvgPool3dBackward(gradOutput *Tensor, kernelSize []int64, stri
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool3dBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AvgPool3dBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3827,10 +3827,10 @@ func (ts *Tensor) AvgPool3dBackwardOut(gradInput *Tensor, gradOutput *Tensor, ke
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AvgPool3dBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3856,10 +3856,10 @@ func (ts *Tensor) AvgPool3dOut(out *Tensor, kernelSize []int64, stride []int64,
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool3dOut(ptr, out.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("AvgPool3dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3871,10 +3871,10 @@ func (ts *Tensor) Baddbmm(batch1 *Tensor, batch2 *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBaddbmm(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Baddbmm", ts, batch1, batch2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3883,7 +3883,7 @@ func (ts *Tensor) Baddbmm_(batch1 *Tensor, batch2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBaddbmm_(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Baddbmm_", ts, batch1, batch2); err != nil {
 		return err
 	}
 
@@ -3897,10 +3897,10 @@ func (ts *Tensor) BaddbmmOut(out *Tensor, batch1 *Tensor, batch2 *Tensor, del bo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBaddbmmOut(ptr, out.ctensor, ts.ctensor, batch1.ctensor, batch2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BaddbmmOut", ts, out, batch1, batch2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3909,10 +3909,10 @@ func BartlettWindow(windowLength int64, optionsKind gotch.DType, optionsDevice g
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBartlettWindow(ptr, windowLength, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BartlettWindow"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3925,10 +3925,10 @@ func BartlettWindow1(windowLength int64, periodic bool, optionsKind gotch.DType,
 		cperiodic = int32(1)
 	}
 	lib.AtgBartlettWindow1(ptr, windowLength, cperiodic, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BartlettWindow1"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3945,10 +3945,10 @@ func BatchNorm(input *Tensor, weight *Tensor, bias *Tensor, runningMean *Tensor,
 		ccudnnEnabled = int32(1)
 	}
 	lib.AtgBatchNorm(ptr, input.ctensor, weight.ctensor, bias.ctensor, runningMean.ctensor, runningVar.ctensor, ctraining, momentum, eps, ccudnnEnabled)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BatchNorm", input, weight, bias, runningMean, runningVar); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3957,10 +3957,10 @@ func BatchNormBackwardElemt(gradOut *Tensor, input *Tensor, mean *Tensor, invstd
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBatchNormBackwardElemt(ptr, gradOut.ctensor, input.ctensor, mean.ctensor, invstd.ctensor, weight.ctensor, meanDy.ctensor, meanDyXmu.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BatchNormBackwardElemt", gradOut, input, mean, invstd, weight, meanDy, meanDyXmu); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3969,10 +3969,10 @@ func BatchNormElemt(input *Tensor, weight *Tensor, bias *Tensor, mean *Tensor, i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBatchNormElemt(ptr, input.ctensor, weight.ctensor, bias.ctensor, mean.ctensor, invstd.ctensor, eps)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BatchNormElemt", input, weight, bias, mean, invstd); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3981,10 +3981,10 @@ func BatchNormElemtOut(out *Tensor, input *Tensor, weight *Tensor, bias *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBatchNormElemtOut(ptr, out.ctensor, input.ctensor, weight.ctensor, bias.ctensor, mean.ctensor, invstd.ctensor, eps)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BatchNormElemtOut", out, input, weight, bias, mean, invstd); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3996,10 +3996,10 @@ func (ts *Tensor) Bernoulli(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulli(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Bernoulli", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4011,10 +4011,10 @@ func (ts *Tensor) Bernoulli1(p float64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulli1(ptr, ts.ctensor, p)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Bernoulli1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4023,7 +4023,7 @@ func (ts *Tensor) Bernoulli_(p *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulli_(ptr, ts.ctensor, p.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Bernoulli_", ts, p); err != nil {
 		return err
 	}
 
@@ -4034,7 +4034,7 @@ func (ts *Tensor) Bernoulli1_(p float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulli1_(ptr, ts.ctensor, p)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Bernoulli1_", ts); err != nil {
 		return err
 	}
 
@@ -4048,10 +4048,10 @@ func (ts *Tensor) BernoulliOut(out *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulliOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BernoulliOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4060,10 +4060,10 @@ func Bilinear(input1 *Tensor, input2 *Tensor, weight *Tensor, bias *Tensor) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBilinear(ptr, input1.ctensor, input2.ctensor, weight.ctensor, bias.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Bilinear", input1, input2, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4075,10 +4075,10 @@ func (ts *Tensor) BinaryCrossEntropy(target *Tensor, weight *Tensor, reduction i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropy(ptr, ts.ctensor, target.ctensor, weight.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BinaryCrossEntropy", ts, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4090,10 +4090,10 @@ func (ts *Tensor) BinaryCrossEntropyBackward(gradOutput *Tensor, target *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BinaryCrossEntropyBackward", ts, gradOutput, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4105,10 +4105,10 @@ func (ts *Tensor) BinaryCrossEntropyBackwardOut(gradInput *Tensor, gradOutput *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BinaryCrossEntropyBackwardOut", ts, gradInput, gradOutput, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4120,10 +4120,10 @@ func (ts *Tensor) BinaryCrossEntropyOut(out *Tensor, target *Tensor, weight *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyOut(ptr, out.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BinaryCrossEntropyOut", ts, out, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4135,10 +4135,10 @@ func (ts *Tensor) BinaryCrossEntropyWithLogits(target *Tensor, weight *Tensor, p
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyWithLogits(ptr, ts.ctensor, target.ctensor, weight.ctensor, posWeight.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BinaryCrossEntropyWithLogits", ts, target, weight, posWeight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4150,10 +4150,10 @@ func (ts *Tensor) BinaryCrossEntropyWithLogitsBackward(gradOutput *Tensor, targe
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyWithLogitsBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, posWeight.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BinaryCrossEntropyWithLogitsBackward", ts, gradOutput, target, weight, posWeight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4165,10 +4165,10 @@ func (ts *Tensor) Bincount(weights *Tensor, minlength int64, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBincount(ptr, ts.ctensor, weights.ctensor, minlength)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Bincount", ts, weights); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4177,10 +4177,10 @@ func Binomial(count *Tensor, prob *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinomial(ptr, count.ctensor, prob.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Binomial", count, prob); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4192,10 +4192,10 @@ func (ts *Tensor) BitwiseAnd(other *Scalar, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAnd(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseAnd", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4207,10 +4207,10 @@ func (ts *Tensor) BitwiseAnd1(other *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAnd1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseAnd1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4219,7 +4219,7 @@ func (ts *Tensor) BitwiseAnd_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAnd_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseAnd_", ts); err != nil {
 		return err
 	}
 
@@ -4230,7 +4230,7 @@ func (ts *Tensor) BitwiseAnd1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAnd1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseAnd1_", ts, other); err != nil {
 		return err
 	}
 
@@ -4244,10 +4244,10 @@ func (ts *Tensor) BitwiseAndOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAndOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseAndOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4259,10 +4259,10 @@ func (ts *Tensor) BitwiseAndOut1(out *Tensor, other *Scalar, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAndOut1(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseAndOut1", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4274,10 +4274,10 @@ func (ts *Tensor) BitwiseNot(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseNot(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseNot", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4286,7 +4286,7 @@ func (ts *Tensor) BitwiseNot_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseNot_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseNot_", ts); err != nil {
 		return err
 	}
 
@@ -4300,10 +4300,10 @@ func (ts *Tensor) BitwiseNotOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseNotOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseNotOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4315,10 +4315,10 @@ func (ts *Tensor) BitwiseOr(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOr(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseOr", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4330,10 +4330,10 @@ func (ts *Tensor) BitwiseOr1(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOr1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseOr1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4342,7 +4342,7 @@ func (ts *Tensor) BitwiseOr_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOr_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseOr_", ts); err != nil {
 		return err
 	}
 
@@ -4353,7 +4353,7 @@ func (ts *Tensor) BitwiseOr1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOr1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseOr1_", ts, other); err != nil {
 		return err
 	}
 
@@ -4367,10 +4367,10 @@ func (ts *Tensor) BitwiseOrOut(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOrOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseOrOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4382,10 +4382,10 @@ func (ts *Tensor) BitwiseOrOut1(out *Tensor, other *Scalar, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOrOut1(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseOrOut1", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4397,10 +4397,10 @@ func (ts *Tensor) BitwiseXor(other *Scalar, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXor(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseXor", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4412,10 +4412,10 @@ func (ts *Tensor) BitwiseXor1(other *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXor1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseXor1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4424,7 +4424,7 @@ func (ts *Tensor) BitwiseXor_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXor_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseXor_", ts); err != nil {
 		return err
 	}
 
@@ -4435,7 +4435,7 @@ func (ts *Tensor) BitwiseXor1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXor1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseXor1_", ts, other); err != nil {
 		return err
 	}
 
@@ -4449,10 +4449,10 @@ func (ts *Tensor) BitwiseXorOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXorOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseXorOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4464,10 +4464,10 @@ func (ts *Tensor) BitwiseXorOut1(out *Tensor, other *Scalar, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXorOut1(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BitwiseXorOut1", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4476,10 +4476,10 @@ func BlackmanWindow(windowLength int64, optionsKind gotch.DType, optionsDevice g
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBlackmanWindow(ptr, windowLength, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BlackmanWindow"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4492,10 +4492,10 @@ func BlackmanWindow1(windowLength int64, periodic bool, optionsKind gotch.DType,
 		cperiodic = int32(1)
 	}
 	lib.AtgBlackmanWindow1(ptr, windowLength, cperiodic, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BlackmanWindow1"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4508,10 +4508,10 @@ func BlockDiag(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgBlockDiag(ptr, ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BlockDiag"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4523,10 +4523,10 @@ func (ts *Tensor) Bmm(mat2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBmm(ptr, ts.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Bmm", ts, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4538,10 +4538,10 @@ func (ts *Tensor) BmmOut(out *Tensor, mat2 *Tensor, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBmmOut(ptr, out.ctensor, ts.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BmmOut", ts, out, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4561,10 +4561,10 @@ func (ts *Tensor) Bucketize(boundaries *Tensor, outInt32 bool, right bool, del b
 		cright = int32(1)
 	}
 	lib.AtgBucketize(ptr, ts.ctensor, boundaries.ctensor, coutInt32, cright)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Bucketize", ts, boundaries); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4581,10 +4581,10 @@ func Bucketize1(selfScalar *Scalar, boundaries *Tensor, outInt32 bool, right boo
 		cright = int32(1)
 	}
 	lib.AtgBucketize1(ptr, selfScalar.cscalar, boundaries.ctensor, coutInt32, cright)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Bucketize1", boundaries); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4604,10 +4604,10 @@ func (ts *Tensor) BucketizeOut(out *Tensor, boundaries *Tensor, outInt32 bool, r
 		cright = int32(1)
 	}
 	lib.AtgBucketizeOut(ptr, out.ctensor, ts.ctensor, boundaries.ctensor, coutInt32, cright)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("BucketizeOut", ts, out, boundaries); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4620,10 +4620,10 @@ func CartesianProd(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgCartesianProd(ptr, ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CartesianProd"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4636,10 +4636,10 @@ func Cat(tensors []Tensor, dim int64) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgCat(ptr, ctensors, len(ctensors), dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cat"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4652,10 +4652,10 @@ func CatOut(out *Tensor, tensors []Tensor, dim int64) (retVal *Tensor, err error
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgCatOut(ptr, out.ctensor, ctensors, len(ctensors), dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CatOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4664,7 +4664,7 @@ func (ts *Tensor) Cauchy_(median float64, sigma float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCauchy_(ptr, ts.ctensor, median, sigma)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cauchy_", ts); err != nil {
 		return err
 	}
 
@@ -4681,10 +4681,10 @@ func Cdist(x1 *Tensor, x2 *Tensor, p float64, computeMode []int64) (retVal *Tens
 		ccomputeModeNull = 0
 	}
 	lib.AtgCdist(ptr, x1.ctensor, x2.ctensor, p, ccomputeModeVal, ccomputeModeNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cdist", x1, x2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4696,10 +4696,10 @@ func (ts *Tensor) Ceil(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCeil(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ceil", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4708,7 +4708,7 @@ func (ts *Tensor) Ceil_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCeil_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ceil_", ts); err != nil {
 		return err
 	}
 
@@ -4722,10 +4722,10 @@ func (ts *Tensor) CeilOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCeilOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CeilOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4737,10 +4737,10 @@ func (ts *Tensor) Celu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCelu(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Celu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4749,7 +4749,7 @@ func (ts *Tensor) Celu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCelu_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Celu_", ts); err != nil {
 		return err
 	}
 
@@ -4764,10 +4764,10 @@ func ChainMatmul(matrices []Tensor) (retVal *Tensor, err error) {
 		cmatrices = append(cmatrices, t.ctensor)
 	}
 	lib.AtgChainMatmul(ptr, cmatrices, len(cmatrices))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ChainMatmul"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4779,10 +4779,10 @@ func (ts *Tensor) ChannelShuffle(groups int64, del bool) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgChannelShuffle(ptr, ts.ctensor, groups)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ChannelShuffle", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4798,10 +4798,10 @@ func (ts *Tensor) Cholesky(upper bool, del bool) (retVal *Tensor, err error) {
 		cupper = int32(1)
 	}
 	lib.AtgCholesky(ptr, ts.ctensor, cupper)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cholesky", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4817,10 +4817,10 @@ func (ts *Tensor) CholeskyInverse(upper bool, del bool) (retVal *Tensor, err err
 		cupper = int32(1)
 	}
 	lib.AtgCholeskyInverse(ptr, ts.ctensor, cupper)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CholeskyInverse", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4836,10 +4836,10 @@ func (ts *Tensor) CholeskyInverseOut(out *Tensor, upper bool, del bool) (retVal
 		cupper = int32(1)
 	}
 	lib.AtgCholeskyInverseOut(ptr, out.ctensor, ts.ctensor, cupper)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CholeskyInverseOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4855,10 +4855,10 @@ func (ts *Tensor) CholeskyOut(out *Tensor, upper bool, del bool) (retVal *Tensor
 		cupper = int32(1)
 	}
 	lib.AtgCholeskyOut(ptr, out.ctensor, ts.ctensor, cupper)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CholeskyOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4874,10 +4874,10 @@ func (ts *Tensor) CholeskySolve(input2 *Tensor, upper bool, del bool) (retVal *T
 		cupper = int32(1)
 	}
 	lib.AtgCholeskySolve(ptr, ts.ctensor, input2.ctensor, cupper)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CholeskySolve", ts, input2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4893,10 +4893,10 @@ func (ts *Tensor) CholeskySolveOut(out *Tensor, input2 *Tensor, upper bool, del
 		cupper = int32(1)
 	}
 	lib.AtgCholeskySolveOut(ptr, out.ctensor, ts.ctensor, input2.ctensor, cupper)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CholeskySolveOut", ts, out, input2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4908,10 +4908,10 @@ func (ts *Tensor) Clamp(min *Scalar, max *Scalar, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClamp(ptr, ts.ctensor, min.cscalar, max.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Clamp", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4920,7 +4920,7 @@ func (ts *Tensor) Clamp_(min *Scalar, max *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClamp_(ptr, ts.ctensor, min.cscalar, max.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Clamp_", ts); err != nil {
 		return err
 	}
 
@@ -4934,10 +4934,10 @@ func (ts *Tensor) ClampMax(max *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMax(ptr, ts.ctensor, max.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ClampMax", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4946,7 +4946,7 @@ func (ts *Tensor) ClampMax_(max *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMax_(ptr, ts.ctensor, max.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ClampMax_", ts); err != nil {
 		return err
 	}
 
@@ -4960,10 +4960,10 @@ func (ts *Tensor) ClampMaxOut(out *Tensor, max *Scalar, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMaxOut(ptr, out.ctensor, ts.ctensor, max.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ClampMaxOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4975,10 +4975,10 @@ func (ts *Tensor) ClampMin(min *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMin(ptr, ts.ctensor, min.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ClampMin", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4987,7 +4987,7 @@ func (ts *Tensor) ClampMin_(min *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMin_(ptr, ts.ctensor, min.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ClampMin_", ts); err != nil {
 		return err
 	}
 
@@ -5001,10 +5001,10 @@ func (ts *Tensor) ClampMinOut(out *Tensor, min *Scalar, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMinOut(ptr, out.ctensor, ts.ctensor, min.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ClampMinOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5016,10 +5016,10 @@ func (ts *Tensor) ClampOut(out *Tensor, min *Scalar, max *Scalar, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampOut(ptr, out.ctensor, ts.ctensor, min.cscalar, max.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ClampOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5031,10 +5031,10 @@ func (ts *Tensor) Clip(min *Scalar, max *Scalar, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClip(ptr, ts.ctensor, min.cscalar, max.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Clip", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5043,7 +5043,7 @@ func (ts *Tensor) Clip_(min *Scalar, max *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClip_(ptr, ts.ctensor, min.cscalar, max.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Clip_", ts); err != nil {
 		return err
 	}
 
@@ -5057,10 +5057,10 @@ func (ts *Tensor) ClipOut(out *Tensor, min *Scalar, max *Scalar, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClipOut(ptr, out.ctensor, ts.ctensor, min.cscalar, max.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ClipOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5072,10 +5072,10 @@ func (ts *Tensor) Coalesce(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCoalesce(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Coalesce", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5087,10 +5087,10 @@ func (ts *Tensor) Col2im(outputSize []int64, kernelSize []int64, dilation []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCol2im(ptr, ts.ctensor, outputSize, len(outputSize), kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Col2im", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5099,10 +5099,10 @@ func Col2imBackward(gradOutput *Tensor, kernelSize []int64, dilation []int64, pa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCol2imBackward(ptr, gradOutput.ctensor, kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Col2imBackward", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5111,10 +5111,10 @@ func Col2imBackwardOut(gradInput *Tensor, gradOutput *Tensor, kernelSize []int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCol2imBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Col2imBackwardOut", gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5126,10 +5126,10 @@ func (ts *Tensor) Col2imOut(out *Tensor, outputSize []int64, kernelSize []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCol2imOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Col2imOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5145,10 +5145,10 @@ func (ts *Tensor) Combinations(r int64, withReplacement bool, del bool) (retVal
 		cwithReplacement = int32(1)
 	}
 	lib.AtgCombinations(ptr, ts.ctensor, r, cwithReplacement)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Combinations", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5157,10 +5157,10 @@ func Complex(real *Tensor, imag *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgComplex(ptr, real.ctensor, imag.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Complex", real, imag); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5169,10 +5169,10 @@ func ComplexOut(out *Tensor, real *Tensor, imag *Tensor) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgComplexOut(ptr, out.ctensor, real.ctensor, imag.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ComplexOut", out, real, imag); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5184,10 +5184,10 @@ func (ts *Tensor) Conj(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConj(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Conj", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5199,10 +5199,10 @@ func (ts *Tensor) ConjOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConjOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ConjOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5214,10 +5214,10 @@ func (ts *Tensor) ConstantPadNd(pad []int64, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConstantPadNd(ptr, ts.ctensor, pad, len(pad))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ConstantPadNd", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5229,10 +5229,10 @@ func (ts *Tensor) Contiguous(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgContiguous(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Contiguous", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5241,10 +5241,10 @@ func Conv1d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, padding
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConv1d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), groups)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Conv1d", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5253,10 +5253,10 @@ func Conv2d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, padding
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConv2d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), groups)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Conv2d", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5265,10 +5265,10 @@ func Conv3d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, padding
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConv3d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), groups)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Conv3d", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5280,10 +5280,10 @@ func (ts *Tensor) ConvTbc(weight *Tensor, bias *Tensor, pad int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConvTbc(ptr, ts.ctensor, weight.ctensor, bias.ctensor, pad)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ConvTbc", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5292,10 +5292,10 @@ func ConvTranspose1d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConvTranspose1d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), groups, dilation, len(dilation))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ConvTranspose1d", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5304,10 +5304,10 @@ func ConvTranspose2d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConvTranspose2d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), groups, dilation, len(dilation))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ConvTranspose2d", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5316,10 +5316,10 @@ func ConvTranspose3d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConvTranspose3d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), groups, dilation, len(dilation))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ConvTranspose3d", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5332,10 +5332,10 @@ func Convolution(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, pa
 		ctransposed = int32(1)
 	}
 	lib.AtgConvolution(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding), groups)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Convolution", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5348,10 +5348,10 @@ func ConvolutionOverrideable(input *Tensor, weight *Tensor, bias *Tensor, stride
 		ctransposed = int32(1)
 	}
 	lib.AtgConvolutionOverrideable(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding), groups)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ConvolutionOverrideable", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5364,7 +5364,7 @@ func (ts *Tensor) CopySparseToSparse_(src *Tensor, nonBlocking bool) (err error)
 		cnonBlocking = int32(1)
 	}
 	lib.AtgCopySparseToSparse_(ptr, ts.ctensor, src.ctensor, cnonBlocking)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CopySparseToSparse_", ts, src); err != nil {
 		return err
 	}
 
@@ -5378,10 +5378,10 @@ func (ts *Tensor) Cos(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCos(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cos", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5390,7 +5390,7 @@ func (ts *Tensor) Cos_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCos_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cos_", ts); err != nil {
 		return err
 	}
 
@@ -5404,10 +5404,10 @@ func (ts *Tensor) CosOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CosOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5419,10 +5419,10 @@ func (ts *Tensor) Cosh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cosh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5431,7 +5431,7 @@ func (ts *Tensor) Cosh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cosh_", ts); err != nil {
 		return err
 	}
 
@@ -5445,10 +5445,10 @@ func (ts *Tensor) CoshOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCoshOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CoshOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5457,10 +5457,10 @@ func CosineEmbeddingLoss(input1 *Tensor, input2 *Tensor, target *Tensor, margin
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosineEmbeddingLoss(ptr, input1.ctensor, input2.ctensor, target.ctensor, margin, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CosineEmbeddingLoss", input1, input2, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5469,10 +5469,10 @@ func CosineSimilarity(x1 *Tensor, x2 *Tensor, dim int64, eps float64) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosineSimilarity(ptr, x1.ctensor, x2.ctensor, dim, eps)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CosineSimilarity", x1, x2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5484,10 +5484,10 @@ func (ts *Tensor) CountNonzero(dim []int64, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCountNonzero(ptr, ts.ctensor, dim, len(dim))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CountNonzero", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5505,10 +5505,10 @@ func (ts *Tensor) CountNonzero1(dim []int64, del bool) (retVal *Tensor, err erro
 		cdimNull = 0
 	}
 	lib.AtgCountNonzero1(ptr, ts.ctensor, cdimVal, cdimNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CountNonzero1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5526,10 +5526,10 @@ func (ts *Tensor) Cross(other *Tensor, dim []int64, del bool) (retVal *Tensor, e
 		cdimNull = 0
 	}
 	lib.AtgCross(ptr, ts.ctensor, other.ctensor, cdimVal, cdimNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cross", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5547,10 +5547,10 @@ func (ts *Tensor) CrossOut(out *Tensor, other *Tensor, dim []int64, del bool) (r
 		cdimNull = 0
 	}
 	lib.AtgCrossOut(ptr, out.ctensor, ts.ctensor, other.ctensor, cdimVal, cdimNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CrossOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5563,10 +5563,10 @@ func CtcLoss(logProbs *Tensor, targets *Tensor, inputLengths []int64, targetLeng
 		czeroInfinity = int32(1)
 	}
 	lib.AtgCtcLoss(ptr, logProbs.ctensor, targets.ctensor, inputLengths, len(inputLengths), targetLengths, len(targetLengths), blank, reduction, czeroInfinity)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CtcLoss", logProbs, targets); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5579,10 +5579,10 @@ func CtcLoss1(logProbs *Tensor, targets *Tensor, inputLengths *Tensor, targetLen
 		czeroInfinity = int32(1)
 	}
 	lib.AtgCtcLoss1(ptr, logProbs.ctensor, targets.ctensor, inputLengths.ctensor, targetLengths.ctensor, blank, reduction, czeroInfinity)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CtcLoss1", logProbs, targets, inputLengths, targetLengths); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5591,10 +5591,10 @@ func CudnnAffineGridGenerator(theta *Tensor, n int64, c int64, h int64, w int64)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCudnnAffineGridGenerator(ptr, theta.ctensor, n, c, h, w)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnAffineGridGenerator", theta); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5603,10 +5603,10 @@ func CudnnAffineGridGeneratorBackward(grad *Tensor, n int64, c int64, h int64, w
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCudnnAffineGridGeneratorBackward(ptr, grad.ctensor, n, c, h, w)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnAffineGridGeneratorBackward", grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5626,10 +5626,10 @@ func (ts *Tensor) CudnnConvolution(weight *Tensor, padding []int64, stride []int
 		cdeterministic = int32(1)
 	}
 	lib.AtgCudnnConvolution(ptr, ts.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolution", ts, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5649,10 +5649,10 @@ func (ts *Tensor) CudnnConvolution1(weight *Tensor, bias *Tensor, padding []int6
 		cdeterministic = int32(1)
 	}
 	lib.AtgCudnnConvolution1(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolution1", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5676,10 +5676,10 @@ func (ts *Tensor) CudnnConvolution2(weight *Tensor, padding []int64, stride []in
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolution2(ptr, ts.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolution2", ts, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5700,10 +5700,10 @@ func CudnnConvolutionBackwardInput(selfSize []int64, gradOutput *Tensor, weight
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionBackwardInput(ptr, selfSize, len(selfSize), gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolutionBackwardInput", gradOutput, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5727,10 +5727,10 @@ func (ts *Tensor) CudnnConvolutionBackwardWeight(weightSize []int64, gradOutput
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolutionBackwardWeight", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5750,10 +5750,10 @@ func (ts *Tensor) CudnnConvolutionTranspose(weight *Tensor, padding []int64, out
 		cdeterministic = int32(1)
 	}
 	lib.AtgCudnnConvolutionTranspose(ptr, ts.ctensor, weight.ctensor, padding, len(padding), outputPadding, len(outputPadding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolutionTranspose", ts, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5773,10 +5773,10 @@ func (ts *Tensor) CudnnConvolutionTranspose1(weight *Tensor, bias *Tensor, paddi
 		cdeterministic = int32(1)
 	}
 	lib.AtgCudnnConvolutionTranspose1(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), outputPadding, len(outputPadding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolutionTranspose1", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5800,10 +5800,10 @@ func (ts *Tensor) CudnnConvolutionTranspose2(weight *Tensor, padding []int64, ou
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionTranspose2(ptr, ts.ctensor, weight.ctensor, padding, len(padding), outputPadding, len(outputPadding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolutionTranspose2", ts, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5824,10 +5824,10 @@ func CudnnConvolutionTransposeBackwardInput(gradOutput *Tensor, weight *Tensor,
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionTransposeBackwardInput(ptr, gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolutionTransposeBackwardInput", gradOutput, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5851,10 +5851,10 @@ func (ts *Tensor) CudnnConvolutionTransposeBackwardWeight(weightSize []int64, gr
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionTransposeBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnConvolutionTransposeBackwardWeight", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5866,10 +5866,10 @@ func (ts *Tensor) CudnnGridSampler(grid *Tensor, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCudnnGridSampler(ptr, ts.ctensor, grid.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CudnnGridSampler", ts, grid); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5878,10 +5878,10 @@ func CummaxminBackward(grad *Tensor, input *Tensor, indices *Tensor, dim int64)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCummaxminBackward(ptr, grad.ctensor, input.ctensor, indices.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CummaxminBackward", grad, input, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5893,10 +5893,10 @@ func (ts *Tensor) Cumprod(dim int64, dtype gotch.DType, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumprod(ptr, ts.ctensor, dim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cumprod", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5905,10 +5905,10 @@ func CumprodBackward(grad *Tensor, input *Tensor, dim int64) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumprodBackward(ptr, grad.ctensor, input.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CumprodBackward", grad, input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5920,10 +5920,10 @@ func (ts *Tensor) CumprodOut(out *Tensor, dim int64, dtype gotch.DType, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumprodOut(ptr, out.ctensor, ts.ctensor, dim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CumprodOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5935,10 +5935,10 @@ func (ts *Tensor) Cumsum(dim int64, dtype gotch.DType, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumsum(ptr, ts.ctensor, dim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Cumsum", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5950,10 +5950,10 @@ func (ts *Tensor) CumsumOut(out *Tensor, dim int64, dtype gotch.DType, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumsumOut(ptr, out.ctensor, ts.ctensor, dim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("CumsumOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5965,10 +5965,10 @@ func (ts *Tensor) Data(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgData(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Data", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5980,10 +5980,10 @@ func (ts *Tensor) Deg2rad(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDeg2rad(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Deg2rad", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5992,7 +5992,7 @@ func (ts *Tensor) Deg2rad_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDeg2rad_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Deg2rad_", ts); err != nil {
 		return err
 	}
 
@@ -6006,10 +6006,10 @@ func (ts *Tensor) Deg2radOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDeg2radOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Deg2radOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6021,10 +6021,10 @@ func (ts *Tensor) Dequantize(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDequantize(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Dequantize", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6036,10 +6036,10 @@ func (ts *Tensor) Det(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDet(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Det", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6051,10 +6051,10 @@ func (ts *Tensor) Detach(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDetach(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Detach", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6063,7 +6063,7 @@ func (ts *Tensor) Detach_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDetach_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Detach_", ts); err != nil {
 		return err
 	}
 
@@ -6077,10 +6077,10 @@ func (ts *Tensor) Diag(diagonal int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiag(ptr, ts.ctensor, diagonal)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Diag", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6089,10 +6089,10 @@ func DiagBackward(grad *Tensor, inputSizes []int64, diagonal int64) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagBackward(ptr, grad.ctensor, inputSizes, len(inputSizes), diagonal)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("DiagBackward", grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6104,10 +6104,10 @@ func (ts *Tensor) DiagEmbed(offset int64, dim1 int64, dim2 int64, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagEmbed(ptr, ts.ctensor, offset, dim1, dim2)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("DiagEmbed", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6119,10 +6119,10 @@ func (ts *Tensor) DiagOut(out *Tensor, diagonal int64, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagOut(ptr, out.ctensor, ts.ctensor, diagonal)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("DiagOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6134,10 +6134,10 @@ func (ts *Tensor) Diagflat(offset int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagflat(ptr, ts.ctensor, offset)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Diagflat", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6149,10 +6149,10 @@ func (ts *Tensor) Diagonal(offset int64, dim1 int64, dim2 int64, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagonal(ptr, ts.ctensor, offset, dim1, dim2)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Diagonal", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6161,10 +6161,10 @@ func DiagonalBackward(grad *Tensor, inputSizes []int64, offset int64, dim1 int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagonalBackward(ptr, grad.ctensor, inputSizes, len(inputSizes), offset, dim1, dim2)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("DiagonalBackward", grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6176,10 +6176,10 @@ func (ts *Tensor) Digamma(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDigamma(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Digamma", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6188,7 +6188,7 @@ func (ts *Tensor) Digamma_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDigamma_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Digamma_", ts); err != nil {
 		return err
 	}
 
@@ -6202,10 +6202,10 @@ func (ts *Tensor) DigammaOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDigammaOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("DigammaOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6217,10 +6217,10 @@ func (ts *Tensor) Dist(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDist(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Dist", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6232,10 +6232,10 @@ func (ts *Tensor) Div(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiv(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Div", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6247,10 +6247,10 @@ func (ts *Tensor) Div1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiv1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Div1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6259,7 +6259,7 @@ func (ts *Tensor) Div_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiv_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Div_", ts, other); err != nil {
 		return err
 	}
 
@@ -6270,7 +6270,7 @@ func (ts *Tensor) Div1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiv1_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Div1_", ts); err != nil {
 		return err
 	}
 
@@ -6284,10 +6284,10 @@ func (ts *Tensor) DivOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("DivOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6299,10 +6299,10 @@ func (ts *Tensor) Divide(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivide(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Divide", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6314,10 +6314,10 @@ func (ts *Tensor) Divide1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivide1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Divide1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6326,7 +6326,7 @@ func (ts *Tensor) Divide_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivide_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Divide_", ts, other); err != nil {
 		return err
 	}
 
@@ -6337,7 +6337,7 @@ func (ts *Tensor) Divide1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivide1_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Divide1_", ts); err != nil {
 		return err
 	}
 
@@ -6351,10 +6351,10 @@ func (ts *Tensor) DivideOut(out *Tensor, other *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivideOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("DivideOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6366,10 +6366,10 @@ func (ts *Tensor) Dot(tensor *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDot(ptr, ts.ctensor, tensor.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Dot", ts, tensor); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6381,10 +6381,10 @@ func (ts *Tensor) DotOut(out *Tensor, tensor *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDotOut(ptr, out.ctensor, ts.ctensor, tensor.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("DotOut", ts, out, tensor); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6397,10 +6397,10 @@ func Dropout(input *Tensor, p float64, train bool) (retVal *Tensor, err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgDropout(ptr, input.ctensor, p, ctrain)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Dropout", input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6413,7 +6413,7 @@ func (ts *Tensor) Dropout_(p float64, train bool) (err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgDropout_(ptr, ts.ctensor, p, ctrain)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Dropout_", ts); err != nil {
 		return err
 	}
 
@@ -6428,10 +6428,10 @@ func Dstack(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgDstack(ptr, ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Dstack"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6444,10 +6444,10 @@ func DstackOut(out *Tensor, tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgDstackOut(ptr, out.ctensor, ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("DstackOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6460,10 +6460,10 @@ func Einsum(equation string, tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgEinsum(ptr, equation, ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Einsum"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6475,10 +6475,10 @@ func (ts *Tensor) Elu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgElu(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Elu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6487,7 +6487,7 @@ func (ts *Tensor) Elu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgElu_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Elu_", ts); err != nil {
 		return err
 	}
 
@@ -6498,10 +6498,10 @@ func EluBackward(gradOutput *Tensor, alpha *Scalar, scale *Scalar, inputScale *S
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEluBackward(ptr, gradOutput.ctensor, alpha.cscalar, scale.cscalar, inputScale.cscalar, output.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EluBackward", gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6510,10 +6510,10 @@ func EluBackwardOut(gradInput *Tensor, gradOutput *Tensor, alpha *Scalar, scale
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEluBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, alpha.cscalar, scale.cscalar, inputScale.cscalar, output.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EluBackwardOut", gradInput, gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6525,10 +6525,10 @@ func (ts *Tensor) EluOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEluOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EluOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6545,10 +6545,10 @@ func Embedding(weight *Tensor, indices *Tensor, paddingIdx int64, scaleGradByFre
 		csparse = int32(1)
 	}
 	lib.AtgEmbedding(ptr, weight.ctensor, indices.ctensor, paddingIdx, cscaleGradByFreq, csparse)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Embedding", weight, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6565,10 +6565,10 @@ func EmbeddingBackward(grad *Tensor, indices *Tensor, numWeights int64, paddingI
 		csparse = int32(1)
 	}
 	lib.AtgEmbeddingBackward(ptr, grad.ctensor, indices.ctensor, numWeights, paddingIdx, cscaleGradByFreq, csparse)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EmbeddingBackward", grad, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6581,10 +6581,10 @@ func EmbeddingDenseBackward(gradOutput *Tensor, indices *Tensor, numWeights int6
 		cscaleGradByFreq = int32(1)
 	}
 	lib.AtgEmbeddingDenseBackward(ptr, gradOutput.ctensor, indices.ctensor, numWeights, paddingIdx, cscaleGradByFreq)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EmbeddingDenseBackward", gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6593,7 +6593,7 @@ func (ts *Tensor) EmbeddingRenorm_(indices *Tensor, maxNorm float64, normType fl
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmbeddingRenorm_(ptr, ts.ctensor, indices.ctensor, maxNorm, normType)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EmbeddingRenorm_", ts, indices); err != nil {
 		return err
 	}
 
@@ -6608,10 +6608,10 @@ func EmbeddingSparseBackward(grad *Tensor, indices *Tensor, numWeights int64, pa
 		cscaleGradByFreq = int32(1)
 	}
 	lib.AtgEmbeddingSparseBackward(ptr, grad.ctensor, indices.ctensor, numWeights, paddingIdx, cscaleGradByFreq)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EmbeddingSparseBackward", grad, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6620,10 +6620,10 @@ func Empty(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmpty(ptr, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Empty"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6635,10 +6635,10 @@ func (ts *Tensor) EmptyLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmptyLike(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EmptyLike", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6647,10 +6647,10 @@ func EmptyMeta(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmptyMeta(ptr, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EmptyMeta"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6659,10 +6659,10 @@ func EmptyOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmptyOut(ptr, out.ctensor, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EmptyOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6671,10 +6671,10 @@ func EmptyQuantized(size []int64, qtensor *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmptyQuantized(ptr, size, len(size), qtensor.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EmptyQuantized", qtensor); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6683,10 +6683,10 @@ func EmptyStrided(size []int64, stride []int64, optionsKind gotch.DType, options
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmptyStrided(ptr, size, len(size), stride, len(stride), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EmptyStrided"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6698,10 +6698,10 @@ func (ts *Tensor) Eq(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEq(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Eq", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6713,10 +6713,10 @@ func (ts *Tensor) Eq1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEq1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Eq1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6725,7 +6725,7 @@ func (ts *Tensor) Eq_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEq_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Eq_", ts); err != nil {
 		return err
 	}
 
@@ -6736,7 +6736,7 @@ func (ts *Tensor) Eq1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEq1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Eq1_", ts, other); err != nil {
 		return err
 	}
 
@@ -6750,10 +6750,10 @@ func (ts *Tensor) EqOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEqOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EqOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6765,10 +6765,10 @@ func (ts *Tensor) EqOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEqOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EqOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6780,10 +6780,10 @@ func (ts *Tensor) Erf(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErf(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Erf", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6792,7 +6792,7 @@ func (ts *Tensor) Erf_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErf_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Erf_", ts); err != nil {
 		return err
 	}
 
@@ -6806,10 +6806,10 @@ func (ts *Tensor) ErfOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ErfOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6821,10 +6821,10 @@ func (ts *Tensor) Erfc(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfc(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Erfc", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6833,7 +6833,7 @@ func (ts *Tensor) Erfc_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfc_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Erfc_", ts); err != nil {
 		return err
 	}
 
@@ -6847,10 +6847,10 @@ func (ts *Tensor) ErfcOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfcOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ErfcOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6862,10 +6862,10 @@ func (ts *Tensor) Erfinv(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfinv(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Erfinv", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6874,7 +6874,7 @@ func (ts *Tensor) Erfinv_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfinv_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Erfinv_", ts); err != nil {
 		return err
 	}
 
@@ -6888,10 +6888,10 @@ func (ts *Tensor) ErfinvOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfinvOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ErfinvOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6903,10 +6903,10 @@ func (ts *Tensor) Exp(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Exp", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6918,10 +6918,10 @@ func (ts *Tensor) Exp2(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp2(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Exp2", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6930,7 +6930,7 @@ func (ts *Tensor) Exp2_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp2_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Exp2_", ts); err != nil {
 		return err
 	}
 
@@ -6944,10 +6944,10 @@ func (ts *Tensor) Exp2Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp2Out(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Exp2Out", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6956,7 +6956,7 @@ func (ts *Tensor) Exp_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Exp_", ts); err != nil {
 		return err
 	}
 
@@ -6970,10 +6970,10 @@ func (ts *Tensor) ExpOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ExpOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6989,10 +6989,10 @@ func (ts *Tensor) Expand(size []int64, implicit bool, del bool) (retVal *Tensor,
 		cimplicit = int32(1)
 	}
 	lib.AtgExpand(ptr, ts.ctensor, size, len(size), cimplicit)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Expand", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7004,10 +7004,10 @@ func (ts *Tensor) ExpandAs(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpandAs(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ExpandAs", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7019,10 +7019,10 @@ func (ts *Tensor) Expm1(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpm1(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Expm1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7031,7 +7031,7 @@ func (ts *Tensor) Expm1_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpm1_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Expm1_", ts); err != nil {
 		return err
 	}
 
@@ -7045,10 +7045,10 @@ func (ts *Tensor) Expm1Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpm1Out(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Expm1Out", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7057,7 +7057,7 @@ func (ts *Tensor) Exponential_(lambd float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExponential_(ptr, ts.ctensor, lambd)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Exponential_", ts); err != nil {
 		return err
 	}
 
@@ -7068,10 +7068,10 @@ func Eye(n int64, optionsKind gotch.DType, optionsDevice gotch.Device) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEye(ptr, n, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Eye"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7080,10 +7080,10 @@ func Eye1(n int64, m int64, optionsKind gotch.DType, optionsDevice gotch.Device)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEye1(ptr, n, m, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Eye1"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7092,10 +7092,10 @@ func EyeOut(out *Tensor, n int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEyeOut(ptr, out.ctensor, n)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EyeOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7104,10 +7104,10 @@ func EyeOut1(out *Tensor, n int64, m int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEyeOut1(ptr, out.ctensor, n, m)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("EyeOut1", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7119,10 +7119,10 @@ func (ts *Tensor) FakeQuantizePerChannelAffine(scale *Tensor, zeroPoint *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFakeQuantizePerChannelAffine(ptr, ts.ctensor, scale.ctensor, zeroPoint.ctensor, axis, quantMin, quantMax)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FakeQuantizePerChannelAffine", ts, scale, zeroPoint); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7134,10 +7134,10 @@ func (ts *Tensor) FakeQuantizePerChannelAffineBackward(grad *Tensor, scale *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFakeQuantizePerChannelAffineBackward(ptr, grad.ctensor, ts.ctensor, scale.ctensor, zeroPoint.ctensor, axis, quantMin, quantMax)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FakeQuantizePerChannelAffineBackward", ts, grad, scale, zeroPoint); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7149,10 +7149,10 @@ func (ts *Tensor) FakeQuantizePerTensorAffine(scale float64, zeroPoint int64, qu
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFakeQuantizePerTensorAffine(ptr, ts.ctensor, scale, zeroPoint, quantMin, quantMax)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FakeQuantizePerTensorAffine", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7164,10 +7164,10 @@ func (ts *Tensor) FakeQuantizePerTensorAffineBackward(grad *Tensor, scale float6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFakeQuantizePerTensorAffineBackward(ptr, grad.ctensor, ts.ctensor, scale, zeroPoint, quantMin, quantMax)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FakeQuantizePerTensorAffineBackward", ts, grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7176,10 +7176,10 @@ func FbgemmLinearFp16Weight(input *Tensor, packedWeight *Tensor, bias *Tensor) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmLinearFp16Weight(ptr, input.ctensor, packedWeight.ctensor, bias.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FbgemmLinearFp16Weight", input, packedWeight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7188,10 +7188,10 @@ func FbgemmLinearFp16WeightFp32Activation(input *Tensor, packedWeight *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmLinearFp16WeightFp32Activation(ptr, input.ctensor, packedWeight.ctensor, bias.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FbgemmLinearFp16WeightFp32Activation", input, packedWeight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7200,10 +7200,10 @@ func FbgemmLinearInt8Weight(input *Tensor, weight *Tensor, packed *Tensor, colOf
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmLinearInt8Weight(ptr, input.ctensor, weight.ctensor, packed.ctensor, colOffsets.ctensor, weightScale.cscalar, weightZeroPoint.cscalar, bias.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FbgemmLinearInt8Weight", input, weight, packed, colOffsets, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7212,10 +7212,10 @@ func FbgemmLinearInt8WeightFp32Activation(input *Tensor, weight *Tensor, packed
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmLinearInt8WeightFp32Activation(ptr, input.ctensor, weight.ctensor, packed.ctensor, colOffsets.ctensor, weightScale.cscalar, weightZeroPoint.cscalar, bias.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FbgemmLinearInt8WeightFp32Activation", input, weight, packed, colOffsets, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7224,10 +7224,10 @@ func FbgemmPackGemmMatrixFp16(input *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmPackGemmMatrixFp16(ptr, input.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FbgemmPackGemmMatrixFp16", input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7236,10 +7236,10 @@ func FbgemmPackQuantizedMatrix(input *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmPackQuantizedMatrix(ptr, input.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FbgemmPackQuantizedMatrix", input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7248,10 +7248,10 @@ func FbgemmPackQuantizedMatrix1(input *Tensor, k int64, n int64) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmPackQuantizedMatrix1(ptr, input.ctensor, k, n)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FbgemmPackQuantizedMatrix1", input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7264,10 +7264,10 @@ func FeatureAlphaDropout(input *Tensor, p float64, train bool) (retVal *Tensor,
 		ctrain = int32(1)
 	}
 	lib.AtgFeatureAlphaDropout(ptr, input.ctensor, p, ctrain)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FeatureAlphaDropout", input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7280,7 +7280,7 @@ func (ts *Tensor) FeatureAlphaDropout_(p float64, train bool) (err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgFeatureAlphaDropout_(ptr, ts.ctensor, p, ctrain)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FeatureAlphaDropout_", ts); err != nil {
 		return err
 	}
 
@@ -7295,10 +7295,10 @@ func FeatureDropout(input *Tensor, p float64, train bool) (retVal *Tensor, err e
 		ctrain = int32(1)
 	}
 	lib.AtgFeatureDropout(ptr, input.ctensor, p, ctrain)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FeatureDropout", input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7311,7 +7311,7 @@ func (ts *Tensor) FeatureDropout_(p float64, train bool) (err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgFeatureDropout_(ptr, ts.ctensor, p, ctrain)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FeatureDropout_", ts); err != nil {
 		return err
 	}
 
@@ -7329,10 +7329,10 @@ func (ts *Tensor) Fft(signalNdim int64, normalized bool, del bool) (retVal *Tens
 		cnormalized = int32(1)
 	}
 	lib.AtgFft(ptr, ts.ctensor, signalNdim, cnormalized)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7350,10 +7350,10 @@ func (ts *Tensor) FftFft(n []int64, dim int64, norm string, del bool) (retVal *T
 		cnNull = 0
 	}
 	lib.AtgFftFft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftFft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7365,10 +7365,10 @@ func (ts *Tensor) FftFftn(s []int64, dim []int64, norm string, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFftFftn(ptr, ts.ctensor, s, len(s), dim, len(dim), norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftFftn", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7386,10 +7386,10 @@ func (ts *Tensor) FftHfft(n []int64, dim int64, norm string, del bool) (retVal *
 		cnNull = 0
 	}
 	lib.AtgFftHfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftHfft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7407,10 +7407,10 @@ func (ts *Tensor) FftIfft(n []int64, dim int64, norm string, del bool) (retVal *
 		cnNull = 0
 	}
 	lib.AtgFftIfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftIfft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7422,10 +7422,10 @@ func (ts *Tensor) FftIfftn(s []int64, dim []int64, norm string, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFftIfftn(ptr, ts.ctensor, s, len(s), dim, len(dim), norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftIfftn", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7443,10 +7443,10 @@ func (ts *Tensor) FftIhfft(n []int64, dim int64, norm string, del bool) (retVal
 		cnNull = 0
 	}
 	lib.AtgFftIhfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftIhfft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7464,10 +7464,10 @@ func (ts *Tensor) FftIrfft(n []int64, dim int64, norm string, del bool) (retVal
 		cnNull = 0
 	}
 	lib.AtgFftIrfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftIrfft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7479,10 +7479,10 @@ func (ts *Tensor) FftIrfftn(s []int64, dim []int64, norm string, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFftIrfftn(ptr, ts.ctensor, s, len(s), dim, len(dim), norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftIrfftn", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7500,10 +7500,10 @@ func (ts *Tensor) FftRfft(n []int64, dim int64, norm string, del bool) (retVal *
 		cnNull = 0
 	}
 	lib.AtgFftRfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftRfft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7515,10 +7515,10 @@ func (ts *Tensor) FftRfftn(s []int64, dim []int64, norm string, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFftRfftn(ptr, ts.ctensor, s, len(s), dim, len(dim), norm)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FftRfftn", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7527,7 +7527,7 @@ func (ts *Tensor) Fill_(value *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFill_(ptr, ts.ctensor, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fill_", ts); err != nil {
 		return err
 	}
 
@@ -7538,7 +7538,7 @@ func (ts *Tensor) Fill1_(value *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFill1_(ptr, ts.ctensor, value.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fill1_", ts, value); err != nil {
 		return err
 	}
 
@@ -7553,7 +7553,7 @@ func (ts *Tensor) FillDiagonal_(fillValue *Scalar, wrap bool) (err error) {
 		cwrap = int32(1)
 	}
 	lib.AtgFillDiagonal_(ptr, ts.ctensor, fillValue.cscalar, cwrap)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FillDiagonal_", ts); err != nil {
 		return err
 	}
 
@@ -7567,10 +7567,10 @@ func (ts *Tensor) Fix(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFix(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fix", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7579,7 +7579,7 @@ func (ts *Tensor) Fix_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFix_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fix_", ts); err != nil {
 		return err
 	}
 
@@ -7593,10 +7593,10 @@ func (ts *Tensor) FixOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFixOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FixOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7608,10 +7608,10 @@ func (ts *Tensor) Flatten(startDim int64, endDim int64, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFlatten(ptr, ts.ctensor, startDim, endDim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Flatten", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7623,10 +7623,10 @@ func (ts *Tensor) Flip(dims []int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFlip(ptr, ts.ctensor, dims, len(dims))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Flip", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7638,10 +7638,10 @@ func (ts *Tensor) Fliplr(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFliplr(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fliplr", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7653,10 +7653,10 @@ func (ts *Tensor) Flipud(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFlipud(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Flipud", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7668,10 +7668,10 @@ func (ts *Tensor) Floor(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloor(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Floor", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7680,7 +7680,7 @@ func (ts *Tensor) Floor_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloor_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Floor_", ts); err != nil {
 		return err
 	}
 
@@ -7694,10 +7694,10 @@ func (ts *Tensor) FloorDivide(other *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivide(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FloorDivide", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7709,10 +7709,10 @@ func (ts *Tensor) FloorDivide1(other *Scalar, del bool) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivide1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FloorDivide1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7721,7 +7721,7 @@ func (ts *Tensor) FloorDivide_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivide_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FloorDivide_", ts, other); err != nil {
 		return err
 	}
 
@@ -7732,7 +7732,7 @@ func (ts *Tensor) FloorDivide1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivide1_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FloorDivide1_", ts); err != nil {
 		return err
 	}
 
@@ -7746,10 +7746,10 @@ func (ts *Tensor) FloorDivideOut(out *Tensor, other *Tensor, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivideOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FloorDivideOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7761,10 +7761,10 @@ func (ts *Tensor) FloorOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FloorOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7776,10 +7776,10 @@ func (ts *Tensor) Fmod(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmod(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fmod", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7791,10 +7791,10 @@ func (ts *Tensor) Fmod1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmod1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fmod1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7803,7 +7803,7 @@ func (ts *Tensor) Fmod_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmod_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fmod_", ts); err != nil {
 		return err
 	}
 
@@ -7814,7 +7814,7 @@ func (ts *Tensor) Fmod1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmod1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Fmod1_", ts, other); err != nil {
 		return err
 	}
 
@@ -7828,10 +7828,10 @@ func (ts *Tensor) FmodOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmodOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FmodOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7843,10 +7843,10 @@ func (ts *Tensor) FmodOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmodOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FmodOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7858,10 +7858,10 @@ func (ts *Tensor) Frac(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFrac(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Frac", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7870,7 +7870,7 @@ func (ts *Tensor) Frac_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFrac_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Frac_", ts); err != nil {
 		return err
 	}
 
@@ -7884,10 +7884,10 @@ func (ts *Tensor) FracOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFracOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FracOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7899,10 +7899,10 @@ func (ts *Tensor) FractionalMaxPool2dBackward(gradOutput *Tensor, kernelSize []i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFractionalMaxPool2dBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), outputSize, len(outputSize), indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FractionalMaxPool2dBackward", ts, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7914,10 +7914,10 @@ func (ts *Tensor) FractionalMaxPool2dBackwardOut(gradInput *Tensor, gradOutput *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFractionalMaxPool2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), outputSize, len(outputSize), indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FractionalMaxPool2dBackwardOut", ts, gradInput, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7929,10 +7929,10 @@ func (ts *Tensor) FractionalMaxPool3dBackward(gradOutput *Tensor, kernelSize []i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFractionalMaxPool3dBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), outputSize, len(outputSize), indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FractionalMaxPool3dBackward", ts, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7944,10 +7944,10 @@ func (ts *Tensor) FractionalMaxPool3dBackwardOut(gradInput *Tensor, gradOutput *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFractionalMaxPool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), outputSize, len(outputSize), indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FractionalMaxPool3dBackwardOut", ts, gradInput, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7959,10 +7959,10 @@ func (ts *Tensor) FrobeniusNorm(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFrobeniusNorm(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FrobeniusNorm", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7978,10 +7978,10 @@ func (ts *Tensor) FrobeniusNorm1(dim []int64, keepdim bool, del bool) (retVal *T
 		ckeepdim = int32(1)
 	}
 	lib.AtgFrobeniusNorm1(ptr, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FrobeniusNorm1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7997,10 +7997,10 @@ func (ts *Tensor) FrobeniusNormOut(out *Tensor, dim []int64, keepdim bool, del b
 		ckeepdim = int32(1)
 	}
 	lib.AtgFrobeniusNormOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FrobeniusNormOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8019,10 +8019,10 @@ func FromFile(filename string, shared bool, size []int64, optionsKind gotch.DTyp
 		csizeNull = 0
 	}
 	lib.AtgFromFile(ptr, filename, cshared, csizeVal, csizeNull, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FromFile"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8031,10 +8031,10 @@ func Full(size []int64, fillValue *Scalar, optionsKind gotch.DType, optionsDevic
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFull(ptr, size, len(size), fillValue.cscalar, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Full"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8046,10 +8046,10 @@ func (ts *Tensor) FullLike(fillValue *Scalar, del bool) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFullLike(ptr, ts.ctensor, fillValue.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FullLike", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8058,10 +8058,10 @@ func FullOut(out *Tensor, size []int64, fillValue *Scalar) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFullOut(ptr, out.ctensor, size, len(size), fillValue.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("FullOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8077,10 +8077,10 @@ func (ts *Tensor) Gather(dim int64, index *Tensor, sparseGrad bool, del bool) (r
 		csparseGrad = int32(1)
 	}
 	lib.AtgGather(ptr, ts.ctensor, dim, index.ctensor, csparseGrad)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Gather", ts, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8096,10 +8096,10 @@ func (ts *Tensor) GatherBackward(grad *Tensor, dim int64, index *Tensor, sparseG
 		csparseGrad = int32(1)
 	}
 	lib.AtgGatherBackward(ptr, grad.ctensor, ts.ctensor, dim, index.ctensor, csparseGrad)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GatherBackward", ts, grad, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8115,10 +8115,10 @@ func (ts *Tensor) GatherOut(out *Tensor, dim int64, index *Tensor, sparseGrad bo
 		csparseGrad = int32(1)
 	}
 	lib.AtgGatherOut(ptr, out.ctensor, ts.ctensor, dim, index.ctensor, csparseGrad)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GatherOut", ts, out, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8130,10 +8130,10 @@ func (ts *Tensor) Gcd(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGcd(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Gcd", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8142,7 +8142,7 @@ func (ts *Tensor) Gcd_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGcd_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Gcd_", ts, other); err != nil {
 		return err
 	}
 
@@ -8156,10 +8156,10 @@ func (ts *Tensor) GcdOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGcdOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GcdOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8171,10 +8171,10 @@ func (ts *Tensor) Ge(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGe(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ge", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8186,10 +8186,10 @@ func (ts *Tensor) Ge1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGe1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ge1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8198,7 +8198,7 @@ func (ts *Tensor) Ge_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGe_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ge_", ts); err != nil {
 		return err
 	}
 
@@ -8209,7 +8209,7 @@ func (ts *Tensor) Ge1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGe1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ge1_", ts, other); err != nil {
 		return err
 	}
 
@@ -8223,10 +8223,10 @@ func (ts *Tensor) GeOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGeOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GeOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8238,10 +8238,10 @@ func (ts *Tensor) GeOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGeOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GeOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8253,10 +8253,10 @@ func (ts *Tensor) Gelu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGelu(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Gelu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8268,10 +8268,10 @@ func (ts *Tensor) GeluBackward(grad *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGeluBackward(ptr, grad.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GeluBackward", ts, grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8280,7 +8280,7 @@ func (ts *Tensor) Geometric_(p float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGeometric_(ptr, ts.ctensor, p)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Geometric_", ts); err != nil {
 		return err
 	}
 
@@ -8294,10 +8294,10 @@ func (ts *Tensor) Ger(vec2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGer(ptr, ts.ctensor, vec2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ger", ts, vec2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8309,10 +8309,10 @@ func (ts *Tensor) GerOut(out *Tensor, vec2 *Tensor, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGerOut(ptr, out.ctensor, ts.ctensor, vec2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GerOut", ts, out, vec2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8324,10 +8324,10 @@ func (ts *Tensor) Glu(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGlu(ptr, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Glu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8339,10 +8339,10 @@ func (ts *Tensor) GluBackward(gradOutput *Tensor, dim int64, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGluBackward(ptr, gradOutput.ctensor, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GluBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8354,10 +8354,10 @@ func (ts *Tensor) GluBackwardOut(gradInput *Tensor, gradOutput *Tensor, dim int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGluBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GluBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8369,10 +8369,10 @@ func (ts *Tensor) GluOut(out *Tensor, dim int64, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGluOut(ptr, out.ctensor, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GluOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8384,10 +8384,10 @@ func (ts *Tensor) Grad(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGrad(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Grad", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8399,10 +8399,10 @@ func (ts *Tensor) Greater(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreater(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Greater", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8414,10 +8414,10 @@ func (ts *Tensor) Greater1(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreater1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Greater1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8426,7 +8426,7 @@ func (ts *Tensor) Greater_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreater_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Greater_", ts); err != nil {
 		return err
 	}
 
@@ -8437,7 +8437,7 @@ func (ts *Tensor) Greater1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreater1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Greater1_", ts, other); err != nil {
 		return err
 	}
 
@@ -8451,10 +8451,10 @@ func (ts *Tensor) GreaterEqual(other *Scalar, del bool) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqual(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GreaterEqual", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8466,10 +8466,10 @@ func (ts *Tensor) GreaterEqual1(other *Tensor, del bool) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqual1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GreaterEqual1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8478,7 +8478,7 @@ func (ts *Tensor) GreaterEqual_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqual_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GreaterEqual_", ts); err != nil {
 		return err
 	}
 
@@ -8489,7 +8489,7 @@ func (ts *Tensor) GreaterEqual1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqual1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GreaterEqual1_", ts, other); err != nil {
 		return err
 	}
 
@@ -8503,10 +8503,10 @@ func (ts *Tensor) GreaterEqualOut(out *Tensor, other *Scalar, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqualOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GreaterEqualOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8518,10 +8518,10 @@ func (ts *Tensor) GreaterEqualOut1(out *Tensor, other *Tensor, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqualOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GreaterEqualOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8533,10 +8533,10 @@ func (ts *Tensor) GreaterOut(out *Tensor, other *Scalar, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GreaterOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8548,10 +8548,10 @@ func (ts *Tensor) GreaterOut1(out *Tensor, other *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GreaterOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8564,10 +8564,10 @@ func GridSampler(input *Tensor, grid *Tensor, interpolationMode int64, paddingMo
 		calignCorners = int32(1)
 	}
 	lib.AtgGridSampler(ptr, input.ctensor, grid.ctensor, interpolationMode, paddingMode, calignCorners)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GridSampler", input, grid); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8580,10 +8580,10 @@ func GridSampler2d(input *Tensor, grid *Tensor, interpolationMode int64, padding
 		calignCorners = int32(1)
 	}
 	lib.AtgGridSampler2d(ptr, input.ctensor, grid.ctensor, interpolationMode, paddingMode, calignCorners)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GridSampler2d", input, grid); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8596,10 +8596,10 @@ func GridSampler3d(input *Tensor, grid *Tensor, interpolationMode int64, padding
 		calignCorners = int32(1)
 	}
 	lib.AtgGridSampler3d(ptr, input.ctensor, grid.ctensor, interpolationMode, paddingMode, calignCorners)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GridSampler3d", input, grid); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8612,10 +8612,10 @@ func GroupNorm(input *Tensor, numGroups int64, weight *Tensor, bias *Tensor, eps
 		ccudnnEnabled = int32(1)
 	}
 	lib.AtgGroupNorm(ptr, input.ctensor, numGroups, weight.ctensor, bias.ctensor, eps, ccudnnEnabled)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GroupNorm", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8624,10 +8624,10 @@ func GruCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, bIh *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGruCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GruCell", input, hx, wIh, wHh, bIh, bHh); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8639,10 +8639,10 @@ func (ts *Tensor) Gt(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGt(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Gt", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8654,10 +8654,10 @@ func (ts *Tensor) Gt1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGt1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Gt1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8666,7 +8666,7 @@ func (ts *Tensor) Gt_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGt_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Gt_", ts); err != nil {
 		return err
 	}
 
@@ -8677,7 +8677,7 @@ func (ts *Tensor) Gt1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGt1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Gt1_", ts, other); err != nil {
 		return err
 	}
 
@@ -8691,10 +8691,10 @@ func (ts *Tensor) GtOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGtOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GtOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8706,10 +8706,10 @@ func (ts *Tensor) GtOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGtOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("GtOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8718,10 +8718,10 @@ func HammingWindow(windowLength int64, optionsKind gotch.DType, optionsDevice go
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHammingWindow(ptr, windowLength, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HammingWindow"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8734,10 +8734,10 @@ func HammingWindow1(windowLength int64, periodic bool, optionsKind gotch.DType,
 		cperiodic = int32(1)
 	}
 	lib.AtgHammingWindow1(ptr, windowLength, cperiodic, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HammingWindow1"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8750,10 +8750,10 @@ func HammingWindow2(windowLength int64, periodic bool, alpha float64, optionsKin
 		cperiodic = int32(1)
 	}
 	lib.AtgHammingWindow2(ptr, windowLength, cperiodic, alpha, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HammingWindow2"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8766,10 +8766,10 @@ func HammingWindow3(windowLength int64, periodic bool, alpha float64, beta float
 		cperiodic = int32(1)
 	}
 	lib.AtgHammingWindow3(ptr, windowLength, cperiodic, alpha, beta, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HammingWindow3"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8778,10 +8778,10 @@ func HannWindow(windowLength int64, optionsKind gotch.DType, optionsDevice gotch
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHannWindow(ptr, windowLength, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HannWindow"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8794,10 +8794,10 @@ func HannWindow1(windowLength int64, periodic bool, optionsKind gotch.DType, opt
 		cperiodic = int32(1)
 	}
 	lib.AtgHannWindow1(ptr, windowLength, cperiodic, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HannWindow1"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8809,10 +8809,10 @@ func (ts *Tensor) Hardshrink(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardshrink(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hardshrink", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8824,10 +8824,10 @@ func (ts *Tensor) HardshrinkBackward(gradOut *Tensor, lambd *Scalar, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardshrinkBackward(ptr, gradOut.ctensor, ts.ctensor, lambd.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HardshrinkBackward", ts, gradOut); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8839,10 +8839,10 @@ func (ts *Tensor) Hardsigmoid(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardsigmoid(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hardsigmoid", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8851,7 +8851,7 @@ func (ts *Tensor) Hardsigmoid_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardsigmoid_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hardsigmoid_", ts); err != nil {
 		return err
 	}
 
@@ -8865,10 +8865,10 @@ func (ts *Tensor) HardsigmoidBackward(gradOutput *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardsigmoidBackward(ptr, gradOutput.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HardsigmoidBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8880,10 +8880,10 @@ func (ts *Tensor) HardsigmoidOut(out *Tensor, del bool) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardsigmoidOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HardsigmoidOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8895,10 +8895,10 @@ func (ts *Tensor) Hardswish(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardswish(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hardswish", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8907,7 +8907,7 @@ func (ts *Tensor) Hardswish_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardswish_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hardswish_", ts); err != nil {
 		return err
 	}
 
@@ -8921,10 +8921,10 @@ func (ts *Tensor) HardswishBackward(gradOutput *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardswishBackward(ptr, gradOutput.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HardswishBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8936,10 +8936,10 @@ func (ts *Tensor) HardswishOut(out *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardswishOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HardswishOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8951,10 +8951,10 @@ func (ts *Tensor) Hardtanh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hardtanh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8963,7 +8963,7 @@ func (ts *Tensor) Hardtanh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hardtanh_", ts); err != nil {
 		return err
 	}
 
@@ -8977,10 +8977,10 @@ func (ts *Tensor) HardtanhBackward(gradOutput *Tensor, minVal *Scalar, maxVal *S
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanhBackward(ptr, gradOutput.ctensor, ts.ctensor, minVal.cscalar, maxVal.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HardtanhBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8992,10 +8992,10 @@ func (ts *Tensor) HardtanhBackwardOut(gradInput *Tensor, gradOutput *Tensor, min
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanhBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, minVal.cscalar, maxVal.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HardtanhBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9007,10 +9007,10 @@ func (ts *Tensor) HardtanhOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanhOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HardtanhOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9022,10 +9022,10 @@ func (ts *Tensor) Heaviside(values *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHeaviside(ptr, ts.ctensor, values.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Heaviside", ts, values); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9034,7 +9034,7 @@ func (ts *Tensor) Heaviside_(values *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHeaviside_(ptr, ts.ctensor, values.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Heaviside_", ts, values); err != nil {
 		return err
 	}
 
@@ -9048,10 +9048,10 @@ func (ts *Tensor) HeavisideOut(out *Tensor, values *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHeavisideOut(ptr, out.ctensor, ts.ctensor, values.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HeavisideOut", ts, out, values); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9063,10 +9063,10 @@ func (ts *Tensor) HingeEmbeddingLoss(target *Tensor, margin float64, reduction i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHingeEmbeddingLoss(ptr, ts.ctensor, target.ctensor, margin, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HingeEmbeddingLoss", ts, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9078,10 +9078,10 @@ func (ts *Tensor) Histc(bins int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHistc(ptr, ts.ctensor, bins)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Histc", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9093,10 +9093,10 @@ func (ts *Tensor) HistcOut(out *Tensor, bins int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHistcOut(ptr, out.ctensor, ts.ctensor, bins)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HistcOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9105,10 +9105,10 @@ func Hspmm(mat1 *Tensor, mat2 *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHspmm(ptr, mat1.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hspmm", mat1, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9117,10 +9117,10 @@ func HspmmOut(out *Tensor, mat1 *Tensor, mat2 *Tensor) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHspmmOut(ptr, out.ctensor, mat1.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HspmmOut", out, mat1, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9133,10 +9133,10 @@ func Hstack(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgHstack(ptr, ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hstack"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9149,10 +9149,10 @@ func HstackOut(out *Tensor, tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgHstackOut(ptr, out.ctensor, ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HstackOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9164,10 +9164,10 @@ func (ts *Tensor) Hypot(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHypot(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hypot", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9176,7 +9176,7 @@ func (ts *Tensor) Hypot_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHypot_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Hypot_", ts, other); err != nil {
 		return err
 	}
 
@@ -9190,10 +9190,10 @@ func (ts *Tensor) HypotOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHypotOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("HypotOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9205,10 +9205,10 @@ func (ts *Tensor) I0(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgI0(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("I0", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9217,7 +9217,7 @@ func (ts *Tensor) I0_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgI0_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("I0_", ts); err != nil {
 		return err
 	}
 
@@ -9231,10 +9231,10 @@ func (ts *Tensor) I0Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgI0Out(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("I0Out", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9250,10 +9250,10 @@ func (ts *Tensor) Ifft(signalNdim int64, normalized bool, del bool) (retVal *Ten
 		cnormalized = int32(1)
 	}
 	lib.AtgIfft(ptr, ts.ctensor, signalNdim, cnormalized)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ifft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9265,10 +9265,10 @@ func (ts *Tensor) Im2col(kernelSize []int64, dilation []int64, padding []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIm2col(ptr, ts.ctensor, kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Im2col", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9277,10 +9277,10 @@ func Im2colBackward(gradOutput *Tensor, inputSize []int64, kernelSize []int64, d
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIm2colBackward(ptr, gradOutput.ctensor, inputSize, len(inputSize), kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Im2colBackward", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9289,10 +9289,10 @@ func Im2colBackwardOut(gradInput *Tensor, gradOutput *Tensor, inputSize []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIm2colBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, inputSize, len(inputSize), kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Im2colBackwardOut", gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9304,10 +9304,10 @@ func (ts *Tensor) Im2colOut(out *Tensor, kernelSize []int64, dilation []int64, p
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIm2colOut(ptr, out.ctensor, ts.ctensor, kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Im2colOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9319,10 +9319,10 @@ func (ts *Tensor) Imag(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgImag(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Imag", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9338,10 +9338,10 @@ func (ts *Tensor) Index(indices []Tensor, del bool) (retVal *Tensor, err error)
 		cindices = append(cindices, t.ctensor)
 	}
 	lib.AtgIndex(ptr, ts.ctensor, cindices, len(cindices))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Index", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9353,10 +9353,10 @@ func (ts *Tensor) IndexAdd(dim int64, index *Tensor, source *Tensor, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexAdd(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexAdd", ts, index, source); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9365,7 +9365,7 @@ func (ts *Tensor) IndexAdd_(dim int64, index *Tensor, source *Tensor) (err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexAdd_(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexAdd_", ts, index, source); err != nil {
 		return err
 	}
 
@@ -9379,10 +9379,10 @@ func (ts *Tensor) IndexCopy(dim int64, index *Tensor, source *Tensor, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexCopy(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexCopy", ts, index, source); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9391,7 +9391,7 @@ func (ts *Tensor) IndexCopy_(dim int64, index *Tensor, source *Tensor) (err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexCopy_(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexCopy_", ts, index, source); err != nil {
 		return err
 	}
 
@@ -9405,10 +9405,10 @@ func (ts *Tensor) IndexFill(dim int64, index *Tensor, value *Scalar, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexFill(ptr, ts.ctensor, dim, index.ctensor, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexFill", ts, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9420,10 +9420,10 @@ func (ts *Tensor) IndexFill1(dim int64, index *Tensor, value *Tensor, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexFill1(ptr, ts.ctensor, dim, index.ctensor, value.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexFill1", ts, index, value); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9432,7 +9432,7 @@ func (ts *Tensor) IndexFill_(dim int64, index *Tensor, value *Scalar) (err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexFill_(ptr, ts.ctensor, dim, index.ctensor, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexFill_", ts, index); err != nil {
 		return err
 	}
 
@@ -9443,7 +9443,7 @@ func (ts *Tensor) IndexFill1_(dim int64, index *Tensor, value *Tensor) (err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexFill1_(ptr, ts.ctensor, dim, index.ctensor, value.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexFill1_", ts, index, value); err != nil {
 		return err
 	}
 
@@ -9465,10 +9465,10 @@ func (ts *Tensor) IndexPut(indices []Tensor, values *Tensor, accumulate bool, de
 		caccumulate = int32(1)
 	}
 	lib.AtgIndexPut(ptr, ts.ctensor, cindices, len(cindices), values.ctensor, caccumulate)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexPut", ts, values); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9485,7 +9485,7 @@ func (ts *Tensor) IndexPut_(indices []Tensor, values *Tensor, accumulate bool) (
 		caccumulate = int32(1)
 	}
 	lib.AtgIndexPut_(ptr, ts.ctensor, cindices, len(cindices), values.ctensor, caccumulate)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexPut_", ts, values); err != nil {
 		return err
 	}
 
@@ -9499,10 +9499,10 @@ func (ts *Tensor) IndexSelect(dim int64, index *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexSelect(ptr, ts.ctensor, dim, index.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexSelect", ts, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9511,10 +9511,10 @@ func IndexSelectBackward(grad *Tensor, selfSizes []int64, dim int64, index *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexSelectBackward(ptr, grad.ctensor, selfSizes, len(selfSizes), dim, index.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexSelectBackward", grad, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9526,10 +9526,10 @@ func (ts *Tensor) IndexSelectOut(out *Tensor, dim int64, index *Tensor, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexSelectOut(ptr, out.ctensor, ts.ctensor, dim, index.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IndexSelectOut", ts, out, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9541,10 +9541,10 @@ func (ts *Tensor) Indices(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndices(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Indices", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9556,10 +9556,10 @@ func (ts *Tensor) InfinitelyDifferentiableGeluBackward(grad *Tensor, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgInfinitelyDifferentiableGeluBackward(ptr, grad.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("InfinitelyDifferentiableGeluBackward", ts, grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9576,10 +9576,10 @@ func InstanceNorm(input *Tensor, weight *Tensor, bias *Tensor, runningMean *Tens
 		ccudnnEnabled = int32(1)
 	}
 	lib.AtgInstanceNorm(ptr, input.ctensor, weight.ctensor, bias.ctensor, runningMean.ctensor, runningVar.ctensor, cuseInputStats, momentum, eps, ccudnnEnabled)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("InstanceNorm", input, weight, bias, runningMean, runningVar); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9591,10 +9591,10 @@ func (ts *Tensor) IntRepr(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIntRepr(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IntRepr", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9606,10 +9606,10 @@ func (ts *Tensor) Inverse(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgInverse(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Inverse", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9621,10 +9621,10 @@ func (ts *Tensor) InverseOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgInverseOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("InverseOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9644,10 +9644,10 @@ func (ts *Tensor) Irfft(signalNdim int64, normalized bool, onesided bool, signal
 		conesided = int32(1)
 	}
 	lib.AtgIrfft(ptr, ts.ctensor, signalNdim, cnormalized, conesided, signalSizes, len(signalSizes))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Irfft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9663,10 +9663,10 @@ func (ts *Tensor) Isclose(other *Tensor, rtol float64, atol float64, equalNan bo
 		cequalNan = int32(1)
 	}
 	lib.AtgIsclose(ptr, ts.ctensor, other.ctensor, rtol, atol, cequalNan)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Isclose", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9678,10 +9678,10 @@ func (ts *Tensor) Isfinite(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsfinite(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Isfinite", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9693,10 +9693,10 @@ func (ts *Tensor) Isinf(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsinf(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Isinf", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9708,10 +9708,10 @@ func (ts *Tensor) Isnan(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsnan(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Isnan", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9723,10 +9723,10 @@ func (ts *Tensor) Isneginf(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsneginf(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Isneginf", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9738,10 +9738,10 @@ func (ts *Tensor) IsneginfOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsneginfOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IsneginfOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9753,10 +9753,10 @@ func (ts *Tensor) Isposinf(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsposinf(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Isposinf", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9768,10 +9768,10 @@ func (ts *Tensor) IsposinfOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsposinfOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("IsposinfOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9783,10 +9783,10 @@ func (ts *Tensor) Isreal(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsreal(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Isreal", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9832,10 +9832,10 @@ func (ts *Tensor) Istft(nFft int64, hopLength []int64, winLength []int64, window
 		creturnComplex = int32(1)
 	}
 	lib.AtgIstft(ptr, ts.ctensor, nFft, chopLengthVal, chopLengthNull, cwinLengthVal, cwinLengthNull, window.ctensor, ccenter, cnormalized, conesided, clengthVal, clengthNull, creturnComplex)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Istft", ts, window); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9844,10 +9844,10 @@ func KaiserWindow(windowLength int64, optionsKind gotch.DType, optionsDevice got
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgKaiserWindow(ptr, windowLength, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("KaiserWindow"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9860,10 +9860,10 @@ func KaiserWindow1(windowLength int64, periodic bool, optionsKind gotch.DType, o
 		cperiodic = int32(1)
 	}
 	lib.AtgKaiserWindow1(ptr, windowLength, cperiodic, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("KaiserWindow1"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9876,10 +9876,10 @@ func KaiserWindow2(windowLength int64, periodic bool, beta float64, optionsKind
 		cperiodic = int32(1)
 	}
 	lib.AtgKaiserWindow2(ptr, windowLength, cperiodic, beta, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("KaiserWindow2"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9895,10 +9895,10 @@ func (ts *Tensor) KlDiv(target *Tensor, reduction int64, logTarget bool, del boo
 		clogTarget = int32(1)
 	}
 	lib.AtgKlDiv(ptr, ts.ctensor, target.ctensor, reduction, clogTarget)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("KlDiv", ts, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9914,10 +9914,10 @@ func (ts *Tensor) KlDivBackward(gradOutput *Tensor, target *Tensor, reduction in
 		clogTarget = int32(1)
 	}
 	lib.AtgKlDivBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, clogTarget)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("KlDivBackward", ts, gradOutput, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9929,10 +9929,10 @@ func (ts *Tensor) L1Loss(target *Tensor, reduction int64, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgL1Loss(ptr, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("L1Loss", ts, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9944,10 +9944,10 @@ func (ts *Tensor) L1LossBackward(gradOutput *Tensor, target *Tensor, reduction i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgL1LossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("L1LossBackward", ts, gradOutput, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9959,10 +9959,10 @@ func (ts *Tensor) L1LossBackwardOut(gradInput *Tensor, gradOutput *Tensor, targe
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgL1LossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("L1LossBackwardOut", ts, gradInput, gradOutput, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9974,10 +9974,10 @@ func (ts *Tensor) L1LossOut(out *Tensor, target *Tensor, reduction int64, del bo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgL1LossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("L1LossOut", ts, out, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9990,10 +9990,10 @@ func LayerNorm(input *Tensor, normalizedShape []int64, weight *Tensor, bias *Ten
 		ccudnnEnable = int32(1)
 	}
 	lib.AtgLayerNorm(ptr, input.ctensor, normalizedShape, len(normalizedShape), weight.ctensor, bias.ctensor, eps, ccudnnEnable)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LayerNorm", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10005,10 +10005,10 @@ func (ts *Tensor) Lcm(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLcm(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lcm", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10017,7 +10017,7 @@ func (ts *Tensor) Lcm_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLcm_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lcm_", ts, other); err != nil {
 		return err
 	}
 
@@ -10031,10 +10031,10 @@ func (ts *Tensor) LcmOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLcmOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LcmOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10046,10 +10046,10 @@ func (ts *Tensor) Le(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLe(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Le", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10061,10 +10061,10 @@ func (ts *Tensor) Le1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLe1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Le1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10073,7 +10073,7 @@ func (ts *Tensor) Le_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLe_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Le_", ts); err != nil {
 		return err
 	}
 
@@ -10084,7 +10084,7 @@ func (ts *Tensor) Le1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLe1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Le1_", ts, other); err != nil {
 		return err
 	}
 
@@ -10098,10 +10098,10 @@ func (ts *Tensor) LeOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LeOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10113,10 +10113,10 @@ func (ts *Tensor) LeOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LeOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10128,10 +10128,10 @@ func (ts *Tensor) LeakyRelu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeakyRelu(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LeakyRelu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10140,7 +10140,7 @@ func (ts *Tensor) LeakyRelu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeakyRelu_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LeakyRelu_", ts); err != nil {
 		return err
 	}
 
@@ -10158,10 +10158,10 @@ func (ts *Tensor) LeakyReluBackward(gradOutput *Tensor, negativeSlope *Scalar, s
 		cselfIsResult = int32(1)
 	}
 	lib.AtgLeakyReluBackward(ptr, gradOutput.ctensor, ts.ctensor, negativeSlope.cscalar, cselfIsResult)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LeakyReluBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10173,10 +10173,10 @@ func (ts *Tensor) LeakyReluOut(out *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeakyReluOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LeakyReluOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10188,10 +10188,10 @@ func (ts *Tensor) Lerp(end *Tensor, weight *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerp(ptr, ts.ctensor, end.ctensor, weight.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lerp", ts, end); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10203,10 +10203,10 @@ func (ts *Tensor) Lerp1(end *Tensor, weight *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerp1(ptr, ts.ctensor, end.ctensor, weight.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lerp1", ts, end, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10215,7 +10215,7 @@ func (ts *Tensor) Lerp_(end *Tensor, weight *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerp_(ptr, ts.ctensor, end.ctensor, weight.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lerp_", ts, end); err != nil {
 		return err
 	}
 
@@ -10226,7 +10226,7 @@ func (ts *Tensor) Lerp1_(end *Tensor, weight *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerp1_(ptr, ts.ctensor, end.ctensor, weight.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lerp1_", ts, end, weight); err != nil {
 		return err
 	}
 
@@ -10240,10 +10240,10 @@ func (ts *Tensor) LerpOut(out *Tensor, end *Tensor, weight *Scalar, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerpOut(ptr, out.ctensor, ts.ctensor, end.ctensor, weight.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LerpOut", ts, out, end); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10255,10 +10255,10 @@ func (ts *Tensor) LerpOut1(out *Tensor, end *Tensor, weight *Tensor, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerpOut1(ptr, out.ctensor, ts.ctensor, end.ctensor, weight.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LerpOut1", ts, out, end, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10270,10 +10270,10 @@ func (ts *Tensor) Less(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLess(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Less", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10285,10 +10285,10 @@ func (ts *Tensor) Less1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLess1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Less1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10297,7 +10297,7 @@ func (ts *Tensor) Less_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLess_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Less_", ts); err != nil {
 		return err
 	}
 
@@ -10308,7 +10308,7 @@ func (ts *Tensor) Less1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLess1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Less1_", ts, other); err != nil {
 		return err
 	}
 
@@ -10322,10 +10322,10 @@ func (ts *Tensor) LessEqual(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqual(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LessEqual", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10337,10 +10337,10 @@ func (ts *Tensor) LessEqual1(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqual1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LessEqual1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10349,7 +10349,7 @@ func (ts *Tensor) LessEqual_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqual_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LessEqual_", ts); err != nil {
 		return err
 	}
 
@@ -10360,7 +10360,7 @@ func (ts *Tensor) LessEqual1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqual1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LessEqual1_", ts, other); err != nil {
 		return err
 	}
 
@@ -10374,10 +10374,10 @@ func (ts *Tensor) LessEqualOut(out *Tensor, other *Scalar, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqualOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LessEqualOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10389,10 +10389,10 @@ func (ts *Tensor) LessEqualOut1(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqualOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LessEqualOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10404,10 +10404,10 @@ func (ts *Tensor) LessOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LessOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10419,10 +10419,10 @@ func (ts *Tensor) LessOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LessOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10434,10 +10434,10 @@ func (ts *Tensor) Lgamma(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLgamma(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lgamma", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10446,7 +10446,7 @@ func (ts *Tensor) Lgamma_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLgamma_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lgamma_", ts); err != nil {
 		return err
 	}
 
@@ -10460,10 +10460,10 @@ func (ts *Tensor) LgammaOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLgammaOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LgammaOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10475,10 +10475,10 @@ func (ts *Tensor) LinalgDet(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLinalgDet(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LinalgDet", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10494,10 +10494,10 @@ func (ts *Tensor) LinalgNorm(ord *Scalar, dim []int64, keepdim bool, dtype gotch
 		ckeepdim = int32(1)
 	}
 	lib.AtgLinalgNorm(ptr, ts.ctensor, ord.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LinalgNorm", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10513,10 +10513,10 @@ func (ts *Tensor) LinalgNorm1(ord string, dim []int64, keepdim bool, dtype gotch
 		ckeepdim = int32(1)
 	}
 	lib.AtgLinalgNorm1(ptr, ts.ctensor, ord, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LinalgNorm1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10532,10 +10532,10 @@ func (ts *Tensor) LinalgNormOut(out *Tensor, ord *Scalar, dim []int64, keepdim b
 		ckeepdim = int32(1)
 	}
 	lib.AtgLinalgNormOut(ptr, out.ctensor, ts.ctensor, ord.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LinalgNormOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10551,10 +10551,10 @@ func (ts *Tensor) LinalgNormOut1(out *Tensor, ord string, dim []int64, keepdim b
 		ckeepdim = int32(1)
 	}
 	lib.AtgLinalgNormOut1(ptr, out.ctensor, ts.ctensor, ord, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LinalgNormOut1", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10563,10 +10563,10 @@ func Linear(input *Tensor, weight *Tensor, bias *Tensor) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLinear(ptr, input.ctensor, weight.ctensor, bias.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Linear", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10581,10 +10581,10 @@ func Linspace(start *Scalar, end *Scalar, steps []int64, optionsKind gotch.DType
 		cstepsNull = 0
 	}
 	lib.AtgLinspace(ptr, start.cscalar, end.cscalar, cstepsVal, cstepsNull, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Linspace"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10599,10 +10599,10 @@ func LinspaceOut(out *Tensor, start *Scalar, end *Scalar, steps []int64) (retVal
 		cstepsNull = 0
 	}
 	lib.AtgLinspaceOut(ptr, out.ctensor, start.cscalar, end.cscalar, cstepsVal, cstepsNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LinspaceOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10614,10 +10614,10 @@ func (ts *Tensor) Log(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10629,10 +10629,10 @@ func (ts *Tensor) Log10(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog10(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log10", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10641,7 +10641,7 @@ func (ts *Tensor) Log10_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog10_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log10_", ts); err != nil {
 		return err
 	}
 
@@ -10655,10 +10655,10 @@ func (ts *Tensor) Log10Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog10Out(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log10Out", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10670,10 +10670,10 @@ func (ts *Tensor) Log1p(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog1p(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log1p", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10682,7 +10682,7 @@ func (ts *Tensor) Log1p_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog1p_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log1p_", ts); err != nil {
 		return err
 	}
 
@@ -10696,10 +10696,10 @@ func (ts *Tensor) Log1pOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog1pOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log1pOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10711,10 +10711,10 @@ func (ts *Tensor) Log2(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog2(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log2", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10723,7 +10723,7 @@ func (ts *Tensor) Log2_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog2_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log2_", ts); err != nil {
 		return err
 	}
 
@@ -10737,10 +10737,10 @@ func (ts *Tensor) Log2Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog2Out(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log2Out", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10749,7 +10749,7 @@ func (ts *Tensor) Log_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Log_", ts); err != nil {
 		return err
 	}
 
@@ -10760,7 +10760,7 @@ func (ts *Tensor) LogNormal_(mean float64, std float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogNormal_(ptr, ts.ctensor, mean, std)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogNormal_", ts); err != nil {
 		return err
 	}
 
@@ -10774,10 +10774,10 @@ func (ts *Tensor) LogOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10789,10 +10789,10 @@ func (ts *Tensor) LogSigmoid(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSigmoid(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogSigmoid", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10804,10 +10804,10 @@ func (ts *Tensor) LogSigmoidBackward(gradOutput *Tensor, buffer *Tensor, del boo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSigmoidBackward(ptr, gradOutput.ctensor, ts.ctensor, buffer.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogSigmoidBackward", ts, gradOutput, buffer); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10819,10 +10819,10 @@ func (ts *Tensor) LogSigmoidBackwardOut(gradInput *Tensor, gradOutput *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSigmoidBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, buffer.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogSigmoidBackwardOut", ts, gradInput, gradOutput, buffer); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10834,10 +10834,10 @@ func (ts *Tensor) LogSigmoidOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSigmoidOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogSigmoidOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10849,10 +10849,10 @@ func (ts *Tensor) LogSoftmax(dim int64, dtype gotch.DType, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSoftmax(ptr, ts.ctensor, dim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogSoftmax", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10864,10 +10864,10 @@ func (ts *Tensor) Logaddexp(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogaddexp(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Logaddexp", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10879,10 +10879,10 @@ func (ts *Tensor) Logaddexp2(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogaddexp2(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Logaddexp2", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10894,10 +10894,10 @@ func (ts *Tensor) Logaddexp2Out(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogaddexp2Out(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Logaddexp2Out", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10909,10 +10909,10 @@ func (ts *Tensor) LogaddexpOut(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogaddexpOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogaddexpOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10924,10 +10924,10 @@ func (ts *Tensor) Logcumsumexp(dim int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogcumsumexp(ptr, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Logcumsumexp", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10939,10 +10939,10 @@ func (ts *Tensor) LogcumsumexpOut(out *Tensor, dim int64, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogcumsumexpOut(ptr, out.ctensor, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogcumsumexpOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10954,10 +10954,10 @@ func (ts *Tensor) Logdet(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogdet(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Logdet", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10969,10 +10969,10 @@ func (ts *Tensor) LogicalAnd(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalAnd(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalAnd", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10981,7 +10981,7 @@ func (ts *Tensor) LogicalAnd_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalAnd_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalAnd_", ts, other); err != nil {
 		return err
 	}
 
@@ -10995,10 +10995,10 @@ func (ts *Tensor) LogicalAndOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalAndOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalAndOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11010,10 +11010,10 @@ func (ts *Tensor) LogicalNot(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalNot(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalNot", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11022,7 +11022,7 @@ func (ts *Tensor) LogicalNot_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalNot_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalNot_", ts); err != nil {
 		return err
 	}
 
@@ -11036,10 +11036,10 @@ func (ts *Tensor) LogicalNotOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalNotOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalNotOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11051,10 +11051,10 @@ func (ts *Tensor) LogicalOr(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalOr(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalOr", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11063,7 +11063,7 @@ func (ts *Tensor) LogicalOr_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalOr_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalOr_", ts, other); err != nil {
 		return err
 	}
 
@@ -11077,10 +11077,10 @@ func (ts *Tensor) LogicalOrOut(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalOrOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalOrOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11092,10 +11092,10 @@ func (ts *Tensor) LogicalXor(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalXor(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalXor", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11104,7 +11104,7 @@ func (ts *Tensor) LogicalXor_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalXor_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalXor_", ts, other); err != nil {
 		return err
 	}
 
@@ -11118,10 +11118,10 @@ func (ts *Tensor) LogicalXorOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalXorOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogicalXorOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11139,10 +11139,10 @@ func (ts *Tensor) Logit(eps []float64, del bool) (retVal *Tensor, err error) {
 		cepsNull = 0
 	}
 	lib.AtgLogit(ptr, ts.ctensor, cepsVal, cepsNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Logit", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11157,7 +11157,7 @@ func (ts *Tensor) Logit_(eps []float64) (err error) {
 		cepsNull = 0
 	}
 	lib.AtgLogit_(ptr, ts.ctensor, cepsVal, cepsNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Logit_", ts); err != nil {
 		return err
 	}
 
@@ -11177,10 +11177,10 @@ func (ts *Tensor) LogitBackward(gradOutput *Tensor, eps []float64, del bool) (re
 		cepsNull = 0
 	}
 	lib.AtgLogitBackward(ptr, gradOutput.ctensor, ts.ctensor, cepsVal, cepsNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogitBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11198,10 +11198,10 @@ func (ts *Tensor) LogitBackwardOut(gradInput *Tensor, gradOutput *Tensor, eps []
 		cepsNull = 0
 	}
 	lib.AtgLogitBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, cepsVal, cepsNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogitBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11219,10 +11219,10 @@ func (ts *Tensor) LogitOut(out *Tensor, eps []float64, del bool) (retVal *Tensor
 		cepsNull = 0
 	}
 	lib.AtgLogitOut(ptr, out.ctensor, ts.ctensor, cepsVal, cepsNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogitOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11237,10 +11237,10 @@ func Logspace(start *Scalar, end *Scalar, steps []int64, base float64, optionsKi
 		cstepsNull = 0
 	}
 	lib.AtgLogspace(ptr, start.cscalar, end.cscalar, cstepsVal, cstepsNull, base, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Logspace"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11255,10 +11255,10 @@ func LogspaceOut(out *Tensor, start *Scalar, end *Scalar, steps []int64, base fl
 		cstepsNull = 0
 	}
 	lib.AtgLogspaceOut(ptr, out.ctensor, start.cscalar, end.cscalar, cstepsVal, cstepsNull, base)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogspaceOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11274,10 +11274,10 @@ func (ts *Tensor) Logsumexp(dim []int64, keepdim bool, del bool) (retVal *Tensor
 		ckeepdim = int32(1)
 	}
 	lib.AtgLogsumexp(ptr, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Logsumexp", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11293,10 +11293,10 @@ func (ts *Tensor) LogsumexpOut(out *Tensor, dim []int64, keepdim bool, del bool)
 		ckeepdim = int32(1)
 	}
 	lib.AtgLogsumexpOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LogsumexpOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11308,10 +11308,10 @@ func (ts *Tensor) Lt(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLt(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lt", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11323,10 +11323,10 @@ func (ts *Tensor) Lt1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLt1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lt1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11335,7 +11335,7 @@ func (ts *Tensor) Lt_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLt_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lt_", ts); err != nil {
 		return err
 	}
 
@@ -11346,7 +11346,7 @@ func (ts *Tensor) Lt1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLt1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Lt1_", ts, other); err != nil {
 		return err
 	}
 
@@ -11360,10 +11360,10 @@ func (ts *Tensor) LtOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLtOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LtOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11375,10 +11375,10 @@ func (ts *Tensor) LtOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLtOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LtOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11390,10 +11390,10 @@ func (ts *Tensor) LuSolve(lUData *Tensor, lUPivots *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLuSolve(ptr, ts.ctensor, lUData.ctensor, lUPivots.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LuSolve", ts, lUData, lUPivots); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11405,10 +11405,10 @@ func (ts *Tensor) LuSolveOut(out *Tensor, lUData *Tensor, lUPivots *Tensor, del
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLuSolveOut(ptr, out.ctensor, ts.ctensor, lUData.ctensor, lUPivots.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("LuSolveOut", ts, out, lUData, lUPivots); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11417,10 +11417,10 @@ func MarginRankingLoss(input1 *Tensor, input2 *Tensor, target *Tensor, margin fl
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMarginRankingLoss(ptr, input1.ctensor, input2.ctensor, target.ctensor, margin, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MarginRankingLoss", input1, input2, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11432,10 +11432,10 @@ func (ts *Tensor) MaskedFill(mask *Tensor, value *Scalar, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedFill(ptr, ts.ctensor, mask.ctensor, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaskedFill", ts, mask); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11447,10 +11447,10 @@ func (ts *Tensor) MaskedFill1(mask *Tensor, value *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedFill1(ptr, ts.ctensor, mask.ctensor, value.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaskedFill1", ts, mask, value); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11459,7 +11459,7 @@ func (ts *Tensor) MaskedFill_(mask *Tensor, value *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedFill_(ptr, ts.ctensor, mask.ctensor, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaskedFill_", ts, mask); err != nil {
 		return err
 	}
 
@@ -11470,7 +11470,7 @@ func (ts *Tensor) MaskedFill1_(mask *Tensor, value *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedFill1_(ptr, ts.ctensor, mask.ctensor, value.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaskedFill1_", ts, mask, value); err != nil {
 		return err
 	}
 
@@ -11484,10 +11484,10 @@ func (ts *Tensor) MaskedScatter(mask *Tensor, source *Tensor, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedScatter(ptr, ts.ctensor, mask.ctensor, source.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaskedScatter", ts, mask, source); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11496,7 +11496,7 @@ func (ts *Tensor) MaskedScatter_(mask *Tensor, source *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedScatter_(ptr, ts.ctensor, mask.ctensor, source.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaskedScatter_", ts, mask, source); err != nil {
 		return err
 	}
 
@@ -11510,10 +11510,10 @@ func (ts *Tensor) MaskedSelect(mask *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedSelect(ptr, ts.ctensor, mask.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaskedSelect", ts, mask); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11522,10 +11522,10 @@ func MaskedSelectBackward(grad *Tensor, input *Tensor, mask *Tensor) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedSelectBackward(ptr, grad.ctensor, input.ctensor, mask.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaskedSelectBackward", grad, input, mask); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11537,10 +11537,10 @@ func (ts *Tensor) MaskedSelectOut(out *Tensor, mask *Tensor, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedSelectOut(ptr, out.ctensor, ts.ctensor, mask.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaskedSelectOut", ts, out, mask); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11552,10 +11552,10 @@ func (ts *Tensor) Matmul(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatmul(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Matmul", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11567,10 +11567,10 @@ func (ts *Tensor) MatmulOut(out *Tensor, other *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatmulOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MatmulOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11582,10 +11582,10 @@ func (ts *Tensor) MatrixExp(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatrixExp(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MatrixExp", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11597,10 +11597,10 @@ func (ts *Tensor) MatrixExpBackward(grad *Tensor, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatrixExpBackward(ptr, ts.ctensor, grad.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MatrixExpBackward", ts, grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11612,10 +11612,10 @@ func (ts *Tensor) MatrixPower(n int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatrixPower(ptr, ts.ctensor, n)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MatrixPower", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11631,10 +11631,10 @@ func (ts *Tensor) MatrixRank(symmetric bool, del bool) (retVal *Tensor, err erro
 		csymmetric = int32(1)
 	}
 	lib.AtgMatrixRank(ptr, ts.ctensor, csymmetric)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MatrixRank", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11650,10 +11650,10 @@ func (ts *Tensor) MatrixRank1(tol float64, symmetric bool, del bool) (retVal *Te
 		csymmetric = int32(1)
 	}
 	lib.AtgMatrixRank1(ptr, ts.ctensor, tol, csymmetric)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MatrixRank1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11665,10 +11665,10 @@ func (ts *Tensor) Max(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMax(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Max", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11680,10 +11680,10 @@ func (ts *Tensor) Max1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMax1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Max1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11695,10 +11695,10 @@ func (ts *Tensor) MaxOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11714,10 +11714,10 @@ func (ts *Tensor) MaxPool1d(kernelSize []int64, stride []int64, padding []int64,
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool1d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxPool1d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11733,10 +11733,10 @@ func (ts *Tensor) MaxPool2d(kernelSize []int64, stride []int64, padding []int64,
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool2d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxPool2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11752,10 +11752,10 @@ func (ts *Tensor) MaxPool2dWithIndicesBackward(gradOutput *Tensor, kernelSize []
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool2dWithIndicesBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode, indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxPool2dWithIndicesBackward", ts, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11771,10 +11771,10 @@ func (ts *Tensor) MaxPool2dWithIndicesBackwardOut(gradInput *Tensor, gradOutput
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool2dWithIndicesBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode, indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxPool2dWithIndicesBackwardOut", ts, gradInput, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11790,10 +11790,10 @@ func (ts *Tensor) MaxPool3d(kernelSize []int64, stride []int64, padding []int64,
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool3d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxPool3d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11809,10 +11809,10 @@ func (ts *Tensor) MaxPool3dWithIndicesBackward(gradOutput *Tensor, kernelSize []
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool3dWithIndicesBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode, indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxPool3dWithIndicesBackward", ts, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11828,10 +11828,10 @@ func (ts *Tensor) MaxPool3dWithIndicesBackwardOut(gradInput *Tensor, gradOutput
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool3dWithIndicesBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode, indices.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxPool3dWithIndicesBackwardOut", ts, gradInput, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11843,10 +11843,10 @@ func (ts *Tensor) MaxUnpool2d(indices *Tensor, outputSize []int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool2d(ptr, ts.ctensor, indices.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxUnpool2d", ts, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11858,10 +11858,10 @@ func (ts *Tensor) MaxUnpool2dBackward(gradOutput *Tensor, indices *Tensor, outpu
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool2dBackward(ptr, gradOutput.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxUnpool2dBackward", ts, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11873,10 +11873,10 @@ func (ts *Tensor) MaxUnpool2dBackwardOut(gradInput *Tensor, gradOutput *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxUnpool2dBackwardOut", ts, gradInput, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11888,10 +11888,10 @@ func (ts *Tensor) MaxUnpool2dOut(out *Tensor, indices *Tensor, outputSize []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool2dOut(ptr, out.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxUnpool2dOut", ts, out, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11903,10 +11903,10 @@ func (ts *Tensor) MaxUnpool3d(indices *Tensor, outputSize []int64, stride []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool3d(ptr, ts.ctensor, indices.ctensor, outputSize, len(outputSize), stride, len(stride), padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxUnpool3d", ts, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11918,10 +11918,10 @@ func (ts *Tensor) MaxUnpool3dBackward(gradOutput *Tensor, indices *Tensor, outpu
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool3dBackward(ptr, gradOutput.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize), stride, len(stride), padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxUnpool3dBackward", ts, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11933,10 +11933,10 @@ func (ts *Tensor) MaxUnpool3dBackwardOut(gradInput *Tensor, gradOutput *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize), stride, len(stride), padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxUnpool3dBackwardOut", ts, gradInput, gradOutput, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11948,10 +11948,10 @@ func (ts *Tensor) MaxUnpool3dOut(out *Tensor, indices *Tensor, outputSize []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool3dOut(ptr, out.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize), stride, len(stride), padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaxUnpool3dOut", ts, out, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11963,10 +11963,10 @@ func (ts *Tensor) Maximum(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaximum(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Maximum", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11978,10 +11978,10 @@ func (ts *Tensor) MaximumOut(out *Tensor, other *Tensor, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaximumOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MaximumOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11993,10 +11993,10 @@ func (ts *Tensor) Mean(dtype gotch.DType, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMean(ptr, ts.ctensor, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mean", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12012,10 +12012,10 @@ func (ts *Tensor) Mean1(dim []int64, keepdim bool, dtype gotch.DType, del bool)
 		ckeepdim = int32(1)
 	}
 	lib.AtgMean1(ptr, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mean1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12031,10 +12031,10 @@ func (ts *Tensor) MeanOut(out *Tensor, dim []int64, keepdim bool, dtype gotch.DT
 		ckeepdim = int32(1)
 	}
 	lib.AtgMeanOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MeanOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12046,10 +12046,10 @@ func (ts *Tensor) Median(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMedian(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Median", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12061,10 +12061,10 @@ func (ts *Tensor) Min(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMin(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Min", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12076,10 +12076,10 @@ func (ts *Tensor) Min1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMin1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Min1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12091,10 +12091,10 @@ func (ts *Tensor) MinOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMinOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MinOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12106,10 +12106,10 @@ func (ts *Tensor) Minimum(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMinimum(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Minimum", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12121,10 +12121,10 @@ func (ts *Tensor) MinimumOut(out *Tensor, other *Tensor, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMinimumOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MinimumOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12144,10 +12144,10 @@ func (ts *Tensor) MiopenConvolution(weight *Tensor, bias *Tensor, padding []int6
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolution(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenConvolution", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12156,10 +12156,10 @@ func MiopenConvolutionBackwardBias(gradOutput *Tensor) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMiopenConvolutionBackwardBias(ptr, gradOutput.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenConvolutionBackwardBias", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12176,10 +12176,10 @@ func MiopenConvolutionBackwardInput(selfSize []int64, gradOutput *Tensor, weight
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionBackwardInput(ptr, selfSize, len(selfSize), gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenConvolutionBackwardInput", gradOutput, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12199,10 +12199,10 @@ func (ts *Tensor) MiopenConvolutionBackwardWeight(weightSize []int64, gradOutput
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenConvolutionBackwardWeight", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12222,10 +12222,10 @@ func (ts *Tensor) MiopenConvolutionTranspose(weight *Tensor, bias *Tensor, paddi
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionTranspose(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), outputPadding, len(outputPadding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenConvolutionTranspose", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12242,10 +12242,10 @@ func MiopenConvolutionTransposeBackwardInput(gradOutput *Tensor, weight *Tensor,
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionTransposeBackwardInput(ptr, gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenConvolutionTransposeBackwardInput", gradOutput, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12265,10 +12265,10 @@ func (ts *Tensor) MiopenConvolutionTransposeBackwardWeight(weightSize []int64, g
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionTransposeBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenConvolutionTransposeBackwardWeight", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12288,10 +12288,10 @@ func (ts *Tensor) MiopenDepthwiseConvolution(weight *Tensor, bias *Tensor, paddi
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenDepthwiseConvolution(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenDepthwiseConvolution", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12308,10 +12308,10 @@ func MiopenDepthwiseConvolutionBackwardInput(selfSize []int64, gradOutput *Tenso
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenDepthwiseConvolutionBackwardInput(ptr, selfSize, len(selfSize), gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenDepthwiseConvolutionBackwardInput", gradOutput, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12331,10 +12331,10 @@ func (ts *Tensor) MiopenDepthwiseConvolutionBackwardWeight(weightSize []int64, g
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenDepthwiseConvolutionBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MiopenDepthwiseConvolutionBackwardWeight", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12346,10 +12346,10 @@ func (ts *Tensor) MkldnnAdaptiveAvgPool2d(outputSize []int64, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnAdaptiveAvgPool2d(ptr, ts.ctensor, outputSize, len(outputSize))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MkldnnAdaptiveAvgPool2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12361,10 +12361,10 @@ func (ts *Tensor) MkldnnConvolution(weight *Tensor, bias *Tensor, padding []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnConvolution(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MkldnnConvolution", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12377,10 +12377,10 @@ func MkldnnConvolutionBackwardInput(selfSize []int64, gradOutput *Tensor, weight
 		cbiasDefined = int32(1)
 	}
 	lib.AtgMkldnnConvolutionBackwardInput(ptr, selfSize, len(selfSize), gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbiasDefined)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MkldnnConvolutionBackwardInput", gradOutput, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12389,10 +12389,10 @@ func MkldnnLinear(input *Tensor, weight *Tensor, bias *Tensor) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnLinear(ptr, input.ctensor, weight.ctensor, bias.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MkldnnLinear", input, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12408,10 +12408,10 @@ func (ts *Tensor) MkldnnMaxPool2d(kernelSize []int64, stride []int64, padding []
 		cceilMode = int32(1)
 	}
 	lib.AtgMkldnnMaxPool2d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MkldnnMaxPool2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12427,10 +12427,10 @@ func (ts *Tensor) MkldnnMaxPool3d(kernelSize []int64, stride []int64, padding []
 		cceilMode = int32(1)
 	}
 	lib.AtgMkldnnMaxPool3d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MkldnnMaxPool3d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12442,10 +12442,10 @@ func (ts *Tensor) MkldnnReorderConv2dWeight(padding []int64, stride []int64, dil
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnReorderConv2dWeight(ptr, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MkldnnReorderConv2dWeight", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12457,10 +12457,10 @@ func (ts *Tensor) MkldnnReorderConv3dWeight(padding []int64, stride []int64, dil
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnReorderConv3dWeight(ptr, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MkldnnReorderConv3dWeight", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12472,10 +12472,10 @@ func (ts *Tensor) Mm(mat2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMm(ptr, ts.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mm", ts, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12487,10 +12487,10 @@ func (ts *Tensor) MmOut(out *Tensor, mat2 *Tensor, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMmOut(ptr, out.ctensor, ts.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MmOut", ts, out, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12502,10 +12502,10 @@ func (ts *Tensor) Movedim(source []int64, destination []int64, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMovedim(ptr, ts.ctensor, source, len(source), destination, len(destination))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Movedim", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12517,10 +12517,10 @@ func (ts *Tensor) Movedim1(source int64, destination int64, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMovedim1(ptr, ts.ctensor, source, destination)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Movedim1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12532,10 +12532,10 @@ func (ts *Tensor) MseLoss(target *Tensor, reduction int64, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMseLoss(ptr, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MseLoss", ts, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12547,10 +12547,10 @@ func (ts *Tensor) MseLossBackward(gradOutput *Tensor, target *Tensor, reduction
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMseLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MseLossBackward", ts, gradOutput, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12562,10 +12562,10 @@ func (ts *Tensor) MseLossBackwardOut(gradInput *Tensor, gradOutput *Tensor, targ
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMseLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MseLossBackwardOut", ts, gradInput, gradOutput, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12577,10 +12577,10 @@ func (ts *Tensor) MseLossOut(out *Tensor, target *Tensor, reduction int64, del b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMseLossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MseLossOut", ts, out, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12592,10 +12592,10 @@ func (ts *Tensor) Mul(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMul(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mul", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12607,10 +12607,10 @@ func (ts *Tensor) Mul1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMul1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mul1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12619,7 +12619,7 @@ func (ts *Tensor) Mul_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMul_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mul_", ts, other); err != nil {
 		return err
 	}
 
@@ -12630,7 +12630,7 @@ func (ts *Tensor) Mul1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMul1_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mul1_", ts); err != nil {
 		return err
 	}
 
@@ -12644,10 +12644,10 @@ func (ts *Tensor) MulOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMulOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MulOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12659,10 +12659,10 @@ func (ts *Tensor) MultiMarginLossBackward(gradOutput *Tensor, target *Tensor, p
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiMarginLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, p.cscalar, margin.cscalar, weight.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MultiMarginLossBackward", ts, gradOutput, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12674,10 +12674,10 @@ func (ts *Tensor) MultiMarginLossBackwardOut(gradInput *Tensor, gradOutput *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiMarginLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, p.cscalar, margin.cscalar, weight.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MultiMarginLossBackwardOut", ts, gradInput, gradOutput, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12689,10 +12689,10 @@ func (ts *Tensor) MultilabelMarginLoss(target *Tensor, reduction int64, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultilabelMarginLoss(ptr, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MultilabelMarginLoss", ts, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12704,10 +12704,10 @@ func (ts *Tensor) MultilabelMarginLossBackward(gradOutput *Tensor, target *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultilabelMarginLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, isTarget.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MultilabelMarginLossBackward", ts, gradOutput, target, isTarget); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12719,10 +12719,10 @@ func (ts *Tensor) MultilabelMarginLossBackwardOut(gradInput *Tensor, gradOutput
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultilabelMarginLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, isTarget.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MultilabelMarginLossBackwardOut", ts, gradInput, gradOutput, target, isTarget); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12734,10 +12734,10 @@ func (ts *Tensor) MultilabelMarginLossOut(out *Tensor, target *Tensor, reduction
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultilabelMarginLossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MultilabelMarginLossOut", ts, out, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12753,10 +12753,10 @@ func (ts *Tensor) Multinomial(numSamples int64, replacement bool, del bool) (ret
 		creplacement = int32(1)
 	}
 	lib.AtgMultinomial(ptr, ts.ctensor, numSamples, creplacement)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Multinomial", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12772,10 +12772,10 @@ func (ts *Tensor) MultinomialOut(out *Tensor, numSamples int64, replacement bool
 		creplacement = int32(1)
 	}
 	lib.AtgMultinomialOut(ptr, out.ctensor, ts.ctensor, numSamples, creplacement)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MultinomialOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12787,10 +12787,10 @@ func (ts *Tensor) Multiply(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiply(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Multiply", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12802,10 +12802,10 @@ func (ts *Tensor) Multiply1(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiply1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Multiply1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12814,7 +12814,7 @@ func (ts *Tensor) Multiply_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiply_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Multiply_", ts, other); err != nil {
 		return err
 	}
 
@@ -12825,7 +12825,7 @@ func (ts *Tensor) Multiply1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiply1_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Multiply1_", ts); err != nil {
 		return err
 	}
 
@@ -12839,10 +12839,10 @@ func (ts *Tensor) MultiplyOut(out *Tensor, other *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiplyOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MultiplyOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12854,10 +12854,10 @@ func (ts *Tensor) Mv(vec *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMv(ptr, ts.ctensor, vec.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mv", ts, vec); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12869,10 +12869,10 @@ func (ts *Tensor) MvOut(out *Tensor, vec *Tensor, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMvOut(ptr, out.ctensor, ts.ctensor, vec.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("MvOut", ts, out, vec); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12884,10 +12884,10 @@ func (ts *Tensor) Mvlgamma(p int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMvlgamma(ptr, ts.ctensor, p)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mvlgamma", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12896,7 +12896,7 @@ func (ts *Tensor) Mvlgamma_(p int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMvlgamma_(ptr, ts.ctensor, p)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Mvlgamma_", ts); err != nil {
 		return err
 	}
 
@@ -12920,10 +12920,10 @@ func (ts *Tensor) Nanquantile(q float64, dim []int64, keepdim bool, del bool) (r
 		ckeepdim = int32(1)
 	}
 	lib.AtgNanquantile(ptr, ts.ctensor, q, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Nanquantile", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12945,10 +12945,10 @@ func (ts *Tensor) Nanquantile1(q *Tensor, dim []int64, keepdim bool, del bool) (
 		ckeepdim = int32(1)
 	}
 	lib.AtgNanquantile1(ptr, ts.ctensor, q.ctensor, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Nanquantile1", ts, q); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12970,10 +12970,10 @@ func (ts *Tensor) NanquantileOut(out *Tensor, q float64, dim []int64, keepdim bo
 		ckeepdim = int32(1)
 	}
 	lib.AtgNanquantileOut(ptr, out.ctensor, ts.ctensor, q, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NanquantileOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12995,10 +12995,10 @@ func (ts *Tensor) NanquantileOut1(out *Tensor, q *Tensor, dim []int64, keepdim b
 		ckeepdim = int32(1)
 	}
 	lib.AtgNanquantileOut1(ptr, out.ctensor, ts.ctensor, q.ctensor, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NanquantileOut1", ts, out, q); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13010,10 +13010,10 @@ func (ts *Tensor) Nansum(dtype gotch.DType, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNansum(ptr, ts.ctensor, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Nansum", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13029,10 +13029,10 @@ func (ts *Tensor) Nansum1(dim []int64, keepdim bool, dtype gotch.DType, del bool
 		ckeepdim = int32(1)
 	}
 	lib.AtgNansum1(ptr, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Nansum1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13048,10 +13048,10 @@ func (ts *Tensor) NansumOut(out *Tensor, dim []int64, keepdim bool, dtype gotch.
 		ckeepdim = int32(1)
 	}
 	lib.AtgNansumOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NansumOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13063,10 +13063,10 @@ func (ts *Tensor) Narrow(dim int64, start int64, length int64, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNarrow(ptr, ts.ctensor, dim, start, length)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Narrow", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13078,10 +13078,10 @@ func (ts *Tensor) Narrow1(dim int64, start *Tensor, length int64, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNarrow1(ptr, ts.ctensor, dim, start.ctensor, length)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Narrow1", ts, start); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13093,10 +13093,10 @@ func (ts *Tensor) NarrowCopy(dim int64, start int64, length int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNarrowCopy(ptr, ts.ctensor, dim, start, length)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NarrowCopy", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13108,10 +13108,10 @@ func (ts *Tensor) NativeNorm(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNativeNorm(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NativeNorm", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13127,10 +13127,10 @@ func (ts *Tensor) NativeNorm1(p *Scalar, dim []int64, keepdim bool, dtype gotch.
 		ckeepdim = int32(1)
 	}
 	lib.AtgNativeNorm1(ptr, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NativeNorm1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13142,10 +13142,10 @@ func (ts *Tensor) Ne(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNe(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ne", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13157,10 +13157,10 @@ func (ts *Tensor) Ne1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNe1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ne1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13169,7 +13169,7 @@ func (ts *Tensor) Ne_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNe_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ne_", ts); err != nil {
 		return err
 	}
 
@@ -13180,7 +13180,7 @@ func (ts *Tensor) Ne1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNe1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ne1_", ts, other); err != nil {
 		return err
 	}
 
@@ -13194,10 +13194,10 @@ func (ts *Tensor) NeOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNeOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NeOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13209,10 +13209,10 @@ func (ts *Tensor) NeOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNeOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NeOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13224,10 +13224,10 @@ func (ts *Tensor) Neg(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNeg(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Neg", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13236,7 +13236,7 @@ func (ts *Tensor) Neg_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNeg_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Neg_", ts); err != nil {
 		return err
 	}
 
@@ -13250,10 +13250,10 @@ func (ts *Tensor) NegOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNegOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NegOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13265,10 +13265,10 @@ func (ts *Tensor) Negative(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNegative(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Negative", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13277,7 +13277,7 @@ func (ts *Tensor) Negative_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNegative_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Negative_", ts); err != nil {
 		return err
 	}
 
@@ -13291,10 +13291,10 @@ func (ts *Tensor) NegativeOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNegativeOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NegativeOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13306,10 +13306,10 @@ func (ts *Tensor) NewEmpty(size []int64, optionsKind gotch.DType, optionsDevice
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNewEmpty(ptr, ts.ctensor, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NewEmpty", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13321,10 +13321,10 @@ func (ts *Tensor) NewFull(size []int64, fillValue *Scalar, optionsKind gotch.DTy
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNewFull(ptr, ts.ctensor, size, len(size), fillValue.cscalar, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NewFull", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13336,10 +13336,10 @@ func (ts *Tensor) NewZeros(size []int64, optionsKind gotch.DType, optionsDevice
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNewZeros(ptr, ts.ctensor, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NewZeros", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13351,10 +13351,10 @@ func (ts *Tensor) Nextafter(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNextafter(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Nextafter", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13363,7 +13363,7 @@ func (ts *Tensor) Nextafter_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNextafter_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Nextafter_", ts, other); err != nil {
 		return err
 	}
 
@@ -13377,10 +13377,10 @@ func (ts *Tensor) NextafterOut(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNextafterOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NextafterOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13392,10 +13392,10 @@ func (ts *Tensor) NllLoss(target *Tensor, weight *Tensor, reduction int64, ignor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss(ptr, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NllLoss", ts, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13407,10 +13407,10 @@ func (ts *Tensor) NllLoss2d(target *Tensor, weight *Tensor, reduction int64, ign
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss2d(ptr, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NllLoss2d", ts, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13422,10 +13422,10 @@ func (ts *Tensor) NllLoss2dBackward(gradOutput *Tensor, target *Tensor, weight *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss2dBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex, totalWeight.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NllLoss2dBackward", ts, gradOutput, target, weight, totalWeight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13437,10 +13437,10 @@ func (ts *Tensor) NllLoss2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, ta
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex, totalWeight.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NllLoss2dBackwardOut", ts, gradInput, gradOutput, target, weight, totalWeight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13452,10 +13452,10 @@ func (ts *Tensor) NllLoss2dOut(out *Tensor, target *Tensor, weight *Tensor, redu
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss2dOut(ptr, out.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NllLoss2dOut", ts, out, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13467,10 +13467,10 @@ func (ts *Tensor) NllLossBackward(gradOutput *Tensor, target *Tensor, weight *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex, totalWeight.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NllLossBackward", ts, gradOutput, target, weight, totalWeight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13482,10 +13482,10 @@ func (ts *Tensor) NllLossBackwardOut(gradInput *Tensor, gradOutput *Tensor, targ
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex, totalWeight.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NllLossBackwardOut", ts, gradInput, gradOutput, target, weight, totalWeight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13497,10 +13497,10 @@ func (ts *Tensor) NllLossOut(out *Tensor, target *Tensor, weight *Tensor, reduct
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NllLossOut", ts, out, target, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13512,10 +13512,10 @@ func (ts *Tensor) Nonzero(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNonzero(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Nonzero", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13527,10 +13527,10 @@ func (ts *Tensor) NonzeroOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNonzeroOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NonzeroOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13542,10 +13542,10 @@ func (ts *Tensor) Norm(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNorm(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Norm", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13557,10 +13557,10 @@ func (ts *Tensor) Norm1(p *Scalar, dtype gotch.DType, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNorm1(ptr, ts.ctensor, p.cscalar, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Norm1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13576,10 +13576,10 @@ func (ts *Tensor) Norm2(p *Scalar, dim []int64, keepdim bool, del bool) (retVal
 		ckeepdim = int32(1)
 	}
 	lib.AtgNorm2(ptr, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Norm2", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13595,10 +13595,10 @@ func (ts *Tensor) Norm3(p *Scalar, dim []int64, keepdim bool, dtype gotch.DType,
 		ckeepdim = int32(1)
 	}
 	lib.AtgNorm3(ptr, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Norm3", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13607,10 +13607,10 @@ func NormExceptDim(v *Tensor, pow int64, dim int64) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormExceptDim(ptr, v.ctensor, pow, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NormExceptDim", v); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13626,10 +13626,10 @@ func (ts *Tensor) NormOut(out *Tensor, p *Scalar, dim []int64, keepdim bool, del
 		ckeepdim = int32(1)
 	}
 	lib.AtgNormOut(ptr, out.ctensor, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NormOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13645,10 +13645,10 @@ func (ts *Tensor) NormOut1(out *Tensor, p *Scalar, dim []int64, keepdim bool, dt
 		ckeepdim = int32(1)
 	}
 	lib.AtgNormOut1(ptr, out.ctensor, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NormOut1", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13657,7 +13657,7 @@ func (ts *Tensor) Normal_(mean float64, std float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormal_(ptr, ts.ctensor, mean, std)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Normal_", ts); err != nil {
 		return err
 	}
 
@@ -13668,10 +13668,10 @@ func NormalOut(out *Tensor, mean *Tensor, std float64) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormalOut(ptr, out.ctensor, mean.ctensor, std)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NormalOut", out, mean); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13680,10 +13680,10 @@ func NormalOut1(out *Tensor, mean float64, std *Tensor) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormalOut1(ptr, out.ctensor, mean, std.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NormalOut1", out, std); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13692,10 +13692,10 @@ func NormalOut2(out *Tensor, mean *Tensor, std *Tensor) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormalOut2(ptr, out.ctensor, mean.ctensor, std.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NormalOut2", out, mean, std); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13704,10 +13704,10 @@ func NormalOut3(out *Tensor, mean float64, std float64, size []int64) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormalOut3(ptr, out.ctensor, mean, std, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NormalOut3", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13719,10 +13719,10 @@ func (ts *Tensor) NotEqual(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqual(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NotEqual", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13734,10 +13734,10 @@ func (ts *Tensor) NotEqual1(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqual1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NotEqual1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13746,7 +13746,7 @@ func (ts *Tensor) NotEqual_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqual_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NotEqual_", ts); err != nil {
 		return err
 	}
 
@@ -13757,7 +13757,7 @@ func (ts *Tensor) NotEqual1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqual1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NotEqual1_", ts, other); err != nil {
 		return err
 	}
 
@@ -13771,10 +13771,10 @@ func (ts *Tensor) NotEqualOut(out *Tensor, other *Scalar, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqualOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NotEqualOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13786,10 +13786,10 @@ func (ts *Tensor) NotEqualOut1(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqualOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NotEqualOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13805,10 +13805,10 @@ func (ts *Tensor) NuclearNorm(keepdim bool, del bool) (retVal *Tensor, err error
 		ckeepdim = int32(1)
 	}
 	lib.AtgNuclearNorm(ptr, ts.ctensor, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NuclearNorm", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13824,10 +13824,10 @@ func (ts *Tensor) NuclearNorm1(dim []int64, keepdim bool, del bool) (retVal *Ten
 		ckeepdim = int32(1)
 	}
 	lib.AtgNuclearNorm1(ptr, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NuclearNorm1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13843,10 +13843,10 @@ func (ts *Tensor) NuclearNormOut(out *Tensor, keepdim bool, del bool) (retVal *T
 		ckeepdim = int32(1)
 	}
 	lib.AtgNuclearNormOut(ptr, out.ctensor, ts.ctensor, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NuclearNormOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13862,10 +13862,10 @@ func (ts *Tensor) NuclearNormOut1(out *Tensor, dim []int64, keepdim bool, del bo
 		ckeepdim = int32(1)
 	}
 	lib.AtgNuclearNormOut1(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NuclearNormOut1", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13877,10 +13877,10 @@ func (ts *Tensor) NumpyT(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNumpyT(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("NumpyT", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13892,10 +13892,10 @@ func (ts *Tensor) OneHot(numClasses int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOneHot(ptr, ts.ctensor, numClasses)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("OneHot", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13904,10 +13904,10 @@ func Ones(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOnes(ptr, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ones"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13919,10 +13919,10 @@ func (ts *Tensor) OnesLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOnesLike(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("OnesLike", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13931,10 +13931,10 @@ func OnesOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOnesOut(ptr, out.ctensor, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("OnesOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13946,10 +13946,10 @@ func (ts *Tensor) Orgqr(input2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOrgqr(ptr, ts.ctensor, input2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Orgqr", ts, input2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13961,10 +13961,10 @@ func (ts *Tensor) OrgqrOut(out *Tensor, input2 *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOrgqrOut(ptr, out.ctensor, ts.ctensor, input2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("OrgqrOut", ts, out, input2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13984,10 +13984,10 @@ func (ts *Tensor) Ormqr(input2 *Tensor, input3 *Tensor, left bool, transpose boo
 		ctranspose = int32(1)
 	}
 	lib.AtgOrmqr(ptr, ts.ctensor, input2.ctensor, input3.ctensor, cleft, ctranspose)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Ormqr", ts, input2, input3); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14007,10 +14007,10 @@ func (ts *Tensor) OrmqrOut(out *Tensor, input2 *Tensor, input3 *Tensor, left boo
 		ctranspose = int32(1)
 	}
 	lib.AtgOrmqrOut(ptr, out.ctensor, ts.ctensor, input2.ctensor, input3.ctensor, cleft, ctranspose)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("OrmqrOut", ts, out, input2, input3); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14022,10 +14022,10 @@ func (ts *Tensor) Outer(vec2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOuter(ptr, ts.ctensor, vec2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Outer", ts, vec2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14037,10 +14037,10 @@ func (ts *Tensor) OuterOut(out *Tensor, vec2 *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOuterOut(ptr, out.ctensor, ts.ctensor, vec2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("OuterOut", ts, out, vec2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14053,10 +14053,10 @@ func PairwiseDistance(x1 *Tensor, x2 *Tensor, p float64, eps float64, keepdim bo
 		ckeepdim = int32(1)
 	}
 	lib.AtgPairwiseDistance(ptr, x1.ctensor, x2.ctensor, p, eps, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("PairwiseDistance", x1, x2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14068,10 +14068,10 @@ func (ts *Tensor) Pdist(p float64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPdist(ptr, ts.ctensor, p)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Pdist", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14083,10 +14083,10 @@ func (ts *Tensor) Permute(dims []int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPermute(ptr, ts.ctensor, dims, len(dims))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Permute", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14098,10 +14098,10 @@ func (ts *Tensor) PinMemory(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPinMemory(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("PinMemory", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14113,10 +14113,10 @@ func (ts *Tensor) Pinverse(rcond float64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPinverse(ptr, ts.ctensor, rcond)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Pinverse", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14128,10 +14128,10 @@ func (ts *Tensor) PixelShuffle(upscaleFactor int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPixelShuffle(ptr, ts.ctensor, upscaleFactor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("PixelShuffle", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14143,10 +14143,10 @@ func (ts *Tensor) Poisson(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPoisson(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Poisson", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14163,10 +14163,10 @@ func PoissonNllLoss(input *Tensor, target *Tensor, logInput bool, full bool, eps
 		cfull = int32(1)
 	}
 	lib.AtgPoissonNllLoss(ptr, input.ctensor, target.ctensor, clogInput, cfull, eps, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("PoissonNllLoss", input, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14175,10 +14175,10 @@ func Polar(abs *Tensor, angle *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolar(ptr, abs.ctensor, angle.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Polar", abs, angle); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14187,10 +14187,10 @@ func PolarOut(out *Tensor, abs *Tensor, angle *Tensor) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolarOut(ptr, out.ctensor, abs.ctensor, angle.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("PolarOut", out, abs, angle); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14202,10 +14202,10 @@ func (ts *Tensor) Polygamma(n int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolygamma(ptr, n, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Polygamma", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14214,7 +14214,7 @@ func (ts *Tensor) Polygamma_(n int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolygamma_(ptr, ts.ctensor, n)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Polygamma_", ts); err != nil {
 		return err
 	}
 
@@ -14228,10 +14228,10 @@ func (ts *Tensor) PolygammaOut(out *Tensor, n int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolygammaOut(ptr, out.ctensor, n, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("PolygammaOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14243,10 +14243,10 @@ func (ts *Tensor) Pow(exponent *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow(ptr, ts.ctensor, exponent.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Pow", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14258,10 +14258,10 @@ func (ts *Tensor) Pow1(exponent *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow1(ptr, ts.ctensor, exponent.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Pow1", ts, exponent); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14270,10 +14270,10 @@ func Pow2(selfScalar *Scalar, exponent *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow2(ptr, selfScalar.cscalar, exponent.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Pow2", exponent); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14282,7 +14282,7 @@ func (ts *Tensor) Pow_(exponent *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow_(ptr, ts.ctensor, exponent.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Pow_", ts); err != nil {
 		return err
 	}
 
@@ -14293,7 +14293,7 @@ func (ts *Tensor) Pow1_(exponent *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow1_(ptr, ts.ctensor, exponent.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Pow1_", ts, exponent); err != nil {
 		return err
 	}
 
@@ -14307,10 +14307,10 @@ func (ts *Tensor) PowOut(out *Tensor, exponent *Scalar, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPowOut(ptr, out.ctensor, ts.ctensor, exponent.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("PowOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14322,10 +14322,10 @@ func (ts *Tensor) PowOut1(out *Tensor, exponent *Tensor, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPowOut1(ptr, out.ctensor, ts.ctensor, exponent.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("PowOut1", ts, out, exponent); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14334,10 +14334,10 @@ func PowOut2(out *Tensor, selfScalar *Scalar, exponent *Tensor) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPowOut2(ptr, out.ctensor, selfScalar.cscalar, exponent.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("PowOut2", out, exponent); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14349,10 +14349,10 @@ func (ts *Tensor) Prelu(weight *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPrelu(ptr, ts.ctensor, weight.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Prelu", ts, weight); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14364,10 +14364,10 @@ func (ts *Tensor) Prod(dtype gotch.DType, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgProd(ptr, ts.ctensor, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Prod", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14383,10 +14383,10 @@ func (ts *Tensor) Prod1(dim int64, keepdim bool, dtype gotch.DType, del bool) (r
 		ckeepdim = int32(1)
 	}
 	lib.AtgProd1(ptr, ts.ctensor, dim, ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Prod1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14402,10 +14402,10 @@ func (ts *Tensor) ProdOut(out *Tensor, dim int64, keepdim bool, dtype gotch.DTyp
 		ckeepdim = int32(1)
 	}
 	lib.AtgProdOut(ptr, out.ctensor, ts.ctensor, dim, ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ProdOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14418,7 +14418,7 @@ func (ts *Tensor) Put_(index *Tensor, source *Tensor, accumulate bool) (err erro
 		caccumulate = int32(1)
 	}
 	lib.AtgPut_(ptr, ts.ctensor, index.ctensor, source.ctensor, caccumulate)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Put_", ts, index, source); err != nil {
 		return err
 	}
 
@@ -14432,10 +14432,10 @@ func (ts *Tensor) QPerChannelScales(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQPerChannelScales(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QPerChannelScales", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14447,10 +14447,10 @@ func (ts *Tensor) QPerChannelZeroPoints(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQPerChannelZeroPoints(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QPerChannelZeroPoints", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14472,10 +14472,10 @@ func (ts *Tensor) Quantile(q float64, dim []int64, keepdim bool, del bool) (retV
 		ckeepdim = int32(1)
 	}
 	lib.AtgQuantile(ptr, ts.ctensor, q, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Quantile", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14497,10 +14497,10 @@ func (ts *Tensor) Quantile1(q *Tensor, dim []int64, keepdim bool, del bool) (ret
 		ckeepdim = int32(1)
 	}
 	lib.AtgQuantile1(ptr, ts.ctensor, q.ctensor, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Quantile1", ts, q); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14522,10 +14522,10 @@ func (ts *Tensor) QuantileOut(out *Tensor, q float64, dim []int64, keepdim bool,
 		ckeepdim = int32(1)
 	}
 	lib.AtgQuantileOut(ptr, out.ctensor, ts.ctensor, q, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantileOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14547,10 +14547,10 @@ func (ts *Tensor) QuantileOut1(out *Tensor, q *Tensor, dim []int64, keepdim bool
 		ckeepdim = int32(1)
 	}
 	lib.AtgQuantileOut1(ptr, out.ctensor, ts.ctensor, q.ctensor, cdimVal, cdimNull, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantileOut1", ts, out, q); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14562,10 +14562,10 @@ func (ts *Tensor) QuantizePerChannel(scales *Tensor, zeroPoints *Tensor, axis in
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizePerChannel(ptr, ts.ctensor, scales.ctensor, zeroPoints.ctensor, axis, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantizePerChannel", ts, scales, zeroPoints); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14577,10 +14577,10 @@ func (ts *Tensor) QuantizePerTensor(scale float64, zeroPoint int64, dtype gotch.
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizePerTensor(ptr, ts.ctensor, scale, zeroPoint, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantizePerTensor", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14589,10 +14589,10 @@ func QuantizedBatchNorm(input *Tensor, weight *Tensor, bias *Tensor, mean *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizedBatchNorm(ptr, input.ctensor, weight.ctensor, bias.ctensor, mean.ctensor, vari.ctensor, eps, outputScale, outputZeroPoint)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantizedBatchNorm", input, weight, bias, mean, vari); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14601,10 +14601,10 @@ func QuantizedGruCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, bIh *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizedGruCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor, packedIh.ctensor, packedHh.ctensor, colOffsetsIh.ctensor, colOffsetsHh.ctensor, scaleIh.cscalar, scaleHh.cscalar, zeroPointIh.cscalar, zeroPointHh.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantizedGruCell", input, hx, wIh, wHh, bIh, bHh, packedIh, packedHh, colOffsetsIh, colOffsetsHh); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14620,10 +14620,10 @@ func (ts *Tensor) QuantizedMaxPool1d(kernelSize []int64, stride []int64, padding
 		cceilMode = int32(1)
 	}
 	lib.AtgQuantizedMaxPool1d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantizedMaxPool1d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14639,10 +14639,10 @@ func (ts *Tensor) QuantizedMaxPool2d(kernelSize []int64, stride []int64, padding
 		cceilMode = int32(1)
 	}
 	lib.AtgQuantizedMaxPool2d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantizedMaxPool2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14651,10 +14651,10 @@ func QuantizedRnnReluCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizedRnnReluCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor, packedIh.ctensor, packedHh.ctensor, colOffsetsIh.ctensor, colOffsetsHh.ctensor, scaleIh.cscalar, scaleHh.cscalar, zeroPointIh.cscalar, zeroPointHh.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantizedRnnReluCell", input, hx, wIh, wHh, bIh, bHh, packedIh, packedHh, colOffsetsIh, colOffsetsHh); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14663,10 +14663,10 @@ func QuantizedRnnTanhCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizedRnnTanhCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor, packedIh.ctensor, packedHh.ctensor, colOffsetsIh.ctensor, colOffsetsHh.ctensor, scaleIh.cscalar, scaleHh.cscalar, zeroPointIh.cscalar, zeroPointHh.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("QuantizedRnnTanhCell", input, hx, wIh, wHh, bIh, bHh, packedIh, packedHh, colOffsetsIh, colOffsetsHh); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14678,10 +14678,10 @@ func (ts *Tensor) Rad2deg(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRad2deg(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rad2deg", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14690,7 +14690,7 @@ func (ts *Tensor) Rad2deg_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRad2deg_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rad2deg_", ts); err != nil {
 		return err
 	}
 
@@ -14704,10 +14704,10 @@ func (ts *Tensor) Rad2degOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRad2degOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rad2degOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14716,10 +14716,10 @@ func Rand(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRand(ptr, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rand"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14731,10 +14731,10 @@ func (ts *Tensor) RandLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandLike(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RandLike", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14743,10 +14743,10 @@ func RandOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandOut(ptr, out.ctensor, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RandOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14755,10 +14755,10 @@ func Randint(high int64, size []int64, optionsKind gotch.DType, optionsDevice go
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandint(ptr, high, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Randint"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14767,10 +14767,10 @@ func Randint1(low int64, high int64, size []int64, optionsKind gotch.DType, opti
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandint1(ptr, low, high, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Randint1"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14782,10 +14782,10 @@ func (ts *Tensor) RandintLike(high int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandintLike(ptr, ts.ctensor, high)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RandintLike", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14797,10 +14797,10 @@ func (ts *Tensor) RandintLike1(low int64, high int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandintLike1(ptr, ts.ctensor, low, high)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RandintLike1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14809,10 +14809,10 @@ func RandintOut(out *Tensor, high int64, size []int64) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandintOut(ptr, out.ctensor, high, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RandintOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14821,10 +14821,10 @@ func RandintOut1(out *Tensor, low int64, high int64, size []int64) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandintOut1(ptr, out.ctensor, low, high, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RandintOut1", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14833,10 +14833,10 @@ func Randn(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandn(ptr, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Randn"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14848,10 +14848,10 @@ func (ts *Tensor) RandnLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandnLike(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RandnLike", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14860,10 +14860,10 @@ func RandnOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandnOut(ptr, out.ctensor, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RandnOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14872,7 +14872,7 @@ func (ts *Tensor) Random_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandom_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Random_", ts); err != nil {
 		return err
 	}
 
@@ -14883,7 +14883,7 @@ func (ts *Tensor) Random1_(to int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandom1_(ptr, ts.ctensor, to)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Random1_", ts); err != nil {
 		return err
 	}
 
@@ -14900,7 +14900,7 @@ func (ts *Tensor) Random2(from int64, to []int64) (err error) {
 		ctoNull = 0
 	}
 	lib.AtgRandom2(ptr, ts.ctensor, from, ctoVal, ctoNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Random2", ts); err != nil {
 		return err
 	}
 
@@ -14911,10 +14911,10 @@ func Randperm(n int64, optionsKind gotch.DType, optionsDevice gotch.Device) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandperm(ptr, n, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Randperm"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14923,10 +14923,10 @@ func RandpermOut(out *Tensor, n int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandpermOut(ptr, out.ctensor, n)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RandpermOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14935,10 +14935,10 @@ func Range(start *Scalar, end *Scalar, optionsKind gotch.DType, optionsDevice go
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRange(ptr, start.cscalar, end.cscalar, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Range"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14947,10 +14947,10 @@ func Range1(start *Scalar, end *Scalar, optionsKind gotch.DType, optionsDevice g
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRange1(ptr, start.cscalar, end.cscalar, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Range1"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14959,10 +14959,10 @@ func RangeOut(out *Tensor, start *Scalar, end *Scalar) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRangeOut(ptr, out.ctensor, start.cscalar, end.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RangeOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14974,10 +14974,10 @@ func (ts *Tensor) Real(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReal(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Real", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14989,10 +14989,10 @@ func (ts *Tensor) Reciprocal(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReciprocal(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Reciprocal", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15001,7 +15001,7 @@ func (ts *Tensor) Reciprocal_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReciprocal_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Reciprocal_", ts); err != nil {
 		return err
 	}
 
@@ -15015,10 +15015,10 @@ func (ts *Tensor) ReciprocalOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReciprocalOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReciprocalOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15030,10 +15030,10 @@ func (ts *Tensor) ReflectionPad1d(padding []int64, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad1d(ptr, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReflectionPad1d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15045,10 +15045,10 @@ func (ts *Tensor) ReflectionPad1dBackward(gradOutput *Tensor, padding []int64, d
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad1dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReflectionPad1dBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15060,10 +15060,10 @@ func (ts *Tensor) ReflectionPad1dBackwardOut(gradInput *Tensor, gradOutput *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad1dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReflectionPad1dBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15075,10 +15075,10 @@ func (ts *Tensor) ReflectionPad1dOut(out *Tensor, padding []int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad1dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReflectionPad1dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15090,10 +15090,10 @@ func (ts *Tensor) ReflectionPad2d(padding []int64, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad2d(ptr, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReflectionPad2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15105,10 +15105,10 @@ func (ts *Tensor) ReflectionPad2dBackward(gradOutput *Tensor, padding []int64, d
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad2dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReflectionPad2dBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15120,10 +15120,10 @@ func (ts *Tensor) ReflectionPad2dBackwardOut(gradInput *Tensor, gradOutput *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReflectionPad2dBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15135,10 +15135,10 @@ func (ts *Tensor) ReflectionPad2dOut(out *Tensor, padding []int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad2dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReflectionPad2dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15150,10 +15150,10 @@ func (ts *Tensor) Relu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRelu(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Relu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15162,7 +15162,7 @@ func (ts *Tensor) Relu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRelu_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Relu_", ts); err != nil {
 		return err
 	}
 
@@ -15176,10 +15176,10 @@ func (ts *Tensor) Remainder(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainder(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Remainder", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15191,10 +15191,10 @@ func (ts *Tensor) Remainder1(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainder1(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Remainder1", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15203,7 +15203,7 @@ func (ts *Tensor) Remainder_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainder_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Remainder_", ts); err != nil {
 		return err
 	}
 
@@ -15214,7 +15214,7 @@ func (ts *Tensor) Remainder1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainder1_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Remainder1_", ts, other); err != nil {
 		return err
 	}
 
@@ -15228,10 +15228,10 @@ func (ts *Tensor) RemainderOut(out *Tensor, other *Scalar, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainderOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RemainderOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15243,10 +15243,10 @@ func (ts *Tensor) RemainderOut1(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainderOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RemainderOut1", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15258,10 +15258,10 @@ func (ts *Tensor) Renorm(p *Scalar, dim int64, maxnorm *Scalar, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRenorm(ptr, ts.ctensor, p.cscalar, dim, maxnorm.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Renorm", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15270,7 +15270,7 @@ func (ts *Tensor) Renorm_(p *Scalar, dim int64, maxnorm *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRenorm_(ptr, ts.ctensor, p.cscalar, dim, maxnorm.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Renorm_", ts); err != nil {
 		return err
 	}
 
@@ -15284,10 +15284,10 @@ func (ts *Tensor) RenormOut(out *Tensor, p *Scalar, dim int64, maxnorm *Scalar,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRenormOut(ptr, out.ctensor, ts.ctensor, p.cscalar, dim, maxnorm.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RenormOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15299,10 +15299,10 @@ func (ts *Tensor) Repeat(repeats []int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRepeat(ptr, ts.ctensor, repeats, len(repeats))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Repeat", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15311,10 +15311,10 @@ func RepeatInterleave(repeats *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRepeatInterleave(ptr, repeats.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RepeatInterleave", repeats); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15332,10 +15332,10 @@ func (ts *Tensor) RepeatInterleave1(repeats *Tensor, dim []int64, del bool) (ret
 		cdimNull = 0
 	}
 	lib.AtgRepeatInterleave1(ptr, ts.ctensor, repeats.ctensor, cdimVal, cdimNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RepeatInterleave1", ts, repeats); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15353,10 +15353,10 @@ func (ts *Tensor) RepeatInterleave2(repeats int64, dim []int64, del bool) (retVa
 		cdimNull = 0
 	}
 	lib.AtgRepeatInterleave2(ptr, ts.ctensor, repeats, cdimVal, cdimNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RepeatInterleave2", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15368,10 +15368,10 @@ func (ts *Tensor) ReplicationPad1d(padding []int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad1d(ptr, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad1d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15383,10 +15383,10 @@ func (ts *Tensor) ReplicationPad1dBackward(gradOutput *Tensor, padding []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad1dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad1dBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15398,10 +15398,10 @@ func (ts *Tensor) ReplicationPad1dBackwardOut(gradInput *Tensor, gradOutput *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad1dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad1dBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15413,10 +15413,10 @@ func (ts *Tensor) ReplicationPad1dOut(out *Tensor, padding []int64, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad1dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad1dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15428,10 +15428,10 @@ func (ts *Tensor) ReplicationPad2d(padding []int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad2d(ptr, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15443,10 +15443,10 @@ func (ts *Tensor) ReplicationPad2dBackward(gradOutput *Tensor, padding []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad2dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad2dBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15458,10 +15458,10 @@ func (ts *Tensor) ReplicationPad2dBackwardOut(gradInput *Tensor, gradOutput *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad2dBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15473,10 +15473,10 @@ func (ts *Tensor) ReplicationPad2dOut(out *Tensor, padding []int64, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad2dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad2dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15488,10 +15488,10 @@ func (ts *Tensor) ReplicationPad3d(padding []int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad3d(ptr, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad3d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15503,10 +15503,10 @@ func (ts *Tensor) ReplicationPad3dBackward(gradOutput *Tensor, padding []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad3dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad3dBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15518,10 +15518,10 @@ func (ts *Tensor) ReplicationPad3dBackwardOut(gradInput *Tensor, gradOutput *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad3dBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15533,10 +15533,10 @@ func (ts *Tensor) ReplicationPad3dOut(out *Tensor, padding []int64, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad3dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReplicationPad3dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15549,7 +15549,7 @@ func (ts *Tensor) RequiresGrad_(requiresGrad bool) (err error) {
 		crequiresGrad = int32(1)
 	}
 	lib.AtgRequiresGrad_(ptr, ts.ctensor, crequiresGrad)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RequiresGrad_", ts); err != nil {
 		return err
 	}
 
@@ -15563,10 +15563,10 @@ func (ts *Tensor) Reshape(shape []int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReshape(ptr, ts.ctensor, shape, len(shape))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Reshape", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15578,10 +15578,10 @@ func (ts *Tensor) ReshapeAs(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReshapeAs(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ReshapeAs", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15590,7 +15590,7 @@ func (ts *Tensor) Resize_(size []int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgResize_(ptr, ts.ctensor, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Resize_", ts); err != nil {
 		return err
 	}
 
@@ -15601,7 +15601,7 @@ func (ts *Tensor) ResizeAs_(theTemplate *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgResizeAs_(ptr, ts.ctensor, theTemplate.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ResizeAs_", ts, theTemplate); err != nil {
 		return err
 	}
 
@@ -15623,10 +15623,10 @@ func (ts *Tensor) Rfft(signalNdim int64, normalized bool, onesided bool, del boo
 		conesided = int32(1)
 	}
 	lib.AtgRfft(ptr, ts.ctensor, signalNdim, cnormalized, conesided)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rfft", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15635,10 +15635,10 @@ func RnnReluCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, bIh *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRnnReluCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RnnReluCell", input, hx, wIh, wHh, bIh, bHh); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15647,10 +15647,10 @@ func RnnTanhCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, bIh *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRnnTanhCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RnnTanhCell", input, hx, wIh, wHh, bIh, bHh); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15662,10 +15662,10 @@ func (ts *Tensor) Roll(shifts []int64, dims []int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRoll(ptr, ts.ctensor, shifts, len(shifts), dims, len(dims))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Roll", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15677,10 +15677,10 @@ func (ts *Tensor) Rot90(k int64, dims []int64, del bool) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRot90(ptr, ts.ctensor, k, dims, len(dims))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rot90", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15692,10 +15692,10 @@ func (ts *Tensor) Round(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRound(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Round", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15704,7 +15704,7 @@ func (ts *Tensor) Round_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRound_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Round_", ts); err != nil {
 		return err
 	}
 
@@ -15718,10 +15718,10 @@ func (ts *Tensor) RoundOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRoundOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RoundOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15737,10 +15737,10 @@ func (ts *Tensor) Rrelu(training bool, del bool) (retVal *Tensor, err error) {
 		ctraining = int32(1)
 	}
 	lib.AtgRrelu(ptr, ts.ctensor, ctraining)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rrelu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15753,7 +15753,7 @@ func (ts *Tensor) Rrelu_(training bool) (err error) {
 		ctraining = int32(1)
 	}
 	lib.AtgRrelu_(ptr, ts.ctensor, ctraining)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rrelu_", ts); err != nil {
 		return err
 	}
 
@@ -15771,10 +15771,10 @@ func (ts *Tensor) RreluWithNoise(noise *Tensor, training bool, del bool) (retVal
 		ctraining = int32(1)
 	}
 	lib.AtgRreluWithNoise(ptr, ts.ctensor, noise.ctensor, ctraining)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RreluWithNoise", ts, noise); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15787,7 +15787,7 @@ func (ts *Tensor) RreluWithNoise_(noise *Tensor, training bool) (err error) {
 		ctraining = int32(1)
 	}
 	lib.AtgRreluWithNoise_(ptr, ts.ctensor, noise.ctensor, ctraining)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RreluWithNoise_", ts, noise); err != nil {
 		return err
 	}
 
@@ -15809,10 +15809,10 @@ func (ts *Tensor) RreluWithNoiseBackward(gradOutput *Tensor, noise *Tensor, lowe
 		cselfIsResult = int32(1)
 	}
 	lib.AtgRreluWithNoiseBackward(ptr, gradOutput.ctensor, ts.ctensor, noise.ctensor, lower.cscalar, upper.cscalar, ctraining, cselfIsResult)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RreluWithNoiseBackward", ts, gradOutput, noise); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15828,10 +15828,10 @@ func (ts *Tensor) RreluWithNoiseOut(out *Tensor, noise *Tensor, training bool, d
 		ctraining = int32(1)
 	}
 	lib.AtgRreluWithNoiseOut(ptr, out.ctensor, ts.ctensor, noise.ctensor, ctraining)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RreluWithNoiseOut", ts, out, noise); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15843,10 +15843,10 @@ func (ts *Tensor) Rsqrt(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsqrt(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rsqrt", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15855,7 +15855,7 @@ func (ts *Tensor) Rsqrt_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsqrt_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rsqrt_", ts); err != nil {
 		return err
 	}
 
@@ -15869,10 +15869,10 @@ func (ts *Tensor) RsqrtOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsqrtOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("RsqrtOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15884,10 +15884,10 @@ func (ts *Tensor) Rsub(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsub(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rsub", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15899,10 +15899,10 @@ func (ts *Tensor) Rsub1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsub1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Rsub1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15911,10 +15911,10 @@ func ScalarTensor(s *Scalar, optionsKind gotch.DType, optionsDevice gotch.Device
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScalarTensor(ptr, s.cscalar, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ScalarTensor"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15926,10 +15926,10 @@ func (ts *Tensor) Scatter(dim int64, index *Tensor, src *Tensor, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter(ptr, ts.ctensor, dim, index.ctensor, src.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Scatter", ts, index, src); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15941,10 +15941,10 @@ func (ts *Tensor) Scatter1(dim int64, index *Tensor, value *Scalar, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter1(ptr, ts.ctensor, dim, index.ctensor, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Scatter1", ts, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15953,7 +15953,7 @@ func (ts *Tensor) Scatter_(dim int64, index *Tensor, src *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter_(ptr, ts.ctensor, dim, index.ctensor, src.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Scatter_", ts, index, src); err != nil {
 		return err
 	}
 
@@ -15964,7 +15964,7 @@ func (ts *Tensor) Scatter1_(dim int64, index *Tensor, value *Scalar) (err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter1_(ptr, ts.ctensor, dim, index.ctensor, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Scatter1_", ts, index); err != nil {
 		return err
 	}
 
@@ -15975,7 +15975,7 @@ func (ts *Tensor) Scatter2(dim int64, index *Tensor, src *Tensor, reduce string)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter2(ptr, ts.ctensor, dim, index.ctensor, src.ctensor, reduce)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Scatter2", ts, index, src); err != nil {
 		return err
 	}
 
@@ -15986,7 +15986,7 @@ func (ts *Tensor) Scatter3(dim int64, index *Tensor, value *Scalar, reduce strin
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter3(ptr, ts.ctensor, dim, index.ctensor, value.cscalar, reduce)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Scatter3", ts, index); err != nil {
 		return err
 	}
 
@@ -16000,10 +16000,10 @@ func (ts *Tensor) ScatterAdd(dim int64, index *Tensor, src *Tensor, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatterAdd(ptr, ts.ctensor, dim, index.ctensor, src.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ScatterAdd", ts, index, src); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16012,7 +16012,7 @@ func (ts *Tensor) ScatterAdd_(dim int64, index *Tensor, src *Tensor) (err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatterAdd_(ptr, ts.ctensor, dim, index.ctensor, src.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ScatterAdd_", ts, index, src); err != nil {
 		return err
 	}
 
@@ -16034,10 +16034,10 @@ func (ts *Tensor) Searchsorted(sortedSequence *Tensor, outInt32 bool, right bool
 		cright = int32(1)
 	}
 	lib.AtgSearchsorted(ptr, sortedSequence.ctensor, ts.ctensor, coutInt32, cright)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Searchsorted", ts, sortedSequence); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16054,10 +16054,10 @@ func Searchsorted1(sortedSequence *Tensor, selfScalar *Scalar, outInt32 bool, ri
 		cright = int32(1)
 	}
 	lib.AtgSearchsorted1(ptr, sortedSequence.ctensor, selfScalar.cscalar, coutInt32, cright)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Searchsorted1", sortedSequence); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16077,10 +16077,10 @@ func (ts *Tensor) SearchsortedOut(out *Tensor, sortedSequence *Tensor, outInt32
 		cright = int32(1)
 	}
 	lib.AtgSearchsortedOut(ptr, out.ctensor, sortedSequence.ctensor, ts.ctensor, coutInt32, cright)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SearchsortedOut", ts, out, sortedSequence); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16092,10 +16092,10 @@ func (ts *Tensor) Select(dim int64, index int64, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSelect(ptr, ts.ctensor, dim, index)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Select", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16104,10 +16104,10 @@ func SelectBackward(grad *Tensor, inputSizes []int64, dim int64, index int64) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSelectBackward(ptr, grad.ctensor, inputSizes, len(inputSizes), dim, index)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SelectBackward", grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16119,10 +16119,10 @@ func (ts *Tensor) Selu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSelu(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Selu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16131,7 +16131,7 @@ func (ts *Tensor) Selu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSelu_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Selu_", ts); err != nil {
 		return err
 	}
 
@@ -16142,7 +16142,7 @@ func (ts *Tensor) Set_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSet_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Set_", ts); err != nil {
 		return err
 	}
 
@@ -16153,7 +16153,7 @@ func (ts *Tensor) Set1_(source *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSet1_(ptr, ts.ctensor, source.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Set1_", ts, source); err != nil {
 		return err
 	}
 
@@ -16171,10 +16171,10 @@ func (ts *Tensor) SetRequiresGrad(r bool, del bool) (retVal *Tensor, err error)
 		cr = int32(1)
 	}
 	lib.AtgSetRequiresGrad(ptr, ts.ctensor, cr)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SetRequiresGrad", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16186,10 +16186,10 @@ func (ts *Tensor) Sgn(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSgn(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sgn", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16198,7 +16198,7 @@ func (ts *Tensor) Sgn_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSgn_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sgn_", ts); err != nil {
 		return err
 	}
 
@@ -16212,10 +16212,10 @@ func (ts *Tensor) SgnOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSgnOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SgnOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16227,10 +16227,10 @@ func (ts *Tensor) Sigmoid(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoid(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sigmoid", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16239,7 +16239,7 @@ func (ts *Tensor) Sigmoid_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoid_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sigmoid_", ts); err != nil {
 		return err
 	}
 
@@ -16250,10 +16250,10 @@ func SigmoidBackward(gradOutput *Tensor, output *Tensor) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoidBackward(ptr, gradOutput.ctensor, output.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SigmoidBackward", gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16262,10 +16262,10 @@ func SigmoidBackwardOut(gradInput *Tensor, gradOutput *Tensor, output *Tensor) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoidBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, output.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SigmoidBackwardOut", gradInput, gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16277,10 +16277,10 @@ func (ts *Tensor) SigmoidOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoidOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SigmoidOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16292,10 +16292,10 @@ func (ts *Tensor) Sign(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSign(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sign", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16304,7 +16304,7 @@ func (ts *Tensor) Sign_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSign_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sign_", ts); err != nil {
 		return err
 	}
 
@@ -16318,10 +16318,10 @@ func (ts *Tensor) SignOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSignOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SignOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16333,10 +16333,10 @@ func (ts *Tensor) Signbit(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSignbit(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Signbit", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16348,10 +16348,10 @@ func (ts *Tensor) SignbitOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSignbitOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SignbitOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16363,10 +16363,10 @@ func (ts *Tensor) Silu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSilu(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Silu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16375,7 +16375,7 @@ func (ts *Tensor) Silu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSilu_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Silu_", ts); err != nil {
 		return err
 	}
 
@@ -16389,10 +16389,10 @@ func (ts *Tensor) SiluBackward(gradOutput *Tensor, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSiluBackward(ptr, gradOutput.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SiluBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16404,10 +16404,10 @@ func (ts *Tensor) SiluOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSiluOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SiluOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16419,10 +16419,10 @@ func (ts *Tensor) Sin(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSin(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sin", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16431,7 +16431,7 @@ func (ts *Tensor) Sin_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSin_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sin_", ts); err != nil {
 		return err
 	}
 
@@ -16445,10 +16445,10 @@ func (ts *Tensor) SinOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSinOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SinOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16460,10 +16460,10 @@ func (ts *Tensor) Sinh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSinh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sinh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16472,7 +16472,7 @@ func (ts *Tensor) Sinh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSinh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sinh_", ts); err != nil {
 		return err
 	}
 
@@ -16486,10 +16486,10 @@ func (ts *Tensor) SinhOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSinhOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SinhOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16501,10 +16501,10 @@ func (ts *Tensor) Slice(dim int64, start int64, end int64, step int64, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlice(ptr, ts.ctensor, dim, start, end, step)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Slice", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16513,10 +16513,10 @@ func SliceBackward(grad *Tensor, inputSizes []int64, dim int64, start int64, end
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSliceBackward(ptr, grad.ctensor, inputSizes, len(inputSizes), dim, start, end, step)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SliceBackward", grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16528,10 +16528,10 @@ func (ts *Tensor) SlowConv3d(weight *Tensor, kernelSize []int64, bias *Tensor, s
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConv3d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SlowConv3d", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16543,10 +16543,10 @@ func (ts *Tensor) SlowConv3dOut(out *Tensor, weight *Tensor, kernelSize []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConv3dOut(ptr, out.ctensor, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SlowConv3dOut", ts, out, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16558,10 +16558,10 @@ func (ts *Tensor) SlowConvDilated2d(weight *Tensor, kernelSize []int64, bias *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvDilated2d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SlowConvDilated2d", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16573,10 +16573,10 @@ func (ts *Tensor) SlowConvDilated3d(weight *Tensor, kernelSize []int64, bias *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvDilated3d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SlowConvDilated3d", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16588,10 +16588,10 @@ func (ts *Tensor) SlowConvTranspose2d(weight *Tensor, kernelSize []int64, bias *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvTranspose2d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), dilation, len(dilation))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SlowConvTranspose2d", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16603,10 +16603,10 @@ func (ts *Tensor) SlowConvTranspose2dOut(out *Tensor, weight *Tensor, kernelSize
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvTranspose2dOut(ptr, out.ctensor, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), dilation, len(dilation))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SlowConvTranspose2dOut", ts, out, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16618,10 +16618,10 @@ func (ts *Tensor) SlowConvTranspose3d(weight *Tensor, kernelSize []int64, bias *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvTranspose3d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), dilation, len(dilation))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SlowConvTranspose3d", ts, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16633,10 +16633,10 @@ func (ts *Tensor) SlowConvTranspose3dOut(out *Tensor, weight *Tensor, kernelSize
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvTranspose3dOut(ptr, out.ctensor, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), dilation, len(dilation))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SlowConvTranspose3dOut", ts, out, weight, bias); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16648,10 +16648,10 @@ func (ts *Tensor) Smm(mat2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmm(ptr, ts.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Smm", ts, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16663,10 +16663,10 @@ func (ts *Tensor) SmoothL1Loss(target *Tensor, reduction int64, beta float64, de
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmoothL1Loss(ptr, ts.ctensor, target.ctensor, reduction, beta)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SmoothL1Loss", ts, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16678,10 +16678,10 @@ func (ts *Tensor) SmoothL1LossBackward(gradOutput *Tensor, target *Tensor, reduc
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmoothL1LossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, beta)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SmoothL1LossBackward", ts, gradOutput, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16693,10 +16693,10 @@ func (ts *Tensor) SmoothL1LossBackwardOut(gradInput *Tensor, gradOutput *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmoothL1LossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, beta)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SmoothL1LossBackwardOut", ts, gradInput, gradOutput, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16708,10 +16708,10 @@ func (ts *Tensor) SmoothL1LossOut(out *Tensor, target *Tensor, reduction int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmoothL1LossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction, beta)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SmoothL1LossOut", ts, out, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16723,10 +16723,10 @@ func (ts *Tensor) SoftMarginLoss(target *Tensor, reduction int64, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftMarginLoss(ptr, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftMarginLoss", ts, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16738,10 +16738,10 @@ func (ts *Tensor) SoftMarginLossBackward(gradOutput *Tensor, target *Tensor, red
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftMarginLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftMarginLossBackward", ts, gradOutput, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16753,10 +16753,10 @@ func (ts *Tensor) SoftMarginLossBackwardOut(gradInput *Tensor, gradOutput *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftMarginLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftMarginLossBackwardOut", ts, gradInput, gradOutput, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16768,10 +16768,10 @@ func (ts *Tensor) SoftMarginLossOut(out *Tensor, target *Tensor, reduction int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftMarginLossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftMarginLossOut", ts, out, target); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16783,10 +16783,10 @@ func (ts *Tensor) Softmax(dim int64, dtype gotch.DType, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftmax(ptr, ts.ctensor, dim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Softmax", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16798,10 +16798,10 @@ func (ts *Tensor) Softplus(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftplus(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Softplus", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16813,10 +16813,10 @@ func (ts *Tensor) SoftplusBackward(gradOutput *Tensor, beta *Scalar, threshold *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftplusBackward(ptr, gradOutput.ctensor, ts.ctensor, beta.cscalar, threshold.cscalar, output.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftplusBackward", ts, gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16828,10 +16828,10 @@ func (ts *Tensor) SoftplusBackwardOut(gradInput *Tensor, gradOutput *Tensor, bet
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftplusBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, beta.cscalar, threshold.cscalar, output.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftplusBackwardOut", ts, gradInput, gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16843,10 +16843,10 @@ func (ts *Tensor) SoftplusOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftplusOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftplusOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16858,10 +16858,10 @@ func (ts *Tensor) Softshrink(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftshrink(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Softshrink", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16873,10 +16873,10 @@ func (ts *Tensor) SoftshrinkBackward(gradOutput *Tensor, lambd *Scalar, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftshrinkBackward(ptr, gradOutput.ctensor, ts.ctensor, lambd.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftshrinkBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16888,10 +16888,10 @@ func (ts *Tensor) SoftshrinkBackwardOut(gradInput *Tensor, gradOutput *Tensor, l
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftshrinkBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, lambd.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftshrinkBackwardOut", ts, gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16903,10 +16903,10 @@ func (ts *Tensor) SoftshrinkOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftshrinkOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SoftshrinkOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16915,10 +16915,10 @@ func SparseCooTensor(size []int64, optionsKind gotch.DType, optionsDevice gotch.
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseCooTensor(ptr, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SparseCooTensor"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16927,10 +16927,10 @@ func SparseCooTensor1(indices *Tensor, values *Tensor, optionsKind gotch.DType,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseCooTensor1(ptr, indices.ctensor, values.ctensor, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SparseCooTensor1", indices, values); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16939,10 +16939,10 @@ func SparseCooTensor2(indices *Tensor, values *Tensor, size []int64, optionsKind
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseCooTensor2(ptr, indices.ctensor, values.ctensor, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SparseCooTensor2", indices, values); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16954,10 +16954,10 @@ func (ts *Tensor) SparseMask(mask *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseMask(ptr, ts.ctensor, mask.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SparseMask", ts, mask); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16966,7 +16966,7 @@ func (ts *Tensor) SparseResize_(size []int64, sparseDim int64, denseDim int64) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseResize_(ptr, ts.ctensor, size, len(size), sparseDim, denseDim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SparseResize_", ts); err != nil {
 		return err
 	}
 
@@ -16977,7 +16977,7 @@ func (ts *Tensor) SparseResizeAndClear_(size []int64, sparseDim int64, denseDim
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseResizeAndClear_(ptr, ts.ctensor, size, len(size), sparseDim, denseDim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SparseResizeAndClear_", ts); err != nil {
 		return err
 	}
 
@@ -16991,10 +16991,10 @@ func (ts *Tensor) Sqrt(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqrt(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sqrt", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17003,7 +17003,7 @@ func (ts *Tensor) Sqrt_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqrt_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sqrt_", ts); err != nil {
 		return err
 	}
 
@@ -17017,10 +17017,10 @@ func (ts *Tensor) SqrtOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqrtOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SqrtOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17032,10 +17032,10 @@ func (ts *Tensor) Square(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSquare(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Square", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17044,7 +17044,7 @@ func (ts *Tensor) Square_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSquare_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Square_", ts); err != nil {
 		return err
 	}
 
@@ -17058,10 +17058,10 @@ func (ts *Tensor) Squeeze(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqueeze(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Squeeze", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17073,10 +17073,10 @@ func (ts *Tensor) Squeeze1(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqueeze1(ptr, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Squeeze1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17085,7 +17085,7 @@ func (ts *Tensor) Squeeze_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqueeze_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Squeeze_", ts); err != nil {
 		return err
 	}
 
@@ -17096,7 +17096,7 @@ func (ts *Tensor) Squeeze1_(dim int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqueeze1_(ptr, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Squeeze1_", ts); err != nil {
 		return err
 	}
 
@@ -17110,10 +17110,10 @@ func (ts *Tensor) Sspaddmm(mat1 *Tensor, mat2 *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSspaddmm(ptr, ts.ctensor, mat1.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sspaddmm", ts, mat1, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17125,10 +17125,10 @@ func (ts *Tensor) SspaddmmOut(out *Tensor, mat1 *Tensor, mat2 *Tensor, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSspaddmmOut(ptr, out.ctensor, ts.ctensor, mat1.ctensor, mat2.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SspaddmmOut", ts, out, mat1, mat2); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17141,10 +17141,10 @@ func Stack(tensors []Tensor, dim int64) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgStack(ptr, ctensors, len(ctensors), dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Stack"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17157,10 +17157,10 @@ func StackOut(out *Tensor, tensors []Tensor, dim int64) (retVal *Tensor, err err
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgStackOut(ptr, out.ctensor, ctensors, len(ctensors), dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("StackOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17176,10 +17176,10 @@ func (ts *Tensor) Std(unbiased bool, del bool) (retVal *Tensor, err error) {
 		cunbiased = int32(1)
 	}
 	lib.AtgStd(ptr, ts.ctensor, cunbiased)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Std", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17199,10 +17199,10 @@ func (ts *Tensor) Std1(dim []int64, unbiased bool, keepdim bool, del bool) (retV
 		ckeepdim = int32(1)
 	}
 	lib.AtgStd1(ptr, ts.ctensor, dim, len(dim), cunbiased, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Std1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17222,10 +17222,10 @@ func (ts *Tensor) StdOut(out *Tensor, dim []int64, unbiased bool, keepdim bool,
 		ckeepdim = int32(1)
 	}
 	lib.AtgStdOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), cunbiased, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("StdOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17261,10 +17261,10 @@ func (ts *Tensor) Stft(nFft int64, hopLength []int64, winLength []int64, window
 		creturnComplex = int32(1)
 	}
 	lib.AtgStft(ptr, ts.ctensor, nFft, chopLengthVal, chopLengthNull, cwinLengthVal, cwinLengthNull, window.ctensor, cnormalized, conesided, creturnComplex)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Stft", ts, window); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17276,10 +17276,10 @@ func (ts *Tensor) Sub(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSub(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sub", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17291,10 +17291,10 @@ func (ts *Tensor) Sub1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSub1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sub1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17303,7 +17303,7 @@ func (ts *Tensor) Sub_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSub_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sub_", ts, other); err != nil {
 		return err
 	}
 
@@ -17314,7 +17314,7 @@ func (ts *Tensor) Sub1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSub1_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sub1_", ts); err != nil {
 		return err
 	}
 
@@ -17328,10 +17328,10 @@ func (ts *Tensor) SubOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SubOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17343,10 +17343,10 @@ func (ts *Tensor) Subtract(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtract(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Subtract", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17358,10 +17358,10 @@ func (ts *Tensor) Subtract1(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtract1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Subtract1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17370,7 +17370,7 @@ func (ts *Tensor) Subtract_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtract_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Subtract_", ts, other); err != nil {
 		return err
 	}
 
@@ -17381,7 +17381,7 @@ func (ts *Tensor) Subtract1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtract1_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Subtract1_", ts); err != nil {
 		return err
 	}
 
@@ -17395,10 +17395,10 @@ func (ts *Tensor) SubtractOut(out *Tensor, other *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtractOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SubtractOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17410,10 +17410,10 @@ func (ts *Tensor) Sum(dtype gotch.DType, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSum(ptr, ts.ctensor, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sum", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17429,10 +17429,10 @@ func (ts *Tensor) Sum1(dim []int64, keepdim bool, dtype gotch.DType, del bool) (
 		ckeepdim = int32(1)
 	}
 	lib.AtgSum1(ptr, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Sum1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17448,10 +17448,10 @@ func (ts *Tensor) SumOut(out *Tensor, dim []int64, keepdim bool, dtype gotch.DTy
 		ckeepdim = int32(1)
 	}
 	lib.AtgSumOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SumOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17463,10 +17463,10 @@ func (ts *Tensor) SumToSize(size []int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSumToSize(ptr, ts.ctensor, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("SumToSize", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17478,10 +17478,10 @@ func (ts *Tensor) T(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgT(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("T", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17490,7 +17490,7 @@ func (ts *Tensor) T_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgT_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("T_", ts); err != nil {
 		return err
 	}
 
@@ -17504,10 +17504,10 @@ func (ts *Tensor) Take(index *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTake(ptr, ts.ctensor, index.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Take", ts, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17516,10 +17516,10 @@ func TakeBackward(grad *Tensor, input *Tensor, index *Tensor) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTakeBackward(ptr, grad.ctensor, input.ctensor, index.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TakeBackward", grad, input, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17531,10 +17531,10 @@ func (ts *Tensor) TakeOut(out *Tensor, index *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTakeOut(ptr, out.ctensor, ts.ctensor, index.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TakeOut", ts, out, index); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17546,10 +17546,10 @@ func (ts *Tensor) Tan(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTan(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Tan", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17558,7 +17558,7 @@ func (ts *Tensor) Tan_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTan_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Tan_", ts); err != nil {
 		return err
 	}
 
@@ -17572,10 +17572,10 @@ func (ts *Tensor) TanOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TanOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17587,10 +17587,10 @@ func (ts *Tensor) Tanh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanh(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Tanh", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17599,7 +17599,7 @@ func (ts *Tensor) Tanh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanh_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Tanh_", ts); err != nil {
 		return err
 	}
 
@@ -17610,10 +17610,10 @@ func TanhBackward(gradOutput *Tensor, output *Tensor) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanhBackward(ptr, gradOutput.ctensor, output.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TanhBackward", gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17622,10 +17622,10 @@ func TanhBackwardOut(gradInput *Tensor, gradOutput *Tensor, output *Tensor) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanhBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, output.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TanhBackwardOut", gradInput, gradOutput, output); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17637,10 +17637,10 @@ func (ts *Tensor) TanhOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanhOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TanhOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17652,10 +17652,10 @@ func (ts *Tensor) Tensordot(other *Tensor, dimsSelf []int64, dimsOther []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTensordot(ptr, ts.ctensor, other.ctensor, dimsSelf, len(dimsSelf), dimsOther, len(dimsOther))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Tensordot", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17667,10 +17667,10 @@ func (ts *Tensor) Threshold(threshold *Scalar, value *Scalar, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgThreshold(ptr, ts.ctensor, threshold.cscalar, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Threshold", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17679,7 +17679,7 @@ func (ts *Tensor) Threshold_(threshold *Scalar, value *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgThreshold_(ptr, ts.ctensor, threshold.cscalar, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Threshold_", ts); err != nil {
 		return err
 	}
 
@@ -17693,10 +17693,10 @@ func (ts *Tensor) ThresholdBackward(gradOutput *Tensor, threshold *Scalar, del b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgThresholdBackward(ptr, gradOutput.ctensor, ts.ctensor, threshold.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ThresholdBackward", ts, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17708,10 +17708,10 @@ func (ts *Tensor) ThresholdOut(out *Tensor, threshold *Scalar, value *Scalar, de
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgThresholdOut(ptr, out.ctensor, ts.ctensor, threshold.cscalar, value.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ThresholdOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17723,10 +17723,10 @@ func (ts *Tensor) To(device gotch.Device, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTo(ptr, ts.ctensor, device.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("To", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17746,10 +17746,10 @@ func (ts *Tensor) To1(optionsKind gotch.DType, optionsDevice gotch.Device, nonBl
 		ccopy = int32(1)
 	}
 	lib.AtgTo1(ptr, ts.ctensor, optionsKind.CInt(), optionsDevice.CInt(), cnonBlocking, ccopy)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("To1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17769,10 +17769,10 @@ func (ts *Tensor) To2(dtype gotch.DType, nonBlocking bool, copy bool, del bool)
 		ccopy = int32(1)
 	}
 	lib.AtgTo2(ptr, ts.ctensor, dtype.CInt(), cnonBlocking, ccopy)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("To2", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17792,10 +17792,10 @@ func (ts *Tensor) To3(other *Tensor, nonBlocking bool, copy bool, del bool) (ret
 		ccopy = int32(1)
 	}
 	lib.AtgTo3(ptr, ts.ctensor, other.ctensor, cnonBlocking, ccopy)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("To3", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17815,10 +17815,10 @@ func (ts *Tensor) To4(device gotch.Device, dtype gotch.DType, nonBlocking bool,
 		ccopy = int32(1)
 	}
 	lib.AtgTo4(ptr, ts.ctensor, device.CInt(), dtype.CInt(), cnonBlocking, ccopy)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("To4", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17830,10 +17830,10 @@ func (ts *Tensor) ToDense(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToDense(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ToDense", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17842,10 +17842,10 @@ func ToDenseBackward(grad *Tensor, input *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToDenseBackward(ptr, grad.ctensor, input.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ToDenseBackward", grad, input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17857,10 +17857,10 @@ func (ts *Tensor) ToMkldnn(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToMkldnn(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ToMkldnn", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17869,10 +17869,10 @@ func ToMkldnnBackward(grad *Tensor, input *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToMkldnnBackward(ptr, grad.ctensor, input.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ToMkldnnBackward", grad, input); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17884,10 +17884,10 @@ func (ts *Tensor) ToSparse(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToSparse(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ToSparse", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17899,10 +17899,10 @@ func (ts *Tensor) ToSparse1(sparseDim int64, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToSparse1(ptr, ts.ctensor, sparseDim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ToSparse1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17914,10 +17914,10 @@ func (ts *Tensor) Totype(scalarType gotch.DType, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTotype(ptr, ts.ctensor, scalarType.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Totype", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17929,10 +17929,10 @@ func (ts *Tensor) Trace(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrace(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Trace", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17941,10 +17941,10 @@ func TraceBackward(grad *Tensor, sizes []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTraceBackward(ptr, grad.ctensor, sizes, len(sizes))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TraceBackward", grad); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17956,10 +17956,10 @@ func (ts *Tensor) Transpose(dim0 int64, dim1 int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTranspose(ptr, ts.ctensor, dim0, dim1)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Transpose", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17968,7 +17968,7 @@ func (ts *Tensor) Transpose_(dim0 int64, dim1 int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTranspose_(ptr, ts.ctensor, dim0, dim1)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Transpose_", ts); err != nil {
 		return err
 	}
 
@@ -17979,10 +17979,10 @@ func Trapz(y *Tensor, x *Tensor, dim int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrapz(ptr, y.ctensor, x.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Trapz", y, x); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17991,10 +17991,10 @@ func Trapz1(y *Tensor, dx float64, dim int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrapz1(ptr, y.ctensor, dx, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Trapz1", y); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18006,10 +18006,10 @@ func (ts *Tensor) Tril(diagonal int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTril(ptr, ts.ctensor, diagonal)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Tril", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18018,7 +18018,7 @@ func (ts *Tensor) Tril_(diagonal int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTril_(ptr, ts.ctensor, diagonal)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Tril_", ts); err != nil {
 		return err
 	}
 
@@ -18029,10 +18029,10 @@ func TrilIndices(row int64, col int64, offset int64, optionsKind gotch.DType, op
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrilIndices(ptr, row, col, offset, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TrilIndices"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18044,10 +18044,10 @@ func (ts *Tensor) TrilOut(out *Tensor, diagonal int64, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrilOut(ptr, out.ctensor, ts.ctensor, diagonal)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TrilOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18060,10 +18060,10 @@ func TripletMarginLoss(anchor *Tensor, positive *Tensor, negative *Tensor, margi
 		cswap = int32(1)
 	}
 	lib.AtgTripletMarginLoss(ptr, anchor.ctensor, positive.ctensor, negative.ctensor, margin, p, eps, cswap, reduction)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TripletMarginLoss", anchor, positive, negative); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18075,10 +18075,10 @@ func (ts *Tensor) Triu(diagonal int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTriu(ptr, ts.ctensor, diagonal)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Triu", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18087,7 +18087,7 @@ func (ts *Tensor) Triu_(diagonal int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTriu_(ptr, ts.ctensor, diagonal)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Triu_", ts); err != nil {
 		return err
 	}
 
@@ -18098,10 +18098,10 @@ func TriuIndices(row int64, col int64, offset int64, optionsKind gotch.DType, op
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTriuIndices(ptr, row, col, offset, optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TriuIndices"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18113,10 +18113,10 @@ func (ts *Tensor) TriuOut(out *Tensor, diagonal int64, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTriuOut(ptr, out.ctensor, ts.ctensor, diagonal)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TriuOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18128,10 +18128,10 @@ func (ts *Tensor) TrueDivide(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivide(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TrueDivide", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18143,10 +18143,10 @@ func (ts *Tensor) TrueDivide1(other *Scalar, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivide1(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TrueDivide1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18155,7 +18155,7 @@ func (ts *Tensor) TrueDivide_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivide_(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TrueDivide_", ts, other); err != nil {
 		return err
 	}
 
@@ -18166,7 +18166,7 @@ func (ts *Tensor) TrueDivide1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivide1_(ptr, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TrueDivide1_", ts); err != nil {
 		return err
 	}
 
@@ -18180,10 +18180,10 @@ func (ts *Tensor) TrueDivideOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivideOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TrueDivideOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18195,10 +18195,10 @@ func (ts *Tensor) Trunc(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrunc(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Trunc", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18207,7 +18207,7 @@ func (ts *Tensor) Trunc_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrunc_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Trunc_", ts); err != nil {
 		return err
 	}
 
@@ -18221,10 +18221,10 @@ func (ts *Tensor) TruncOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTruncOut(ptr, out.ctensor, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TruncOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18236,10 +18236,10 @@ func (ts *Tensor) TypeAs(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTypeAs(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("TypeAs", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18251,10 +18251,10 @@ func (ts *Tensor) Unflatten(dim int64, sizes []int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnflatten(ptr, ts.ctensor, dim, sizes, len(sizes))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Unflatten", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18266,10 +18266,10 @@ func (ts *Tensor) Unfold(dimension int64, size int64, step int64, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnfold(ptr, ts.ctensor, dimension, size, step)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Unfold", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18278,10 +18278,10 @@ func UnfoldBackward(gradIn *Tensor, inputSizes []int64, dim int64, size int64, s
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnfoldBackward(ptr, gradIn.ctensor, inputSizes, len(inputSizes), dim, size, step)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UnfoldBackward", gradIn); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18290,7 +18290,7 @@ func (ts *Tensor) Uniform_(from float64, to float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUniform_(ptr, ts.ctensor, from, to)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Uniform_", ts); err != nil {
 		return err
 	}
 
@@ -18304,10 +18304,10 @@ func (ts *Tensor) Unsqueeze(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnsqueeze(ptr, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Unsqueeze", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18316,7 +18316,7 @@ func (ts *Tensor) Unsqueeze_(dim int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnsqueeze_(ptr, ts.ctensor, dim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Unsqueeze_", ts); err != nil {
 		return err
 	}
 
@@ -18346,10 +18346,10 @@ func (ts *Tensor) UpsampleBicubic2d(outputSize []int64, alignCorners bool, scale
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBicubic2d(ptr, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleBicubic2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18374,10 +18374,10 @@ func UpsampleBicubic2dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBicubic2dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleBicubic2dBackward", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18402,10 +18402,10 @@ func UpsampleBicubic2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputS
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBicubic2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleBicubic2dBackwardOut", gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18433,10 +18433,10 @@ func (ts *Tensor) UpsampleBicubic2dOut(out *Tensor, outputSize []int64, alignCor
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBicubic2dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleBicubic2dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18464,10 +18464,10 @@ func (ts *Tensor) UpsampleBilinear2d(outputSize []int64, alignCorners bool, scal
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBilinear2d(ptr, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleBilinear2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18492,10 +18492,10 @@ func UpsampleBilinear2dBackward(gradOutput *Tensor, outputSize []int64, inputSiz
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBilinear2dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleBilinear2dBackward", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18520,10 +18520,10 @@ func UpsampleBilinear2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, output
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBilinear2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleBilinear2dBackwardOut", gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18551,10 +18551,10 @@ func (ts *Tensor) UpsampleBilinear2dOut(out *Tensor, outputSize []int64, alignCo
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBilinear2dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleBilinear2dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18576,10 +18576,10 @@ func (ts *Tensor) UpsampleLinear1d(outputSize []int64, alignCorners bool, scales
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleLinear1d(ptr, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesVal, cscalesNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleLinear1d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18598,10 +18598,10 @@ func UpsampleLinear1dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleLinear1dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesVal, cscalesNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleLinear1dBackward", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18620,10 +18620,10 @@ func UpsampleLinear1dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputSi
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleLinear1dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesVal, cscalesNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleLinear1dBackwardOut", gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18645,10 +18645,10 @@ func (ts *Tensor) UpsampleLinear1dOut(out *Tensor, outputSize []int64, alignCorn
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleLinear1dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesVal, cscalesNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleLinear1dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18666,10 +18666,10 @@ func (ts *Tensor) UpsampleNearest1d(outputSize []int64, scales []float64, del bo
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleNearest1d(ptr, ts.ctensor, outputSize, len(outputSize), cscalesVal, cscalesNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest1d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18684,10 +18684,10 @@ func UpsampleNearest1dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleNearest1dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesVal, cscalesNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest1dBackward", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18702,10 +18702,10 @@ func UpsampleNearest1dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputS
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleNearest1dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesVal, cscalesNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest1dBackwardOut", gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18723,10 +18723,10 @@ func (ts *Tensor) UpsampleNearest1dOut(out *Tensor, outputSize []int64, scales [
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleNearest1dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), cscalesVal, cscalesNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest1dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18750,10 +18750,10 @@ func (ts *Tensor) UpsampleNearest2d(outputSize []int64, scalesH []float64, scale
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest2d(ptr, ts.ctensor, outputSize, len(outputSize), cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest2d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18774,10 +18774,10 @@ func UpsampleNearest2dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest2dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest2dBackward", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18798,10 +18798,10 @@ func UpsampleNearest2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputS
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest2dBackwardOut", gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18825,10 +18825,10 @@ func (ts *Tensor) UpsampleNearest2dOut(out *Tensor, outputSize []int64, scalesH
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest2dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest2dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18858,10 +18858,10 @@ func (ts *Tensor) UpsampleNearest3d(outputSize []int64, scalesD []float64, scale
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest3d(ptr, ts.ctensor, outputSize, len(outputSize), cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest3d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18888,10 +18888,10 @@ func UpsampleNearest3dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest3dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest3dBackward", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18918,10 +18918,10 @@ func UpsampleNearest3dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputS
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest3dBackwardOut", gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18951,10 +18951,10 @@ func (ts *Tensor) UpsampleNearest3dOut(out *Tensor, outputSize []int64, scalesD
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest3dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleNearest3dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18988,10 +18988,10 @@ func (ts *Tensor) UpsampleTrilinear3d(outputSize []int64, alignCorners bool, sca
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleTrilinear3d(ptr, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleTrilinear3d", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19022,10 +19022,10 @@ func UpsampleTrilinear3dBackward(gradOutput *Tensor, outputSize []int64, inputSi
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleTrilinear3dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleTrilinear3dBackward", gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19056,10 +19056,10 @@ func UpsampleTrilinear3dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outpu
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleTrilinear3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleTrilinear3dBackwardOut", gradInput, gradOutput); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19093,10 +19093,10 @@ func (ts *Tensor) UpsampleTrilinear3dOut(out *Tensor, outputSize []int64, alignC
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleTrilinear3dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("UpsampleTrilinear3dOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19109,10 +19109,10 @@ func ValueSelectingReductionBackward(grad *Tensor, dim int64, indices *Tensor, s
 		ckeepdim = int32(1)
 	}
 	lib.AtgValueSelectingReductionBackward(ptr, grad.ctensor, dim, indices.ctensor, sizes, len(sizes), ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ValueSelectingReductionBackward", grad, indices); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19124,10 +19124,10 @@ func (ts *Tensor) Values(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgValues(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Values", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19146,10 +19146,10 @@ func Vander(x *Tensor, n []int64, increasing bool) (retVal *Tensor, err error) {
 		cincreasing = int32(1)
 	}
 	lib.AtgVander(ptr, x.ctensor, cnVal, cnNull, cincreasing)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Vander", x); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19165,10 +19165,10 @@ func (ts *Tensor) Var(unbiased bool, del bool) (retVal *Tensor, err error) {
 		cunbiased = int32(1)
 	}
 	lib.AtgVar(ptr, ts.ctensor, cunbiased)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Var", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19188,10 +19188,10 @@ func (ts *Tensor) Var1(dim []int64, unbiased bool, keepdim bool, del bool) (retV
 		ckeepdim = int32(1)
 	}
 	lib.AtgVar1(ptr, ts.ctensor, dim, len(dim), cunbiased, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Var1", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19211,10 +19211,10 @@ func (ts *Tensor) VarOut(out *Tensor, dim []int64, unbiased bool, keepdim bool,
 		ckeepdim = int32(1)
 	}
 	lib.AtgVarOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), cunbiased, ckeepdim)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("VarOut", ts, out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19226,10 +19226,10 @@ func (ts *Tensor) Vdot(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgVdot(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Vdot", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19241,10 +19241,10 @@ func (ts *Tensor) VdotOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgVdotOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("VdotOut", ts, out, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19256,10 +19256,10 @@ func (ts *Tensor) View(size []int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgView(ptr, ts.ctensor, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("View", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19271,10 +19271,10 @@ func (ts *Tensor) ViewAs(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgViewAs(ptr, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ViewAs", ts, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19286,10 +19286,10 @@ func (ts *Tensor) ViewAsComplex(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgViewAsComplex(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ViewAsComplex", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19301,10 +19301,10 @@ func (ts *Tensor) ViewAsReal(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgViewAsReal(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ViewAsReal", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19317,10 +19317,10 @@ func Vstack(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgVstack(ptr, ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Vstack"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19333,10 +19333,10 @@ func VstackOut(out *Tensor, tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgVstackOut(ptr, out.ctensor, ctensors, len(ctensors))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("VstackOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19348,10 +19348,10 @@ func (ts *Tensor) Where1(condition *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgWhere1(ptr, condition.ctensor, ts.ctensor, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Where1", ts, condition, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19360,10 +19360,10 @@ func Where2(condition *Tensor, selfScalar *Scalar, other *Tensor) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgWhere2(ptr, condition.ctensor, selfScalar.cscalar, other.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Where2", condition, other); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19375,10 +19375,10 @@ func (ts *Tensor) Where3(condition *Tensor, other *Scalar, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgWhere3(ptr, condition.ctensor, ts.ctensor, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Where3", ts, condition); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19387,10 +19387,10 @@ func Where4(condition *Tensor, selfScalar *Scalar, other *Scalar) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgWhere4(ptr, condition.ctensor, selfScalar.cscalar, other.cscalar)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Where4", condition); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19399,7 +19399,7 @@ func (ts *Tensor) Zero_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgZero_(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Zero_", ts); err != nil {
 		return err
 	}
 
@@ -19410,10 +19410,10 @@ func Zeros(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgZeros(ptr, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("Zeros"); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19425,10 +19425,10 @@ func (ts *Tensor) ZerosLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgZerosLike(ptr, ts.ctensor)
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ZerosLike", ts); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19437,10 +19437,10 @@ func ZerosOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgZerosOut(ptr, out.ctensor, size, len(size))
-	if err = TorchErr(); err != nil {
+	if err = torchErrOp("ZerosOut", out); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
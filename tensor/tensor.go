@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"runtime"
+	"sync/atomic"
 	"unsafe"
 
 	gotch "github.com/sugarme/gotch"
@@ -29,13 +31,23 @@ var None = NewTensor()
 // NewTensor creates a new tensor
 func NewTensor() *Tensor {
 	ctensor := lib.AtNewTensor()
-	return &Tensor{ctensor}
+	return newTensor(ctensor)
 }
 
-func (ts *Tensor) Dim() uint64 {
+// DimE returns number of dimensions of the tensor.
+func (ts *Tensor) DimE() (uint64, error) {
 	dim := lib.AtDim(ts.ctensor)
 	if err := TorchErr(); err != nil {
-		log.Fatal(err)
+		return 0, err
+	}
+	return dim, nil
+}
+
+// Dim returns number of dimensions of the tensor. It panics if error occurred.
+func (ts *Tensor) Dim() uint64 {
+	dim, err := ts.DimE()
+	if err != nil {
+		panic(err)
 	}
 	return dim
 }
@@ -59,9 +71,7 @@ func (ts *Tensor) Size() ([]int64, error) {
 		return nil, err
 	}
 
-	shape := decodeSize(szPtr, dim)
-
-	return shape, nil
+	return decodeSize(szPtr, dim)
 }
 
 func (ts *Tensor) MustSize() []int64 {
@@ -133,24 +143,24 @@ func (ts *Tensor) Size4() ([]int64, error) {
 	return shape, nil
 }
 
-func decodeSize(ptr unsafe.Pointer, nsize uint64) []int64 {
+func decodeSize(ptr unsafe.Pointer, nsize uint64) ([]int64, error) {
 	// Decode sz
 	// 1. Count number of elements in data
 	elementNum := nsize
 	// 2. Element size in bytes
 	eltSizeInBytes, err := gotch.DTypeSize(gotch.Int64)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	nbytes := int(eltSizeInBytes) * int(elementNum)
 	dataSlice := (*[1 << 30]byte)(ptr)[:nbytes:nbytes]
 	r := bytes.NewReader(dataSlice)
 	dataIn := make([]int64, nsize)
 	if err := binary.Read(r, nativeEndian, dataIn); err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	return dataIn
+	return dataIn, nil
 }
 
 // OfSlice creates tensor from a slice data
@@ -193,7 +203,7 @@ func OfSlice(data interface{}) (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // OfDataSize creates Tensor from input byte data, shape and dtype.
@@ -229,7 +239,7 @@ func OfDataSize(data []byte, shape []int64, dtype gotch.DType) (*Tensor, error)
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // MustOfDataSize create Tensor from input byte data and specified shape and dtype
@@ -254,10 +264,11 @@ func MustOfSlice(data interface{}) *Tensor {
 }
 
 // TensorFrom create a tensor from slice of data. It will be panic if error.
+// Use `OfSlice` to get the error instead.
 func TensorFrom(data interface{}) *Tensor {
 	ts, err := OfSlice(data)
 	if err != nil {
-		log.Fatal(err)
+		panic(err)
 	}
 	return ts
 }
@@ -266,11 +277,9 @@ func TensorFrom(data interface{}) *Tensor {
 //
 // NOTE: it is printed from C and will print ALL elements of tensor
 // with no truncation at all.
-func (ts *Tensor) Print() {
+func (ts *Tensor) Print() error {
 	lib.AtPrint(ts.ctensor)
-	if err := TorchErr(); err != nil {
-		log.Fatal(err)
-	}
+	return TorchErr()
 }
 
 // NewTensorFromData creates tensor from given data and shape
@@ -317,15 +326,29 @@ func NewTensorFromData(data interface{}, shape []int64) (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
-func (ts *Tensor) DType() gotch.DType {
+// DTypeE returns data type of the tensor.
+func (ts *Tensor) DTypeE() (gotch.DType, error) {
 	cint := lib.AtScalarType(ts.ctensor)
+	if err := TorchErr(); err != nil {
+		return gotch.DType{}, err
+	}
 
 	dtype, err := gotch.CInt2DType(cint)
 	if err != nil {
-		log.Fatalf("Tensor DType error: %v\n", err)
+		return gotch.DType{}, fmt.Errorf("Tensor DType error: %v\n", err)
+	}
+
+	return dtype, nil
+}
+
+// DType returns data type of the tensor. It panics if error occurred.
+func (ts *Tensor) DType() gotch.DType {
+	dtype, err := ts.DTypeE()
+	if err != nil {
+		panic(err)
 	}
 
 	return dtype
@@ -504,6 +527,27 @@ func (ts *Tensor) IsSparse() (bool, error) {
 	return state, nil
 }
 
+// IsContiguous returns true if elements of the tensor are stored contiguously
+// in row-major order.
+func (ts *Tensor) IsContiguous() (bool, error) {
+	state := lib.AtIsContiguous(ts.ctensor)
+
+	if err := TorchErr(); err != nil {
+		return false, err
+	}
+
+	return state, nil
+}
+
+func (ts *Tensor) MustIsContiguous() bool {
+	state, err := ts.IsContiguous()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return state
+}
+
 // ZeroGrad zeroes the gradient tensor attached to this tensor if defined.
 func (ts *Tensor) ZeroGrad() {
 	grad := ts.MustGrad(false)
@@ -516,10 +560,11 @@ func (ts *Tensor) ZeroGrad() {
 // Backward runs the backward pass, populating the gradient tensors for tensors
 // which gradients are tracked.
 //
-// Gradients tracking can be turned on via `SetRequiresGrad`.
+// Gradients tracking can be turned on via `SetRequiresGrad`. See
+// `AnomalyModeSetEnabled` to detect NaN or Inf gradients.
 func (ts *Tensor) Backward() error {
 	lib.AtBackward(ts.ctensor, 0, 0)
-	if err := TorchErr(); err != nil {
+	if err := torchErrOp("Backward", ts); err != nil {
 		return err
 	}
 
@@ -532,34 +577,39 @@ func (ts *Tensor) MustBackward() {
 	}
 }
 
-// RunBackward runs the backward ...
+// RunBackward runs the backward pass from `tensors` (gradients of them are
+// set to ones) and returns gradients of `inputs`, one for each input. Unlike
+// `Backward`, gradients are returned instead of being accumulated to `Grad` of
+// the inputs.
+//
+// If `keepGraphB` is true, the graph is kept so that backward can be run
+// again. If `createGraphB` is true, graph of the gradients is created so that
+// they can be differentiated (double backward). Gradient of an input not
+// reachable from `tensors` is undefined.
 func RunBackward(tensors []Tensor, inputs []Tensor, keepGraphB bool, createGraphB bool) ([]Tensor, error) {
-	// NOTE: outputs is a slice of tensors with length = len(inputs)
-	var outputsPtr []*lib.Ctensor
-	// Are they allocated contigously??? Definitely not.
-	// TODO. calculate C memory size = C pointer size x n pointers
-	// Then C.malloc such calculated amount
-	// NOTE. replace with the following code and test.
-	/*
-	 *   ntensors := len(inputs)
-	 *   nbytes := C.size_t(ntensors) * C.size_t(unsafe.Sizeof(uintptr(0)))
-	 *   ctensorsPtr := (*[1 << 30]lib.Ctensor)(C.malloc(nbytes))
-	 *   for i :=0; i < ntensors; i++ {
-	 *     outputPtr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
-	 *     outputsPtr[i] = outputPtr
-	 *   }
-	 *  */
-	for i := 0; i < len(inputs); i++ {
-		outputPtr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
-		defer C.free(unsafe.Pointer(outputPtr))
-		outputsPtr = append(outputsPtr, outputPtr)
-	}
-
-	// Get first element pointer
-	ctensor := tensors[0].ctensor
-	cinput := inputs[0].ctensor
-	tensorsPtr := (*lib.Ctensor)(unsafe.Pointer(&ctensor))
-	inputsPtr := (*lib.Ctensor)(unsafe.Pointer(&cinput))
+	ntensors := len(tensors)
+	ninputs := len(inputs)
+	if ntensors == 0 || ninputs == 0 {
+		err := fmt.Errorf("RunBackward() failed: empty tensors (%v) or inputs (%v).\n", ntensors, ninputs)
+		return nil, err
+	}
+
+	// NOTE. tensors may not contain consecutive Ctensor pointers. Copy them to
+	// C arrays.
+	ptrSize := C.size_t(unsafe.Sizeof(uintptr(0)))
+	ctensorsPtr := (*[1 << 28]lib.Ctensor)(C.malloc(C.size_t(ntensors) * ptrSize))
+	defer C.free(unsafe.Pointer(ctensorsPtr))
+	for i := 0; i < ntensors; i++ {
+		ctensorsPtr[i] = tensors[i].ctensor
+	}
+	cinputsPtr := (*[1 << 28]lib.Ctensor)(C.malloc(C.size_t(ninputs) * ptrSize))
+	defer C.free(unsafe.Pointer(cinputsPtr))
+	coutputsPtr := (*[1 << 28]lib.Ctensor)(C.malloc(C.size_t(ninputs) * ptrSize))
+	defer C.free(unsafe.Pointer(coutputsPtr))
+	for i := 0; i < ninputs; i++ {
+		cinputsPtr[i] = inputs[i].ctensor
+	}
+
 	var keepGraph int = 0
 	if keepGraphB {
 		keepGraph = 1
@@ -569,20 +619,30 @@ func RunBackward(tensors []Tensor, inputs []Tensor, keepGraphB bool, createGraph
 		createGraph = 1
 	}
 
-	lib.AtRunBackward(tensorsPtr, len(tensors), inputsPtr, len(inputs), outputsPtr[0], keepGraph, createGraph)
+	lib.AtRunBackward(&ctensorsPtr[0], ntensors, &cinputsPtr[0], ninputs, &coutputsPtr[0], keepGraph, createGraph)
 	if err := TorchErr(); err != nil {
 		return nil, err
 	}
 
 	var oTensors []Tensor
-	for i := 0; i < len(inputs); i++ {
-		outputPtr := outputsPtr[i]
-		oTensors = append(oTensors, Tensor{ctensor: *outputPtr})
+	for i := 0; i < ninputs; i++ {
+		oTensors = append(oTensors, newTensorValue(coutputsPtr[i]))
 	}
 
 	return oTensors, nil
 }
 
+// MustRunBackward runs the backward pass and returns gradients of `inputs`.
+// It panics if error occurred.
+func MustRunBackward(tensors []Tensor, inputs []Tensor, keepGraphB bool, createGraphB bool) []Tensor {
+	grads, err := RunBackward(tensors, inputs, keepGraphB, createGraphB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return grads
+}
+
 // CopyDataUint8 copies `numel` elements from `self` to `dst`.
 //
 // NOTE: `dst` located in Go memory. Should it be?
@@ -616,7 +676,9 @@ func (ts *Tensor) MustCopyDataUint8(dst []uint8, numel uint) {
 }
 
 // CopyData copies `numel` elements from `self` to `dst`.
-// `dst` should be a slice of Go type equivalent to tensor type.
+// `dst` should be a slice of Go type equivalent to tensor type. For tensor of
+// Half or BFloat16 dtype, `dst` is `[]float32` and for ComplexHalf, `dst` is
+// `[]complex64`.
 //
 // NOTE: `dst` located in Go memory. Should it be?
 // We will render Go pointer of first element of `dst` slice
@@ -640,6 +702,18 @@ func (ts *Tensor) CopyData(dst interface{}, numel uint) error {
 	}
 
 	if ts.DType() != dtype {
+		// Half, BFloat16 and ComplexHalf have no Go types. Their values are
+		// converted to `float32` or `complex64` respectively.
+		if gotype, err := gotch.ToGoType(ts.DType()); err == nil && gotype == dtype.Type {
+			x, err := ts.Totype(dtype, false)
+			if err != nil {
+				return err
+			}
+			defer x.MustDrop()
+
+			return x.CopyData(dst, numel)
+		}
+
 		err = fmt.Errorf("Type mismatched: `dst` type: %v, tensor DType: %v", dtype, ts.DType())
 		return err
 	}
@@ -660,6 +734,10 @@ func (ts *Tensor) CopyData(dst interface{}, numel uint) error {
 		vs = unsafe.Pointer(&dst.([]float32)[0])
 	case gotch.Double:
 		vs = unsafe.Pointer(&dst.([]float64)[0])
+	case gotch.ComplexFloat:
+		vs = unsafe.Pointer(&dst.([]complex64)[0])
+	case gotch.ComplexDouble:
+		vs = unsafe.Pointer(&dst.([]complex128)[0])
 	case gotch.Bool:
 		vs = unsafe.Pointer(&dst.([]bool)[0])
 	default:
@@ -679,6 +757,28 @@ func (ts *Tensor) CopyData(dst interface{}, numel uint) error {
 	return nil
 }
 
+// rawData copies all elements of the tensor to a byte slice as stored in
+// C memory.
+func (ts *Tensor) rawData() ([]byte, error) {
+	numel := ts.Numel()
+	eltSizeInBytes, err := gotch.DTypeSize(ts.DType())
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, numel*eltSizeInBytes)
+	if numel == 0 {
+		return data, nil
+	}
+
+	lib.AtCopyData(ts.ctensor, unsafe.Pointer(&data[0]), numel, eltSizeInBytes)
+	if err = TorchErr(); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
 // MustCopyData copies number of elements from tensor to a slice of data
 //
 // NOTE: `dst` is a slice with length = numel and Go type equavalent to tensor
@@ -705,7 +805,7 @@ func (ts *Tensor) ShallowClone() (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // MustShallowClone returns a new tensor that share storage with the input
@@ -727,7 +827,7 @@ func (ts *Tensor) Get(index int) (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // MustGet gets the sub-tensor at the given index. It will panic if error
@@ -743,19 +843,23 @@ func (ts *Tensor) MustGet(index int) *Tensor {
 }
 
 // Copy_ copies in-place values from the argument tensor to the input tensor.
-func Copy_(self, src *Tensor) {
+func Copy_(self, src *Tensor) error {
 
 	lib.AtCopy_(self.ctensor, src.ctensor)
-	if err := TorchErr(); err != nil {
-		log.Fatal(err)
-	}
+	return torchErrOp("Copy_", self, src)
 }
 
 // Copy_ copies in-place values from the argument tensor to existing tensor
-func (ts *Tensor) Copy_(src *Tensor) {
+func (ts *Tensor) Copy_(src *Tensor) error {
 
 	lib.AtCopy_(ts.ctensor, src.ctensor)
-	if err := TorchErr(); err != nil {
+	return torchErrOp("Copy_", ts, src)
+}
+
+// MustCopy_ copies in-place values from the argument tensor to existing tensor.
+// It panics if error occurred.
+func (ts *Tensor) MustCopy_(src *Tensor) {
+	if err := ts.Copy_(src); err != nil {
 		log.Fatal(err)
 	}
 }
@@ -786,7 +890,7 @@ func Load(path string) (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // MustLoad loads a tensor to a file. It will panic if error
@@ -852,7 +956,7 @@ func LoadMulti(path string) ([]NamedTensor, error) {
 	for _, v := range data.NamedCtensors {
 		namedTensor := NamedTensor{
 			Name:   v.Name,
-			Tensor: &Tensor{v.Ctensor},
+			Tensor: newTensor(v.Ctensor),
 		}
 
 		namedTensors = append(namedTensors, namedTensor)
@@ -887,7 +991,7 @@ func LoadMultiWithDevice(path string, device gotch.Device) ([]NamedTensor, error
 	for _, v := range data.NamedCtensors {
 		namedTensor := NamedTensor{
 			Name:   v.Name,
-			Tensor: &Tensor{v.Ctensor},
+			Tensor: newTensor(v.Ctensor),
 		}
 
 		namedTensors = append(namedTensors, namedTensor)
@@ -934,6 +1038,13 @@ func (ts *Tensor) MustToString(lw int64) string {
 
 // Drop drops (frees) the tensor
 func (ts *Tensor) Drop() error {
+	if atomic.LoadInt32(&registered) != 0 || atomic.LoadInt32(&liveNum) != 0 {
+		scopeMu.Lock()
+		untrack(ts.ctensor)
+		removeLive(ts.ctensor)
+		scopeMu.Unlock()
+	}
+
 	lib.AtFree(ts.ctensor)
 	if err := TorchErr(); err != nil {
 		return err
@@ -949,9 +1060,17 @@ func (ts *Tensor) MustDrop() {
 	}
 }
 
-// GradSetEnabled sets globally whether GradMode gradient accumulation is enable or not.
+// GradSetEnabled sets whether GradMode gradient accumulation is enable or not.
 // It returns PREVIOUS state of Grad before setting.
+//
+// NOTE. Libtorch grad mode is local to the OS thread that sets it while a
+// goroutine can be moved to other OS threads at any time. Calling this func
+// alone can therefore affect other goroutines or be lost. Use `NoGrad`,
+// `NoGradGuard` or `InferenceMode` which pin the goroutine to its OS thread
+// instead.
 func GradSetEnabled(b bool) (bool, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
 
 	var cbool, cretVal int
 	switch b {
@@ -988,7 +1107,7 @@ func GradSetEnabled(b bool) (bool, error) {
 	return state, nil
 }
 
-// MustGradSetEnabled sets globally whether GradMode gradient accumuation is enable or not.
+// MustGradSetEnabled sets whether GradMode gradient accumuation is enable or not.
 // It returns PREVIOUS state of Grad before setting. It will be panic if error
 func MustGradSetEnabled(b bool) bool {
 	state, err := GradSetEnabled(b)
@@ -999,8 +1118,20 @@ func MustGradSetEnabled(b bool) bool {
 	return state
 }
 
-// NoGrad runs a closure without keeping track of gradients.
+// NoGrad runs a closure without keeping track of gradients. It panics if `fn`
+// is not a function.
+//
+// The calling goroutine is locked to its OS thread while running the closure
+// so that other goroutines are not affected. Goroutines started inside the
+// closure do not inherit no-grad mode.
 func NoGrad(fn interface{}) {
+	if err := NoGradE(fn); err != nil {
+		panic(err)
+	}
+}
+
+// NoGradE is like `NoGrad` but returns error if `fn` is not a function.
+func NoGradE(fn interface{}) error {
 
 	// TODO: This is weird but somehow we need to trigger C++ print
 	// to get loss function updated. Probably it is related to
@@ -1010,21 +1141,20 @@ func NoGrad(fn interface{}) {
 	newTs := NewTensor()
 	newTs.Drop()
 
-	// Switch off Grad
-	prev := MustGradSetEnabled(false)
-
 	// Analyze input as function. If not, throw error
 	f, err := NewFunc(fn)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
+	// Switch off Grad
+	guard := NewNoGradGuard()
+	defer guard.Drop()
+
 	// invokes the function
 	f.Invoke()
 
-	// Switch on Grad
-	_ = MustGradSetEnabled(prev)
-
+	return nil
 }
 
 func NoGrad1(fn func() interface{}) interface{} {
@@ -1032,14 +1162,10 @@ func NoGrad1(fn func() interface{}) interface{} {
 	newTs.Drop()
 
 	// Switch off Grad
-	prev := MustGradSetEnabled(false)
+	guard := NewNoGradGuard()
+	defer guard.Drop()
 
-	retVal := fn()
-
-	// Switch on Grad
-	_ = MustGradSetEnabled(prev)
-
-	return retVal
+	return fn()
 }
 
 // NoGradGuard is a RAII guard that prevents gradient tracking until deallocated.
@@ -1049,9 +1175,12 @@ func NoGrad1(fn func() interface{}) interface{} {
 // That way it is similar to a with torch.no_grad(): block in python.
 // Ref. https://discuss.pytorch.org/t/how-does-nogradguard-works-in-cpp/34960/2
 //
-// TODO: should we implement Go `mutex` here???
+// As the flag is local to OS thread, the guard locks the calling goroutine to
+// its OS thread until `Drop` is called. `Drop` must be called (usually with
+// `defer`) from the same goroutine.
 type NoGradGuard struct {
-	enabled bool
+	enabled bool // grad mode before the guard
+	dropped bool
 }
 
 // Init NoGradGuard and disables gradient tracking
@@ -1062,13 +1191,19 @@ func NewNoGradGuard() *NoGradGuard {
 // Disables gradient tracking, this will be enabled back when the
 // returned value gets deallocated.
 func noGradGuardInit() *NoGradGuard {
+	runtime.LockOSThread()
 	return &NoGradGuard{enabled: MustGradSetEnabled(false)}
 }
 
-// Drop drops the NoGradGuard state.
+// Drop restores grad mode before the guard was created and unlocks the
+// goroutine from its OS thread. Calling Drop more than once is a no-op.
 func (ngg *NoGradGuard) Drop() {
-	ngg.enabled = true
+	if ngg.dropped {
+		return
+	}
+	ngg.dropped = true
 	_ = MustGradSetEnabled(ngg.enabled)
+	runtime.UnlockOSThread()
 }
 
 func (ngg *NoGradGuard) Enable() {
@@ -1076,6 +1211,43 @@ func (ngg *NoGradGuard) Enable() {
 	_ = MustGradSetEnabled(ngg.enabled)
 }
 
+// InferenceModeGuard is a guard for running inference. While it is alive,
+// gradient tracking is disabled for the calling goroutine which is locked to
+// its OS thread, so that inference goroutines can run in the same process as
+// training goroutines without interfering each other.
+//
+// NOTE. Libtorch 1.7 does not have c10::InferenceMode. The guard currently
+// disables grad mode only.
+type InferenceModeGuard struct {
+	guard *NoGradGuard
+}
+
+// NewInferenceModeGuard creates an InferenceModeGuard. `Drop` must be called
+// (usually with `defer`) from the same goroutine.
+func NewInferenceModeGuard() *InferenceModeGuard {
+	return &InferenceModeGuard{guard: NewNoGradGuard()}
+}
+
+// Drop restores grad mode and unlocks the goroutine from its OS thread.
+func (g *InferenceModeGuard) Drop() {
+	g.guard.Drop()
+}
+
+// InferenceMode runs fn in inference mode. See `InferenceModeGuard`.
+//
+// Example:
+//
+//	var out *ts.Tensor
+//	ts.InferenceMode(func() {
+//		out = model.ForwardT(x, false)
+//	})
+func InferenceMode(fn func()) {
+	guard := NewInferenceModeGuard()
+	defer guard.Drop()
+
+	fn()
+}
+
 // Reduction type is an enum-like type
 type Reduction int
 
@@ -1107,10 +1279,25 @@ func (r Reduction) ToInt() int {
 }
 
 // Float64Values returns values of tensor in a slice of float64.
+//
+// NOTE. For complex tensor, only real parts are returned.
 func (ts *Tensor) Float64Values() []float64 {
 	numel := ts.Numel()
 	vec := make([]float64, numel)
 
+	switch ts.DType() {
+	case gotch.ComplexHalf, gotch.ComplexFloat, gotch.ComplexDouble:
+		cvec := make([]complex128, numel)
+		complexTs := ts.MustTotype(gotch.ComplexDouble, false)
+		complexTs.MustCopyData(cvec, numel)
+		complexTs.MustDrop()
+		for i, v := range cvec {
+			vec[i] = real(v)
+		}
+
+		return vec
+	}
+
 	float64Ts := ts.MustTotype(gotch.Double, false)
 
 	float64Ts.MustCopyData(vec, numel)
@@ -1132,38 +1319,38 @@ func (ts *Tensor) Int64Values() []int64 {
 	return vec
 }
 
-// Vals returns tensor values in a slice
+// Vals returns tensor values in a slice. It panics if error occurred.
 // NOTE: need a type insersion to get runtime type
 // E.g. res := xs.Vals().([]int64)
+// Values of Half and BFloat16 tensor are returned in `[]float32` and
+// ComplexHalf in `[]complex64`.
 func (ts *Tensor) Vals() interface{} {
-	dtype := ts.DType()
+	retVal, err := ts.ValsE()
+	if err != nil {
+		panic(err)
+	}
+
+	return retVal
+}
+
+// ValsE is like `Vals` but returns error.
+func (ts *Tensor) ValsE() (interface{}, error) {
+	dtype, err := ts.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 	numel := ts.Numel()
 
-	var retVal interface{}
-
-	switch dtype.Name() {
-	case "uint8":
-		retVal = make([]uint8, numel)
-	case "int8":
-		retVal = make([]int8, numel)
-	case "int16":
-		retVal = make([]int16, numel)
-	case "int32":
-		retVal = make([]int32, numel)
-	case "int64":
-		retVal = make([]int64, numel)
-	case "float32":
-		retVal = make([]float32, numel)
-	case "float64":
-		retVal = make([]float64, numel)
-	case "bool":
-		retVal = make([]bool, numel)
-	default:
-		log.Fatalf("Unsupported dtype (%v)", dtype)
+	typ, err := gotch.ToGoType(dtype)
+	if err != nil {
+		return nil, fmt.Errorf("Unsupported dtype (%v)\n", dtype)
 	}
+	retVal := reflect.MakeSlice(reflect.SliceOf(typ), int(numel), int(numel)).Interface()
 
-	ts.CopyData(retVal, numel)
-	return retVal
+	if err := ts.CopyData(retVal, numel); err != nil {
+		return nil, err
+	}
+	return retVal, nil
 }
 
 // FlatView flattens a tensor.
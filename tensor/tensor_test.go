@@ -2,6 +2,8 @@ package tensor_test
 
 import (
 	"reflect"
+	"runtime"
+	"sync"
 	"testing"
 
 	"github.com/sugarme/gotch"
@@ -118,3 +120,137 @@ func TestOnehot(t *testing.T) {
  *         vec![1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0]
  *     );
  *     assert_eq!(onehot.size(), vec![4, 4]) */
+
+func TestReducedPrecisionDTypes(t *testing.T) {
+	x := ts.MustOfSlice([]float32{0.5, 1.0, -2.0, 3.25})
+
+	for _, dtype := range []gotch.DType{gotch.Half, gotch.BFloat16} {
+		y := x.MustTotype(dtype, false)
+		if y.DType() != dtype {
+			t.Errorf("Want: %v\n", dtype)
+			t.Errorf("Got: %v\n", y.DType())
+		}
+
+		want := []float32{0.5, 1.0, -2.0, 3.25}
+		got := y.Vals().([]float32)
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("Want: %v\n", want)
+			t.Errorf("Got: %v\n", got)
+		}
+		y.MustDrop()
+	}
+	x.MustDrop()
+}
+
+func TestComplexDTypes(t *testing.T) {
+	x := ts.MustOfSlice([]complex64{1 + 2i, 3 - 4i})
+	if x.DType() != gotch.ComplexFloat {
+		t.Errorf("Want: %v\n", gotch.ComplexFloat)
+		t.Errorf("Got: %v\n", x.DType())
+	}
+
+	want := []complex128{1 + 2i, 3 - 4i}
+	y := x.MustTotype(gotch.ComplexDouble, false)
+	got := y.Vals().([]complex128)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	wantReal := []float64{1, 3}
+	gotReal := y.Float64Values()
+	if !reflect.DeepEqual(wantReal, gotReal) {
+		t.Errorf("Want: %v\n", wantReal)
+		t.Errorf("Got: %v\n", gotReal)
+	}
+
+	x.MustDrop()
+	y.MustDrop()
+}
+
+func TestNoGradGuard(t *testing.T) {
+	x := ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU).MustSetRequiresGrad(true, true)
+	defer x.MustDrop()
+
+	guard := ts.NewNoGradGuard()
+	y := x.MustMul1(ts.FloatScalar(2.0), false)
+	guard.Drop()
+	z := x.MustMul1(ts.FloatScalar(2.0), false)
+
+	if y.MustRequiresGrad() {
+		t.Errorf("Want: %v\n", false)
+		t.Errorf("Got: %v\n", true)
+	}
+	if !z.MustRequiresGrad() {
+		t.Errorf("Want: %v\n", true)
+		t.Errorf("Got: %v\n", false)
+	}
+	y.MustDrop()
+	z.MustDrop()
+}
+
+// Inference goroutines in no-grad mode must not affect training goroutines
+// and vice versa even when goroutines are moved between OS threads.
+func TestInferenceModeConcurrent(t *testing.T) {
+	x := ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU).MustSetRequiresGrad(true, true)
+	defer x.MustDrop()
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		errors int
+	)
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(inference bool) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				var y *ts.Tensor
+				if inference {
+					ts.InferenceMode(func() {
+						runtime.Gosched()
+						y = x.MustMul1(ts.FloatScalar(2.0), false)
+					})
+				} else {
+					runtime.Gosched()
+					y = x.MustMul1(ts.FloatScalar(2.0), false)
+				}
+				if y.MustRequiresGrad() == inference {
+					mu.Lock()
+					errors++
+					mu.Unlock()
+				}
+				y.MustDrop()
+			}
+		}(i%2 == 0)
+	}
+	wg.Wait()
+
+	if errors != 0 {
+		t.Errorf("Want: %v\n", 0)
+		t.Errorf("Got: %v\n", errors)
+	}
+}
+
+func TestRunBackward(t *testing.T) {
+	a := ts.MustOfSlice([]float64{1, 2}).MustSetRequiresGrad(true, true)
+	b := ts.MustOfSlice([]float64{3, 4}).MustSetRequiresGrad(true, true)
+	y := a.MustMul(b, false)
+	z := a.MustAdd(b, false)
+
+	// d(y + z)/da = b + 1, d(y + z)/db = a + 1
+	grads := ts.MustRunBackward([]ts.Tensor{*y, *z}, []ts.Tensor{*a, *b}, false, false)
+	wants := [][]float64{{4, 5}, {2, 3}}
+	for i, want := range wants {
+		if !reflect.DeepEqual(want, grads[i].Float64Values()) {
+			t.Errorf("Want: %v\n", want)
+			t.Errorf("Got: %v\n", grads[i].Float64Values())
+		}
+		grads[i].MustDrop()
+	}
+
+	z.MustDrop()
+	y.MustDrop()
+	b.MustDrop()
+	a.MustDrop()
+}
@@ -74,7 +74,7 @@ func EncodeTensor(w *bytes.Buffer, v reflect.Value, shape []int64) error {
 		if err := w.WriteByte(b); err != nil {
 			return err
 		}
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
 		if err := binary.Write(w, nativeEndian, v.Interface()); err != nil {
 			return err
 		}
@@ -92,7 +92,7 @@ func EncodeTensor(w *bytes.Buffer, v reflect.Value, shape []int64) error {
 		// Optimisation: if only one dimension is left we can use binary.Write() directly for this slice
 		if len(shape) == 1 && v.Len() > 0 {
 			switch v.Index(0).Kind() {
-			case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+			case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
 				return binary.Write(w, nativeEndian, v.Interface())
 			}
 		}
@@ -121,7 +121,7 @@ func DecodeTensor(r *bytes.Reader, shape []int64, typ reflect.Type, ptr reflect.
 			return err
 		}
 		ptr.Elem().SetBool(b == 1)
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
 		if err := binary.Read(r, nativeEndian, ptr.Interface()); err != nil {
 			return err
 		}
@@ -133,7 +133,7 @@ func DecodeTensor(r *bytes.Reader, shape []int64, typ reflect.Type, ptr reflect.
 		// Optimization: if only one dimension is left we can use binary.Read() directly for this slice
 		if len(shape) == 1 && val.Len() > 0 {
 			switch val.Index(0).Kind() {
-			case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+			case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
 				return binary.Read(r, nativeEndian, val.Interface())
 			}
 		}
@@ -199,7 +199,7 @@ func dataCheck(data interface{}, count int) (k reflect.Type, n int, err error) {
 
 		return goType, total, nil
 
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Bool:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
 		total++
 		if goType.String() != "invalid" {
 			goType = v.Type()
@@ -335,6 +335,18 @@ func FlattenData(data interface{}) (fData interface{}, err error) {
 			retVal = append(retVal, v.(float64))
 		}
 		return retVal, nil
+	case reflect.Complex64:
+		var retVal []complex64
+		for _, v := range flat {
+			retVal = append(retVal, v.(complex64))
+		}
+		return retVal, nil
+	case reflect.Complex128:
+		var retVal []complex128
+		for _, v := range flat {
+			retVal = append(retVal, v.(complex128))
+		}
+		return retVal, nil
 	case reflect.Bool:
 		var retVal []bool
 		for _, v := range flat {
@@ -370,7 +382,7 @@ func flattenData(data interface{}, round int, flat []interface{}) (f []interface
 
 		return flatData, nil
 
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Bool:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
 		flatData = append(flatData, data)
 	}
 
@@ -0,0 +1,96 @@
+package gotch
+
+import (
+	"fmt"
+	"runtime"
+
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// Thread settings for CPU execution:
+//
+// Libtorch runs an operation on CPU with a pool of intra-op threads (size
+// given by `GetNumThreads`, default to number of physical cores) and runs
+// asynchronous TorchScript tasks (`torch.jit.fork`) on a pool of inter-op
+// threads (`GetNumInteropThreads`). Both pools are shared by the whole
+// process, not per goroutine.
+//
+// When many goroutines run models concurrently (e.g. `CModule.Forward` to
+// serve requests), each of them can use all intra-op threads and the process
+// ends up with far more busy threads than cores. In that case, parallelism
+// should come from goroutines: set intra-op threads to 1 (or number of cores
+// divided by number of concurrent goroutines). Keep the default when a
+// single goroutine runs big batches.
+//
+// Example:
+//
+//	func main() {
+//		// Before any tensor operation.
+//		if err := gotch.SetNumThreads(1); err != nil {
+//			log.Fatal(err)
+//		}
+//		m, err := ts.ModuleLoad("model.pt")
+//		...
+//		for i := 0; i < runtime.NumCPU(); i++ {
+//			go worker(m, requests) // calls m.Forward(x) concurrently
+//		}
+//	}
+//
+// NOTE. Thread settings should be done at program start before any tensor
+// operation. Depending on libtorch build (OpenMP), intra-op setting may not
+// be applied to OS threads which already ran parallel work and inter-op
+// setting can only be set once before inter-op pool is created.
+
+// GetNumThreads returns number of threads used by libtorch for intra-op
+// parallelism on CPU.
+func GetNumThreads() int {
+	return int(lib.AtGetNumThreads())
+}
+
+// SetNumThreads sets number of threads used by libtorch for intra-op
+// parallelism on CPU.
+func SetNumThreads(n int) error {
+	if n <= 0 {
+		err := fmt.Errorf("Invalid number of threads (%v). Expected a positive number.\n", n)
+		return err
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	lib.AtSetNumThreads(int32(n))
+	return torchErr()
+}
+
+// GetNumInteropThreads returns number of threads used by libtorch for
+// inter-op parallelism (e.g. TorchScript `fork`).
+func GetNumInteropThreads() int {
+	return int(lib.AtGetNumInteropThreads())
+}
+
+// SetNumInteropThreads sets number of threads used by libtorch for inter-op
+// parallelism. It returns error if it is called after inter-op pool is
+// created (i.e. by an inter-op parallel work or a previous call).
+func SetNumInteropThreads(n int) error {
+	if n <= 0 {
+		err := fmt.Errorf("Invalid number of threads (%v). Expected a positive number.\n", n)
+		return err
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	lib.AtSetNumInteropThreads(int32(n))
+	return torchErr()
+}
+
+// torchErr retrieves and clears last libtorch error of current OS thread.
+// Caller should lock OS thread from the libtorch call.
+func torchErr() error {
+	errStr := lib.GetAndResetLastErrString()
+	if errStr == "" {
+		return nil
+	}
+
+	return fmt.Errorf("Libtorch API Error: %v\n", errStr)
+}
@@ -94,20 +94,26 @@ func (ra *RandomAffine) getParams(imageSize []int64) (float64, []int64, float64,
 	return angle, translations, scale, shear
 }
 
-func (ra *RandomAffine) Forward(x *ts.Tensor) *ts.Tensor {
-	assertImageTensor(x)
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RandomAffine struct.
+func (ra *RandomAffine) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	w, h, err := getImageSize(x)
+	if err != nil {
+		return nil, err
+	}
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
-	w, h := getImageSize(fx)
 	angle, translations, scale, shear := ra.getParams([]int64{w, h})
 
 	out := affine(fx, angle, translations, scale, shear, ra.interpolationMode, ra.fillValue)
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func newRandomAffine(opts ...affineOption) *RandomAffine {
@@ -2,7 +2,6 @@ package aug
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
@@ -16,27 +15,18 @@ type GaussianBlur struct {
 // ks : kernal size. Can be 1-2 element slice
 // sigma: minimal and maximal standard deviation that can be chosen for blurring kernel
 // range (min, max). Can be 1-2 element slice
-func newGaussianBlur(ks []int64, sig []float64) *GaussianBlur {
-	if len(ks) == 0 || len(ks) > 2 {
-		err := fmt.Errorf("Kernel size should have 1-2 elements. Got %v\n", len(ks))
-		log.Fatal(err)
-	}
+func newGaussianBlur(ks []int64, sig []float64) (*GaussianBlur, error) {
 	for _, size := range ks {
 		if size <= 0 || size%2 == 0 {
-			err := fmt.Errorf("Kernel size should be an odd and positive number.")
-			log.Fatal(err)
+			err := fmt.Errorf("Kernel size should be an odd and positive number.\n")
+			return nil, err
 		}
 	}
 
-	if len(sig) == 0 || len(sig) > 2 {
-		err := fmt.Errorf("Sigma should have 1-2 elements. Got %v\n", len(sig))
-		log.Fatal(err)
-	}
-
 	for _, s := range sig {
 		if s <= 0 {
-			err := fmt.Errorf("Sigma should be a positive number.")
-			log.Fatal(err)
+			err := fmt.Errorf("Sigma should be a positive number.\n")
+			return nil, err
 		}
 	}
 
@@ -47,7 +37,8 @@ func newGaussianBlur(ks []int64, sig []float64) *GaussianBlur {
 	case 2:
 		kernelSize = ks
 	default:
-		panic("Shouldn't reach here.")
+		err := fmt.Errorf("Kernel size should have 1-2 elements. Got %v\n", len(ks))
+		return nil, err
 	}
 
 	var sigma []float64
@@ -63,35 +54,47 @@ func newGaussianBlur(ks []int64, sig []float64) *GaussianBlur {
 		}
 		sigma = []float64{min, max}
 	default:
-		panic("Shouldn't reach here.")
+		err := fmt.Errorf("Sigma should have 1-2 elements. Got %v\n", len(sig))
+		return nil, err
 	}
 
 	return &GaussianBlur{
 		kernelSize: kernelSize,
 		sigma:      sigma,
-	}
+	}, nil
 }
 
-func (b *GaussianBlur) Forward(x *ts.Tensor) *ts.Tensor {
-	assertImageTensor(x)
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for GaussianBlur struct.
+func (b *GaussianBlur) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
 	sigmaTs := ts.MustEmpty([]int64{1}, gotch.Float, gotch.CPU)
 	sigmaTs.MustUniform_(b.sigma[0], b.sigma[1])
 	sigmaVal := sigmaTs.Float64Values()[0]
 	sigmaTs.MustDrop()
 
-	out := gaussianBlur(fx, b.kernelSize, []float64{sigmaVal, sigmaVal})
-	bx := Float2ByteImage(out)
+	out, err := gaussianBlur(fx, b.kernelSize, []float64{sigmaVal, sigmaVal})
+	if err != nil {
+		fx.MustDrop()
+		return nil, err
+	}
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithGaussianBlur(ks []int64, sig []float64) Option {
 	return func(o *Options) {
-		gb := newGaussianBlur(ks, sig)
+		gb, err := newGaussianBlur(ks, sig)
+		if err != nil {
+			o.setErr(err)
+			return
+		}
 		o.gaussianBlur = gb
 	}
 }
@@ -2,7 +2,6 @@ package aug
 
 import (
 	"fmt"
-	"log"
 
 	ts "github.com/sugarme/gotch/tensor"
 )
@@ -21,6 +20,7 @@ type colorOptions struct {
 	contrast   []float64
 	saturation []float64
 	hue        []float64
+	err        error // first invalid option
 }
 
 type ColorOption func(*colorOptions)
@@ -34,78 +34,99 @@ func defaultColorOptions() *colorOptions {
 	}
 }
 
+func (o *colorOptions) setErr(err error) {
+	if o.err == nil {
+		o.err = err
+	}
+}
+
 func WithColorBrightness(v []float64) ColorOption {
-	b := checkOption(v)
 	return func(o *colorOptions) {
-		o.brightness = b
+		if err := checkOption(v); err != nil {
+			o.setErr(err)
+			return
+		}
+		o.brightness = v
 	}
 }
 
 func WithColorContrast(v []float64) ColorOption {
-	c := checkOption(v)
 	return func(o *colorOptions) {
-		o.contrast = c
+		if err := checkOption(v); err != nil {
+			o.setErr(err)
+			return
+		}
+		o.contrast = v
 	}
 }
 
 func WithColorSaturation(v []float64) ColorOption {
-	s := checkOption(v)
 	return func(o *colorOptions) {
-		o.saturation = s
+		if err := checkOption(v); err != nil {
+			o.setErr(err)
+			return
+		}
+		o.saturation = v
 	}
 }
 
 func WithColorHue(vals []float64) ColorOption {
-	if len(vals) > 2 {
-		err := fmt.Errorf("Expected 1-2 values. Got %v\n", len(vals))
-		log.Fatal(err)
-	}
-	for _, v := range vals {
-		if v < -0.5 || v > 0.5 {
-			err := fmt.Errorf("Expected hue color option from [-0.5, 0.5]. Got %v\n", v)
-			log.Fatal(err)
-		}
-	}
-
 	return func(o *colorOptions) {
+		if len(vals) > 2 {
+			err := fmt.Errorf("Expected 1-2 values. Got %v\n", len(vals))
+			o.setErr(err)
+			return
+		}
+		for _, v := range vals {
+			if v < -0.5 || v > 0.5 {
+				err := fmt.Errorf("Expected hue color option from [-0.5, 0.5]. Got %v\n", v)
+				o.setErr(err)
+				return
+			}
+		}
 		o.hue = vals
 	}
 }
 
-func checkOption(vals []float64) []float64 {
+func checkOption(vals []float64) error {
 	if len(vals) > 2 {
 		err := fmt.Errorf("Expected 1-2 values. Got %v\n", len(vals))
-		log.Fatal(err)
+		return err
 	}
 	for _, v := range vals {
 		if v < 0 {
 			err := fmt.Errorf("Expected non-zero value. Got %v\n", v)
-			log.Fatal(err)
+			return err
 		}
 	}
 
-	return vals
+	return nil
 }
 
-func newColorJitter(opts ...ColorOption) *ColorJitter {
+func newColorJitter(opts ...ColorOption) (*ColorJitter, error) {
 	options := defaultColorOptions()
 	for _, o := range opts {
 		o(options)
 	}
+	if options.err != nil {
+		return nil, options.err
+	}
 
 	return &ColorJitter{
 		brightness: getParam(options.brightness),
 		contrast:   getParam(options.contrast),
 		saturation: getParam(options.saturation),
 		hue:        getParam(options.hue, "hue"),
-	}
+	}, nil
 }
 
 func WithColorJitter(opts ...ColorOption) Option {
-
-	c := newColorJitter(opts...)
-
 	return func(o *Options) {
+		c, err := newColorJitter(opts...)
+		if err != nil {
+			o.setErr(err)
+			return
+		}
 		o.colorJitter = c
 	}
 }
@@ -179,17 +200,21 @@ func getParam(vals []float64, nameOpt ...string) []float64 {
 	}
 }
 
-// Forward implement ts.Module by randomly picking one of brightness, contrast,
-// staturation or hue function to transform input image tensor.
+// Transform implements Transformer interface by applying brightness, contrast,
+// staturation and hue functions with random factors to input image tensor.
 // NOTE. input image dtype must be `uint8(Byte)`
-func (c *ColorJitter) Forward(x *ts.Tensor) *ts.Tensor {
+func (c *ColorJitter) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	var err error
 	// 1. Brightness
 	var bOut *ts.Tensor
 	if c.brightness == nil {
 		bOut = x.MustShallowClone()
 	} else {
 		bfactor := randVal(c.brightness[0], c.brightness[1])
-		bOut = adjustBrightness(x, bfactor)
+		bOut, err = adjustBrightness(x, bfactor)
+		if err != nil {
+			return nil, err
+		}
 	}
 	// 2. Contrast
 	var cOut *ts.Tensor
@@ -198,8 +223,11 @@ func (c *ColorJitter) Forward(x *ts.Tensor) *ts.Tensor {
 		bOut.MustDrop()
 	} else {
 		cfactor := randVal(c.contrast[0], c.contrast[1])
-		cOut = adjustContrast(bOut, cfactor)
+		cOut, err = adjustContrast(bOut, cfactor)
 		bOut.MustDrop()
+		if err != nil {
+			return nil, err
+		}
 	}
 	// 3. Saturation
 	var sOut *ts.Tensor
@@ -208,8 +236,11 @@ func (c *ColorJitter) Forward(x *ts.Tensor) *ts.Tensor {
 		cOut.MustDrop()
 	} else {
 		sfactor := randVal(c.saturation[0], c.saturation[1])
-		sOut = adjustSaturation(cOut, sfactor)
+		sOut, err = adjustSaturation(cOut, sfactor)
 		cOut.MustDrop()
+		if err != nil {
+			return nil, err
+		}
 	}
 	// 4. Hue
 	var hOut *ts.Tensor
@@ -218,9 +249,12 @@ func (c *ColorJitter) Forward(x *ts.Tensor) *ts.Tensor {
 		sOut.MustDrop()
 	} else {
 		hfactor := randVal(c.hue[0], c.hue[1])
-		hOut = adjustHue(sOut, hfactor)
+		hOut, err = adjustHue(sOut, hfactor)
 		sOut.MustDrop()
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return hOut
+	return hOut, nil
 }
@@ -22,23 +22,31 @@ func newRandomAutocontrast(pOpt ...float64) *RandomAutocontrast {
 	return &RandomAutocontrast{p}
 }
 
-func (rac *RandomAutocontrast) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RandomAutocontrast struct.
+func (rac *RandomAutocontrast) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
 	r := randPvalue()
 	var out *ts.Tensor
 	switch {
 	case r < rac.pvalue:
-		out = autocontrast(fx)
+		out, err = autocontrast(fx)
+		if err != nil {
+			fx.MustDrop()
+			return nil, err
+		}
 	default:
 		out = fx.MustShallowClone()
 	}
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithRandomAutocontrast(p ...float64) Option {
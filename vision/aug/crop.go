@@ -2,7 +2,6 @@ package aug
 
 import (
 	"fmt"
-	"log"
 	// "math"
 
 	"github.com/sugarme/gotch"
@@ -26,16 +25,19 @@ func newRandomCrop(size, padding []int64, paddingIfNeeded bool, paddingMode stri
 }
 
 // get parameters for crop
-func (c *RandomCrop) params(x *ts.Tensor) (int64, int64, int64, int64) {
-	w, h := getImageSize(x)
+func (c *RandomCrop) params(x *ts.Tensor) (int64, int64, int64, int64, error) {
+	w, h, err := getImageSize(x)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
 	th, tw := c.size[0], c.size[1]
 	if h+1 < th || w+1 < tw {
-		err := fmt.Errorf("Required crop size %v is larger then input image size %v", c.size, []int64{h, w})
-		log.Fatal(err)
+		err := fmt.Errorf("Required crop size %v is larger then input image size %v\n", c.size, []int64{h, w})
+		return 0, 0, 0, 0, err
 	}
 
 	if w == tw && h == th {
-		return 0, 0, h, w
+		return 0, 0, h, w, nil
 	}
 
 	iTs := ts.MustRandint1(0, h-th+1, []int64{1}, gotch.Int64, gotch.CPU)
@@ -46,20 +48,31 @@ func (c *RandomCrop) params(x *ts.Tensor) (int64, int64, int64, int64) {
 	j := jTs.Int64Values()[0]
 	jTs.MustDrop()
 
-	return i, j, th, tw
+	return i, j, th, tw, nil
 }
 
-func (c *RandomCrop) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RandomCrop struct.
+func (c *RandomCrop) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	w, h, err := getImageSize(x)
+	if err != nil {
+		return nil, err
+	}
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
 	var img *ts.Tensor
 	if c.padding != nil {
-		img = pad(fx, c.padding, c.paddingMode)
+		img, err = pad(fx, c.padding, c.paddingMode)
+		if err != nil {
+			fx.MustDrop()
+			return nil, err
+		}
 	} else {
 		img = fx.MustShallowClone()
 	}
-
-	w, h := getImageSize(fx)
+	fx.MustDrop()
 
 	var (
 		paddedW  *ts.Tensor
@@ -68,31 +81,39 @@ func (c *RandomCrop) Forward(x *ts.Tensor) *ts.Tensor {
 	// pad width if needed
 	if c.paddingIfNeeded && w < c.size[1] {
 		padding := []int64{c.size[1] - w, 0}
-		paddedW = pad(img, padding, c.paddingMode)
+		paddedW, err = pad(img, padding, c.paddingMode)
 	} else {
 		paddedW = img.MustShallowClone()
 	}
 	img.MustDrop()
+	if err != nil {
+		return nil, err
+	}
 
 	// pad height if needed
 	if c.paddingIfNeeded && h < c.size[0] {
 		padding := []int64{0, c.size[0] - h}
-		paddedWH = pad(paddedW, padding, c.paddingMode)
+		paddedWH, err = pad(paddedW, padding, c.paddingMode)
 	} else {
 		paddedWH = paddedW.MustShallowClone()
 	}
-
 	paddedW.MustDrop()
+	if err != nil {
+		return nil, err
+	}
 
 	// i, j, h, w = self.get_params(img, self.size)
-	i, j, h, w := c.params(x)
+	i, j, h, w, err := c.params(x)
+	if err != nil {
+		paddedWH.MustDrop()
+		return nil, err
+	}
 	out := crop(paddedWH, i, j, h, w)
 	paddedWH.MustDrop()
 
-	bx := Float2ByteImage(out)
-	fx.MustDrop()
+	bx, err := Float2ByteImage(out)
 	out.MustDrop()
-	return bx
+	return bx, err
 }
 
 func WithRandomCrop(size []int64, padding []int64, paddingIfNeeded bool, paddingMode string) Option {
@@ -110,21 +131,26 @@ type CenterCrop struct {
 	size []int64
 }
 
-func newCenterCrop(size []int64) *CenterCrop {
+func newCenterCrop(size []int64) (*CenterCrop, error) {
 	if len(size) != 2 {
 		err := fmt.Errorf("Expected size of 2 elements. Got %v\n", len(size))
-		log.Fatal(err)
+		return nil, err
 	}
-	return &CenterCrop{size}
+	return &CenterCrop{size}, nil
 }
 
-func (cc *CenterCrop) Forward(x *ts.Tensor) *ts.Tensor {
+// Transform implements Transformer interface for CenterCrop struct.
+func (cc *CenterCrop) Transform(x *ts.Tensor) (*ts.Tensor, error) {
 	return centerCrop(x, cc.size)
 }
 
 func WithCenterCrop(size []int64) Option {
 	return func(o *Options) {
-		cc := newCenterCrop(size)
+		cc, err := newCenterCrop(size)
+		if err != nil {
+			o.setErr(err)
+			return
+		}
 		o.centerCrop = cc
 	}
 }
@@ -2,7 +2,6 @@ package aug
 
 import (
 	"fmt"
-	"log"
 	"math"
 
 	"github.com/sugarme/gotch"
@@ -33,6 +32,7 @@ type cutoutOptions struct {
 	scale  []float64
 	ratio  []float64
 	rgbVal []int64 // RGB value
+	err    error   // first invalid option
 }
 
 type cutoutOption func(o *cutoutOptions)
@@ -55,46 +55,53 @@ func newRandomCutout(pvalue float64, scale, ratio []float64, rgbVal []int64) *Ra
 	}
 }
 
-func WithCutoutPvalue(p float64) cutoutOption {
-	if p < 0 || p > 1 {
-		log.Fatalf("Cutout p-value must be in range from 0 to 1. Got %v\n", p)
+func (o *cutoutOptions) setErr(err error) {
+	if o.err == nil {
+		o.err = err
 	}
+}
+
+func WithCutoutPvalue(p float64) cutoutOption {
 	return func(o *cutoutOptions) {
+		if p < 0 || p > 1 {
+			o.setErr(fmt.Errorf("Cutout p-value must be in range from 0 to 1. Got %v\n", p))
+			return
+		}
 		o.pvalue = p
 	}
 }
 
 func WithCutoutScale(scale []float64) cutoutOption {
-	if len(scale) != 2 {
-		log.Fatalf("Cutout scale should be in a range of 2 elments. Got %v elements\n", len(scale))
-	}
 	return func(o *cutoutOptions) {
+		if len(scale) != 2 {
+			o.setErr(fmt.Errorf("Cutout scale should be in a range of 2 elments. Got %v elements\n", len(scale)))
+			return
+		}
 		o.scale = scale
 	}
 }
 
 func WithCutoutRatio(ratio []float64) cutoutOption {
-	if len(ratio) != 2 {
-		log.Fatalf("Cutout ratio should be in a range of 2 elments. Got %v elements\n", len(ratio))
-	}
 	return func(o *cutoutOptions) {
+		if len(ratio) != 2 {
+			o.setErr(fmt.Errorf("Cutout ratio should be in a range of 2 elments. Got %v elements\n", len(ratio)))
+			return
+		}
 		o.ratio = ratio
 	}
 }
 
 func WithCutoutValue(rgb []int64) cutoutOption {
-	var rgbVal []int64
-	switch len(rgb) {
-	case 1:
-		rgbVal = []int64{rgb[0], rgb[0], rgb[0]}
-	case 3:
-		rgbVal = rgb
-	default:
-		err := fmt.Errorf("Cutout values can be single value or 3-element (RGB) value. Got %v values.", len(rgb))
-		log.Fatal(err)
-	}
 	return func(o *cutoutOptions) {
-		o.rgbVal = rgbVal
+		switch len(rgb) {
+		case 1:
+			o.rgbVal = []int64{rgb[0], rgb[0], rgb[0]}
+		case 3:
+			o.rgbVal = rgb
+		default:
+			err := fmt.Errorf("Cutout values can be single value or 3-element (RGB) value. Got %v values.\n", len(rgb))
+			o.setErr(err)
+		}
 	}
 }
 
@@ -145,8 +152,12 @@ func (rc *RandomCutout) cutoutParams(x *ts.Tensor) (int64, int64, int64, int64,
 	return 0, 0, imgH, imgW, img
 }
 
-func (rc *RandomCutout) Forward(img *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(img)
+// Transform implements Transformer interface for RandomCutout struct.
+func (rc *RandomCutout) Transform(img *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(img)
+	if err != nil {
+		return nil, err
+	}
 
 	randTs := ts.MustRandn([]int64{1}, gotch.Float, gotch.CPU)
 	randVal := randTs.Float64Values()[0]
@@ -162,11 +173,11 @@ func (rc *RandomCutout) Forward(img *ts.Tensor) *ts.Tensor {
 		out = fx.MustShallowClone()
 	}
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithRandomCutout(opts ...cutoutOption) Option {
@@ -176,6 +187,10 @@ func WithRandomCutout(opts ...cutoutOption) Option {
 	}
 
 	return func(o *Options) {
+		if params.err != nil {
+			o.setErr(params.err)
+			return
+		}
 		rc := newRandomCutout(params.pvalue, params.scale, params.ratio, params.rgbVal)
 		o.randomCutout = rc
 	}
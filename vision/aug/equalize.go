@@ -24,18 +24,17 @@ func newRandomEqualize(pOpt ...float64) *RandomEqualize {
 	return &RandomEqualize{p}
 }
 
-// NOTE. input image MUST be uint8 dtype otherwise panic!
-func (re *RandomEqualize) Forward(x *ts.Tensor) *ts.Tensor {
+// Transform implements Transformer interface for RandomEqualize struct.
+//
+// NOTE. input image MUST be uint8 dtype otherwise error is returned.
+func (re *RandomEqualize) Transform(x *ts.Tensor) (*ts.Tensor, error) {
 	r := randPvalue()
-	var out *ts.Tensor
 	switch {
 	case r < re.pvalue:
-		out = equalize(x)
+		return equalize(x)
 	default:
-		out = x.MustShallowClone()
+		return x.MustShallowClone(), nil
 	}
-
-	return out
 }
 
 func WithRandomEqualize(p ...float64) Option {
@@ -21,8 +21,12 @@ func newRandomHorizontalFlip(pvalue float64) *RandomHorizontalFlip {
 	}
 }
 
-func (hf *RandomHorizontalFlip) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RandomHorizontalFlip struct.
+func (hf *RandomHorizontalFlip) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
 	randTs := ts.MustRandn([]int64{1}, gotch.Float, gotch.CPU)
 	randVal := randTs.Float64Values()[0]
@@ -30,16 +34,20 @@ func (hf *RandomHorizontalFlip) Forward(x *ts.Tensor) *ts.Tensor {
 	var out *ts.Tensor
 	switch {
 	case randVal < hf.pvalue:
-		out = hflip(fx)
+		out, err = hflip(fx)
+		if err != nil {
+			fx.MustDrop()
+			return nil, err
+		}
 	default:
 		out = fx.MustShallowClone()
 	}
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithRandomHFlip(pvalue float64) Option {
@@ -65,8 +73,12 @@ func newRandomVerticalFlip(pvalue float64) *RandomVerticalFlip {
 	}
 }
 
-func (vf *RandomVerticalFlip) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RandomVerticalFlip struct.
+func (vf *RandomVerticalFlip) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
 	randTs := ts.MustRandn([]int64{1}, gotch.Float, gotch.CPU)
 	randVal := randTs.Float64Values()[0]
@@ -75,16 +87,20 @@ func (vf *RandomVerticalFlip) Forward(x *ts.Tensor) *ts.Tensor {
 	var out *ts.Tensor
 	switch {
 	case randVal < vf.pvalue:
-		out = vflip(fx)
+		out, err = vflip(fx)
+		if err != nil {
+			fx.MustDrop()
+			return nil, err
+		}
 	default:
 		out = fx.MustShallowClone()
 	}
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithRandomVFlip(pvalue float64) Option {
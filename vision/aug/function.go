@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"log"
 	"math"
-	"math/rand"
-	"time"
 
 	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
@@ -98,15 +96,17 @@ func castSqueezeOut(x *ts.Tensor, needCast, needSqueeze bool, outDType gotch.DTy
 	return castTs
 }
 
-func gaussianBlur(x *ts.Tensor, ks []int64, sigma []float64) *ts.Tensor {
+func gaussianBlur(x *ts.Tensor, ks []int64, sigma []float64) (*ts.Tensor, error) {
 	// dtype := gotch.Float
-	dtype := x.DType()
-	if x.DType() == gotch.Float || x.DType() == gotch.Double {
-		dtype = x.DType()
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
 	}
 	device := x.MustDevice()
 
-	assertImageTensor(x)
+	if err := assertImageTensor(x); err != nil {
+		return nil, err
+	}
 
 	kernel := gaussianKernel2D(ks, sigma, dtype, device)
 	xdim := x.MustSize()
@@ -114,7 +114,11 @@ func gaussianBlur(x *ts.Tensor, ks []int64, sigma []float64) *ts.Tensor {
 
 	// kernel = kernel.expand(img.shape[-3], 1, kernel.shape[0], kernel.shape[1])
 	kexpand := kernel.MustExpand([]int64{xdim[len(xdim)-3], 1, kdim[0], kdim[1]}, true, true)
-	kdtype := kexpand.DType()
+	kdtype, err := kexpand.DTypeE()
+	if err != nil {
+		kexpand.MustDrop()
+		return nil, err
+	}
 	img, needCast, needSqueeze, outDType := castSqueezeIn(x, []gotch.DType{kdtype})
 
 	// padding = (left, right, top, bottom)
@@ -140,32 +144,45 @@ func gaussianBlur(x *ts.Tensor, ks []int64, sigma []float64) *ts.Tensor {
 	out := castSqueezeOut(imgConv2d, needCast, needSqueeze, outDType)
 	imgConv2d.MustDrop()
 
-	return out
+	return out, nil
 }
 
-func isTorchImage(x *ts.Tensor) bool {
-	return x.Dim() >= 2
+func isTorchImage(x *ts.Tensor) (bool, error) {
+	ndim, err := x.DimE()
+	if err != nil {
+		return false, err
+	}
+
+	return ndim >= 2, nil
 }
 
-func assertImageTensor(x *ts.Tensor) {
-	if !isTorchImage(x) {
-		err := fmt.Errorf("Input tensor is not a torch image.")
-		log.Fatal(err)
+func assertImageTensor(x *ts.Tensor) error {
+	ok, err := isTorchImage(x)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		err := fmt.Errorf("Input tensor is not a torch image.\n")
+		return err
 	}
+
+	return nil
 }
 
-func imageChanNum(x *ts.Tensor) int64 {
-	ndim := x.Dim()
+func imageChanNum(x *ts.Tensor) (int64, error) {
+	ndim, err := x.DimE()
+	if err != nil {
+		return 0, err
+	}
 
 	switch {
 	case ndim == 2:
-		return 1
+		return 1, nil
 	case ndim > 2:
-		return x.MustSize()[0]
+		return x.MustSize()[0], nil
 	default:
-		err := fmt.Errorf("imageChanNum - Input should be 2 or more. Got %v", ndim)
-		log.Fatal(err)
-		return 0
+		err := fmt.Errorf("imageChanNum - Input should be 2 or more. Got %v\n", ndim)
+		return 0, err
 	}
 }
 
@@ -179,12 +196,17 @@ func contains(item int64, list []int64) bool {
 	return false
 }
 
-func assertChannels(x *ts.Tensor, permitted []int64) {
-	c := imageChanNum(x)
+func assertChannels(x *ts.Tensor, permitted []int64) error {
+	c, err := imageChanNum(x)
+	if err != nil {
+		return err
+	}
 	if !contains(c, permitted) {
-		err := fmt.Errorf("Input image tensor permitted channels are %+v, but found %v", permitted, c)
-		log.Fatal(err)
+		err := fmt.Errorf("Input image tensor permitted channels are %+v, but found %v\n", permitted, c)
+		return err
 	}
+
+	return nil
 }
 
 func blend(img1, img2 *ts.Tensor, ratio float64) *ts.Tensor {
@@ -201,20 +223,24 @@ func blend(img1, img2 *ts.Tensor, ratio float64) *ts.Tensor {
 }
 
 // brightness should be in range 0.25 - 1.25 for visible view
-func adjustBrightness(x *ts.Tensor, brightness float64) *ts.Tensor {
+func adjustBrightness(x *ts.Tensor, brightness float64) (*ts.Tensor, error) {
 	if brightness < 0 {
-		err := fmt.Errorf("adjustBrightness - brightness factor (%v) is not non-negative.", brightness)
-		log.Fatal(err)
+		err := fmt.Errorf("adjustBrightness - brightness factor (%v) is not non-negative.\n", brightness)
+		return nil, err
 	}
 
-	assertImageTensor(x)
-	assertChannels(x, []int64{1, 3})
+	if err := assertImageTensor(x); err != nil {
+		return nil, err
+	}
+	if err := assertChannels(x, []int64{1, 3}); err != nil {
+		return nil, err
+	}
 
 	zeros := x.MustZerosLike(false)
 	out := blend(x, zeros, brightness)
 	zeros.MustDrop()
 
-	return out
+	return out, nil
 }
 
 // randVal generates a value from uniform values from 0 to x
@@ -236,22 +262,31 @@ func getMinMax(x float64) (float64, float64) {
 	return from, to
 }
 
-func rgb2Gray(x *ts.Tensor, outChanOpt ...int64) *ts.Tensor {
+func rgb2Gray(x *ts.Tensor, outChanOpt ...int64) (*ts.Tensor, error) {
 	var outChannels int64 = 1
 	if len(outChanOpt) > 0 {
 		outChannels = outChanOpt[0]
 	}
 
-	ndim := x.Dim()
+	ndim, err := x.DimE()
+	if err != nil {
+		return nil, err
+	}
 	if ndim < 3 {
-		err := fmt.Errorf("Input image tensor should have at least 3 dimensions, but found %v", ndim)
-		log.Fatal(err)
+		err := fmt.Errorf("Input image tensor should have at least 3 dimensions, but found %v\n", ndim)
+		return nil, err
+	}
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
 	}
 
-	assertChannels(x, []int64{3})
+	if err := assertChannels(x, []int64{3}); err != nil {
+		return nil, err
+	}
 	if !contains(outChannels, []int64{1, 3}) {
-		err := fmt.Errorf("Number of output channels should be either 1 or 3")
-		log.Fatal(err)
+		err := fmt.Errorf("Number of output channels should be either 1 or 3\n")
+		return nil, err
 	}
 
 	rgbTs := x.MustUnbind(-3, false)
@@ -268,45 +303,69 @@ func rgb2Gray(x *ts.Tensor, outChanOpt ...int64) *ts.Tensor {
 	addTs := rmul.MustAdd(gmul, true).MustAdd(bmul, true)
 	gmul.MustDrop()
 	bmul.MustDrop()
-	lImg := addTs.MustTotype(x.DType(), true).MustUnsqueeze(-3, true)
+	lImg := addTs.MustTotype(dtype, true).MustUnsqueeze(-3, true)
 
 	if outChannels == 3 {
-		return lImg.MustExpand(x.MustSize(), true, true)
+		return lImg.MustExpand(x.MustSize(), true, true), nil
 	}
 
-	return lImg
+	return lImg, nil
 }
 
-func adjustContrast(x *ts.Tensor, contrast float64) *ts.Tensor {
+func adjustContrast(x *ts.Tensor, contrast float64) (*ts.Tensor, error) {
 	if contrast < 0 {
-		err := fmt.Errorf("adjustContrast - contrast factor (%v) is not non-negative.", contrast)
-		log.Fatal(err)
+		err := fmt.Errorf("adjustContrast - contrast factor (%v) is not non-negative.\n", contrast)
+		return nil, err
 	}
 
-	assertImageTensor(x)
-	assertChannels(x, []int64{3})
+	if err := assertImageTensor(x); err != nil {
+		return nil, err
+	}
+	if err := assertChannels(x, []int64{3}); err != nil {
+		return nil, err
+	}
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 
-	grayTs := rgb2Gray(x).MustTotype(x.DType(), true)
+	gray, err := rgb2Gray(x)
+	if err != nil {
+		return nil, err
+	}
+	grayTs := gray.MustTotype(dtype, true)
 
-	mean := grayTs.MustMean1([]int64{-3, -2, -1}, true, gotch.Float, true).MustTotype(x.DType(), true)
+	mean := grayTs.MustMean1([]int64{-3, -2, -1}, true, gotch.Float, true).MustTotype(dtype, true)
 	out := blend(x, mean, contrast)
 	mean.MustDrop()
 
-	return out
+	return out, nil
 }
 
-func adjustSaturation(x *ts.Tensor, sat float64) *ts.Tensor {
+func adjustSaturation(x *ts.Tensor, sat float64) (*ts.Tensor, error) {
 	if sat < 0 {
-		err := fmt.Errorf("adjustSaturation - saturation factor (%v) is not non-negative.", sat)
-		log.Fatal(err)
+		err := fmt.Errorf("adjustSaturation - saturation factor (%v) is not non-negative.\n", sat)
+		return nil, err
 	}
-	assertImageTensor(x)
-	assertChannels(x, []int64{3})
-	grayTs := rgb2Gray(x).MustTotype(x.DType(), true)
+	if err := assertImageTensor(x); err != nil {
+		return nil, err
+	}
+	if err := assertChannels(x, []int64{3}); err != nil {
+		return nil, err
+	}
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
+	}
+	gray, err := rgb2Gray(x)
+	if err != nil {
+		return nil, err
+	}
+	grayTs := gray.MustTotype(dtype, true)
 	out := blend(x, grayTs, sat)
 	grayTs.MustDrop()
 
-	return out
+	return out, nil
 }
 
 func rgb2HSV(x *ts.Tensor) *ts.Tensor {
@@ -474,17 +533,21 @@ func hsv2RGB(x *ts.Tensor) *ts.Tensor {
 }
 
 // ref. https://en.wikipedia.org/wiki/HSL_and_HSV
-func adjustHue(x *ts.Tensor, hue float64) *ts.Tensor {
+func adjustHue(x *ts.Tensor, hue float64) (*ts.Tensor, error) {
 	if hue < -0.5 || hue > 0.5 {
-		err := fmt.Errorf("hue factor (%v) is not in [-0.5, 0.5]", hue)
-		log.Fatal(err)
+		err := fmt.Errorf("hue factor (%v) is not in [-0.5, 0.5]\n", hue)
+		return nil, err
+	}
+	if err := assertImageTensor(x); err != nil {
+		return nil, err
+	}
+	if err := assertChannels(x, []int64{1, 3}); err != nil {
+		return nil, err
 	}
-	assertImageTensor(x)
-	assertChannels(x, []int64{1, 3})
 
-	if c := imageChanNum(x); c == 1 {
+	if c, _ := imageChanNum(x); c == 1 {
 		out := x.MustShallowClone()
-		return out
+		return out, nil
 	}
 
 	imgFl := x.MustTotype(gotch.Float, false).MustDiv1(ts.FloatScalar(255.0), true)
@@ -510,7 +573,7 @@ func adjustHue(x *ts.Tensor, hue float64) *ts.Tensor {
 	hAdj.MustDrop()
 	hsvAdj.MustDrop()
 
-	return out
+	return out, nil
 }
 
 func adjustGamma(x *ts.Tensor, gamma float64, gainOpt ...int64) *ts.Tensor {
@@ -530,28 +593,31 @@ func HSV2RGB(x *ts.Tensor) *ts.Tensor {
 	return hsv2RGB(x)
 }
 
-func pad(x *ts.Tensor, padding []int64, paddingMode string) *ts.Tensor {
+func pad(x *ts.Tensor, padding []int64, paddingMode string) (*ts.Tensor, error) {
 	switch paddingMode {
 	case "reflection":
-		return x.MustReflectionPad2d(padding, false)
+		return x.ReflectionPad2d(padding, false)
 	case "constant":
-		return x.MustConstantPadNd(padding, false)
+		return x.ConstantPadNd(padding, false)
 	case "replicate":
-		return x.MustReplicationPad2d(padding, false)
+		return x.ReplicationPad2d(padding, false)
 	case "circular":
 		// TODO:
 		// ref: https://github.com/pytorch/pytorch/blob/71f4c5c1f436258adc303b710efb3f41b2d50c4e/torch/nn/functional.py#L4493
-		log.Fatal("Unsupported circular padding.")
+		err := fmt.Errorf("Unsupported circular padding.\n")
+		return nil, err
 	default:
-		log.Fatalf("Unrecognized padding mode %q\n", paddingMode)
+		err := fmt.Errorf("Unrecognized padding mode %q\n", paddingMode)
+		return nil, err
 	}
-	return nil
 }
 
-func getImageSize(x *ts.Tensor) (width, height int64) {
-	assertImageTensor(x)
+func getImageSize(x *ts.Tensor) (width, height int64, err error) {
+	if err := assertImageTensor(x); err != nil {
+		return 0, 0, err
+	}
 	dim := x.MustSize()
-	return dim[len(dim)-1], dim[len(dim)-2]
+	return dim[len(dim)-1], dim[len(dim)-2], nil
 }
 
 func makeSlice(from, to int64) []int64 {
@@ -593,8 +659,11 @@ func crop(x *ts.Tensor, top, left, height, width int64) *ts.Tensor {
 // If the image is torch Tensor, it is expected
 // to have [..., H, W] shape, where ... means an arbitrary number of leading dimensions.
 // If image size is smaller than output size along any edge, image is padded with 0 and then center cropped.
-func centerCrop(x *ts.Tensor, size []int64) *ts.Tensor {
-	imgW, imgH := getImageSize(x)
+func centerCrop(x *ts.Tensor, size []int64) (*ts.Tensor, error) {
+	imgW, imgH, err := getImageSize(x)
+	if err != nil {
+		return nil, err
+	}
 	cropH, cropW := size[0], size[1]
 
 	var paddedImg *ts.Tensor
@@ -618,10 +687,13 @@ func centerCrop(x *ts.Tensor, size []int64) *ts.Tensor {
 		// paddedImg = pad(floatX, []int64{left, right, top, bottom}, "reflection")
 		// floatX.MustDrop()
 
-		paddedImg = pad(x, []int64{left, right, top, bottom}, "constant")
-		imgW, imgH = getImageSize(paddedImg)
+		paddedImg, err = pad(x, []int64{left, right, top, bottom}, "constant")
+		if err != nil {
+			return nil, err
+		}
+		imgW, imgH, _ = getImageSize(paddedImg)
 		if cropW == imgW && cropH == imgH {
-			return paddedImg
+			return paddedImg, nil
 		}
 	} else {
 		paddedImg = x.MustShallowClone()
@@ -635,7 +707,7 @@ func centerCrop(x *ts.Tensor, size []int64) *ts.Tensor {
 	out := crop(paddedImg, cropTop, cropLeft, cropH, cropW)
 	paddedImg.MustDrop()
 
-	return out
+	return out, nil
 }
 
 // cutout erases the input Tensor Image with given value
@@ -649,7 +721,7 @@ func centerCrop(x *ts.Tensor, size []int64) *ts.Tensor {
 // v: Erasing value.
 func cutout(x *ts.Tensor, top, left, height, width int64, rgbVal []int64) *ts.Tensor {
 	output := x.MustZerosLike(false)
-	output.Copy_(x)
+	output.MustCopy_(x)
 	dim := output.MustSize()
 	for i := 0; i < int(dim[0]); i++ {
 		cIdx := ts.NewSelect(int64(i))
@@ -659,7 +731,7 @@ func cutout(x *ts.Tensor, top, left, height, width int64, rgbVal []int64) *ts.Te
 		view := output.Idx(srcIdx)
 		oneTs := view.MustOnesLike(false)
 		vTs := oneTs.MustMul1(ts.IntScalar(rgbVal[i]), true)
-		view.Copy_(vTs)
+		view.MustCopy_(vTs)
 		vTs.MustDrop()
 		view.MustDrop()
 	}
@@ -668,14 +740,18 @@ func cutout(x *ts.Tensor, top, left, height, width int64, rgbVal []int64) *ts.Te
 	return output
 }
 
-func hflip(x *ts.Tensor) *ts.Tensor {
-	assertImageTensor(x)
-	return x.MustFlip([]int64{-1}, false)
+func hflip(x *ts.Tensor) (*ts.Tensor, error) {
+	if err := assertImageTensor(x); err != nil {
+		return nil, err
+	}
+	return x.Flip([]int64{-1}, false)
 }
 
-func vflip(x *ts.Tensor) *ts.Tensor {
-	assertImageTensor(x)
-	return x.MustFlip([]int64{-2}, false)
+func vflip(x *ts.Tensor) (*ts.Tensor, error) {
+	if err := assertImageTensor(x); err != nil {
+		return nil, err
+	}
+	return x.Flip([]int64{-2}, false)
 }
 
 // Ref. https://stackoverflow.com/questions/64197754
@@ -797,12 +873,12 @@ func perspectiveCoeff(startPoints, endPoints [][]int64) []float64 {
 
 		idx1 := ts.NewSelect(int64(2 * i))
 		aMatView1 := aMat.Idx(idx1)
-		aMatView1.Copy_(val1)
+		aMatView1.MustCopy_(val1)
 		val1.MustDrop()
 
 		idx2 := ts.NewSelect(int64(2*i + 1))
 		aMatView2 := aMat.Idx(idx2)
-		aMatView2.Copy_(val2)
+		aMatView2.MustCopy_(val2)
 		val2.MustDrop()
 	}
 
@@ -866,12 +942,12 @@ func perspectiveGrid(coef []float64, ow, oh int64, dtype gotch.DType, device got
 	baseDim := baseGrid.MustSize()
 	for i := 0; i < int(baseDim[1]); i++ {
 		view := baseGrid.MustSelect(0, 0, false).MustSelect(0, int64(i), true).MustSelect(1, 0, true)
-		view.Copy_(xGrid)
+		view.MustCopy_(xGrid)
 		view.MustDrop()
 	}
 	for i := 0; i < int(baseDim[2]); i++ {
 		view := baseGrid.MustSelect(0, 0, false).MustSelect(1, int64(i), true).MustSelect(1, 1, true)
-		view.Copy_(yGrid)
+		view.MustCopy_(yGrid)
 		view.MustDrop()
 	}
 
@@ -879,7 +955,7 @@ func perspectiveGrid(coef []float64, ow, oh int64, dtype gotch.DType, device got
 		view := baseGrid.MustSelect(0, 0, false).MustSelect(1, int64(i), true).MustSelect(1, 2, true)
 		// view.Fill_(ts.FloatScalar(1.0)) // NOTE. THIS CAUSES MEMORY LEAK!!!
 		oneTs := view.MustOnesLike(false)
-		view.Copy_(oneTs)
+		view.MustCopy_(oneTs)
 		oneTs.MustDrop()
 		view.MustDrop()
 	}
@@ -905,10 +981,12 @@ func perspectiveGrid(coef []float64, ow, oh int64, dtype gotch.DType, device got
 	return outputGrid
 }
 
-func perspective(x *ts.Tensor, startPoints, endPoints [][]int64, mode string, fillValue []float64) *ts.Tensor {
-	coef := perspectiveCoeff(startPoints, endPoints)
+func perspective(x *ts.Tensor, startPoints, endPoints [][]int64, mode string, fillValue []float64) (*ts.Tensor, error) {
+	if err := assertImageTensor(x); err != nil {
+		return nil, err
+	}
 
-	assertImageTensor(x)
+	coef := perspectiveCoeff(startPoints, endPoints)
 	// assertGridTransformInputs(x, nil, mode, fillValue, []string{"nearest", "bilinear"}, coef)
 
 	dim := x.MustSize()
@@ -919,7 +997,7 @@ func perspective(x *ts.Tensor, startPoints, endPoints [][]int64, mode string, fi
 	output := applyGridTransform(x, grid, mode, fillValue)
 	grid.MustDrop()
 
-	return output
+	return output, nil
 }
 
 // Apply affine transformation on the image keeping image center invariant.
@@ -1062,15 +1140,15 @@ func genAffineGrid(theta *ts.Tensor, w, h, ow, oh int64) *ts.Tensor {
 	// base_grid[..., 1].copy_(y_grid)
 	// base_grid[..., 2].fill_(1)
 	xview := x.MustTranspose(2, 0, false).MustSelect(0, 0, true).MustTranspose(0, 1, true)
-	xview.Copy_(xGrid)
+	xview.MustCopy_(xGrid)
 	xview.MustDrop()
 
 	yview := x.MustTranspose(2, 0, false).MustSelect(0, 1, true).MustTranspose(0, 1, true)
-	yview.Copy_(yGrid)
+	yview.MustCopy_(yGrid)
 	yview.MustDrop()
 
 	oview := x.MustTranspose(2, 0, false).MustSelect(0, 2, true).MustTranspose(0, 1, true)
-	oview.Copy_(oneGrid)
+	oview.MustCopy_(oneGrid)
 	oview.MustDrop()
 
 	// rescaled_theta1 = theta1.transpose(1, 2) / torch.tensor([0.5 * ow, 0.5 * oh], dtype=dtype, device=device)
@@ -1089,26 +1167,23 @@ func genAffineGrid(theta *ts.Tensor, w, h, ow, oh int64) *ts.Tensor {
 
 // randPvalue generates a random propability value [0, 1]
 func randPvalue() float64 {
-	rand.Seed(time.Now().UnixNano())
 	var min, max float64 = 0.0, 1.0
 
-	r := min + rand.Float64()*(max-min)
+	r := min + gotch.Rand().Float64()*(max-min)
 	return r
 }
 
-func getImageChanNum(x *ts.Tensor) int64 {
+func getImageChanNum(x *ts.Tensor) (int64, error) {
 	dim := x.MustSize()
 	switch {
 	case len(dim) == 2:
-		return 1
+		return 1, nil
 	case len(dim) > 2:
-		return dim[len(dim)-3]
+		return dim[len(dim)-3], nil
 	default:
-		log.Fatalf("Input image tensor should have dim of 2 or more. Got %v\n", len(dim))
+		err := fmt.Errorf("Input image tensor should have dim of 2 or more. Got %v\n", len(dim))
+		return -1, err
 	}
-
-	log.Fatalf("Input image tensor should have dim of 2 or more. Got %v\n", len(dim))
-	return -1
 }
 
 // solarize solarizes an RGB/grayscale image by inverting all pixel values above a threshold.
@@ -1117,18 +1192,12 @@ func getImageChanNum(x *ts.Tensor) int64 {
 // If img is torch Tensor, it is expected to be in [..., 1 or 3, H, W] format,
 // where ... means it can have an arbitrary number of leading dimensions.
 // - threshold (float): All pixels equal or above this value are inverted.
-func solarize(img *ts.Tensor, threshold float64) *ts.Tensor {
-	assertImageTensor(img)
-
-	dim := img.MustSize()
-	if len(dim) < 3 {
-		log.Fatalf("Input image tensor should have at least 3 dimensions. Got %v", len(dim))
+func solarize(img *ts.Tensor, threshold float64) (*ts.Tensor, error) {
+	invertedImg, err := invert(img)
+	if err != nil {
+		return nil, err
 	}
 
-	assertChannels(img, []int64{1, 3})
-
-	invertedImg := invert(img)
-
 	// return torch.where(img >= threshold, inverted_img, img)
 	conditionTs := img.MustGe(ts.FloatScalar(threshold), false)
 
@@ -1137,62 +1206,82 @@ func solarize(img *ts.Tensor, threshold float64) *ts.Tensor {
 	invertedImg.MustDrop()
 	conditionTs.MustDrop()
 
-	return out
+	return out, nil
 }
 
 // invert inverts image tensor.
-func invert(img *ts.Tensor) *ts.Tensor {
-	assertImageTensor(img)
+func invert(img *ts.Tensor) (*ts.Tensor, error) {
+	if err := assertImageTensor(img); err != nil {
+		return nil, err
+	}
 
 	dim := img.MustSize()
 	if len(dim) < 3 {
-		log.Fatalf("Input image tensor should have at least 3 dimensions. Got %v", len(dim))
+		err := fmt.Errorf("Input image tensor should have at least 3 dimensions. Got %v\n", len(dim))
+		return nil, err
 	}
 
-	assertChannels(img, []int64{1, 3})
+	if err := assertChannels(img, []int64{1, 3}); err != nil {
+		return nil, err
+	}
 
 	var bound int64 = 255
 	// return bound - img
 	out := img.MustMul1(ts.IntScalar(-1), false).MustAdd1(ts.IntScalar(bound), true)
-	return out
+	return out, nil
 }
 
-func posterize(img *ts.Tensor, bits uint8) *ts.Tensor {
-	assertImageTensor(img)
+func posterize(img *ts.Tensor, bits uint8) (*ts.Tensor, error) {
+	if err := assertImageTensor(img); err != nil {
+		return nil, err
+	}
 
 	dim := img.MustSize()
 
 	if len(dim) < 3 {
-		log.Fatalf("Input image tensor should have at least 3 dimensions. Got %v\n", len(dim))
+		err := fmt.Errorf("Input image tensor should have at least 3 dimensions. Got %v\n", len(dim))
+		return nil, err
 	}
 
-	dtype := img.DType()
+	dtype, err := img.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 	if dtype != gotch.Uint8 {
-		log.Fatalf("Only dtype uint8 image tensors are supported. Got %v", dtype)
+		err := fmt.Errorf("Only dtype uint8 image tensors are supported. Got %v\n", dtype)
+		return nil, err
 	}
 
-	assertChannels(img, []int64{1, 3})
+	if err := assertChannels(img, []int64{1, 3}); err != nil {
+		return nil, err
+	}
 
 	// mask = -int(2**(8 - bits))  # JIT-friendly for: ~(2 ** (8 - bits) - 1)
 	// or mask := -int64(1<<(uint8(8) - bits))
 	mask := -int64(math.Exp2(float64(uint8(8) - bits)))
 
 	out := img.MustBitwiseAnd(ts.IntScalar(mask), false)
-	return out
+	return out, nil
 }
 
-func autocontrast(img *ts.Tensor) *ts.Tensor {
-	assertImageTensor(img)
+func autocontrast(img *ts.Tensor) (*ts.Tensor, error) {
+	if err := assertImageTensor(img); err != nil {
+		return nil, err
+	}
 
 	dim := img.MustSize()
 
 	if len(dim) < 3 {
-		log.Fatalf("Input image tensor should have at least 3 dimensions. Got %v\n", len(dim))
+		err := fmt.Errorf("Input image tensor should have at least 3 dimensions. Got %v\n", len(dim))
+		return nil, err
 	}
 
 	// NOTE. image tensor expected to be float dtype [0,1]
 	var bound float64 = 1.0
-	dtype := img.DType()
+	dtype, err := img.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 
 	// minimum = img.amin(dim=(-2, -1), keepdim=True).to(dtype)
 	minTs := img.MustAmin([]int64{-2, -1}, true, false).MustTotype(dtype, true)
@@ -1206,14 +1295,14 @@ func autocontrast(img *ts.Tensor) *ts.Tensor {
 	// minimum[eq_idxs] = 0
 	minTsView := minTs.MustIndexSelect(0, eqIdx, false)
 	zerosTs := minTsView.MustZerosLike(false)
-	minTsView.Copy_(zerosTs)
+	minTsView.MustCopy_(zerosTs)
 	zerosTs.MustDrop()
 	minTsView.MustDrop()
 
 	// maximum[eq_idxs] = bound
 	maxTsView := maxTs.MustIndexSelect(0, eqIdx, false)
 	boundTs := maxTsView.MustOnesLike(false).MustMul1(ts.FloatScalar(bound), true)
-	maxTsView.Copy_(boundTs)
+	maxTsView.MustCopy_(boundTs)
 	boundTs.MustDrop()
 	maxTsView.MustDrop()
 
@@ -1228,23 +1317,28 @@ func autocontrast(img *ts.Tensor) *ts.Tensor {
 	eqIdx.MustDrop()
 	scale.MustDrop()
 
-	return out
+	return out, nil
 }
 
-func adjustSharpness(img *ts.Tensor, factor float64) *ts.Tensor {
+func adjustSharpness(img *ts.Tensor, factor float64) (*ts.Tensor, error) {
 	if factor < 0 {
-		log.Fatalf("Sharpness factor should not be negative. Got %v", factor)
+		err := fmt.Errorf("Sharpness factor should not be negative. Got %v\n", factor)
+		return nil, err
 	}
 
-	assertImageTensor(img)
-	assertChannels(img, []int64{1, 3})
+	if err := assertImageTensor(img); err != nil {
+		return nil, err
+	}
+	if err := assertChannels(img, []int64{1, 3}); err != nil {
+		return nil, err
+	}
 
 	dim := img.MustSize()
 
 	var out *ts.Tensor
 	if (dim[len(dim)-1]) <= 2 || (dim[len(dim)-2] <= 2) {
 		out = img.MustShallowClone()
-		return out
+		return out, nil
 	}
 
 	// return _blend(img, _blurred_degenerate_image(img), sharpness_factor)
@@ -1252,7 +1346,7 @@ func adjustSharpness(img *ts.Tensor, factor float64) *ts.Tensor {
 	out = blend(img, img1, factor)
 
 	img1.MustDrop()
-	return out
+	return out, nil
 }
 
 func blurredDegenerateImage(img *ts.Tensor) *ts.Tensor {
@@ -1266,7 +1360,7 @@ func blurredDegenerateImage(img *ts.Tensor) *ts.Tensor {
 	// kernel[1, 1] = 5.0
 	kernelView := kernel.MustNarrow(1, 1, 1, false).MustNarrow(0, 1, 1, true)
 	centerVal := kernelView.MustOnesLike(false).MustMul1(ts.FloatScalar(5.0), true)
-	kernelView.Copy_(centerVal) // center kernel value
+	kernelView.MustCopy_(centerVal) // center kernel value
 	centerVal.MustDrop()
 	kernelView.MustDrop()
 
@@ -1304,7 +1398,7 @@ func blurredDegenerateImage(img *ts.Tensor) *ts.Tensor {
 	wDim := int64(len(dim) - 1) // last dim
 	outView := out.MustNarrow(hDim, 1, dim[len(dim)-2]-2, false).MustNarrow(wDim, 1, dim[len(dim)-1]-2, true)
 
-	outView.Copy_(resTmp2)
+	outView.MustCopy_(resTmp2)
 
 	outView.MustDrop()
 	kernelS.MustDrop()
@@ -1316,27 +1410,36 @@ func blurredDegenerateImage(img *ts.Tensor) *ts.Tensor {
 	return out
 }
 
-func equalize(img *ts.Tensor) *ts.Tensor {
-	assertImageTensor(img)
+func equalize(img *ts.Tensor) (*ts.Tensor, error) {
+	if err := assertImageTensor(img); err != nil {
+		return nil, err
+	}
 
 	shape := img.MustSize()
 	ndim := len(shape)
-	dtype := img.DType()
+	dtype, err := img.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 
 	if ndim < 3 || ndim > 4 {
-		log.Fatalf("Input image should have 3 or 4 dimensions. Got %v", ndim)
+		err := fmt.Errorf("Input image should have 3 or 4 dimensions. Got %v\n", ndim)
+		return nil, err
 	}
 
 	if dtype != gotch.Uint8 {
-		log.Fatalf("Only dtype uint8 image tensors are supported. Got %v", dtype)
+		err := fmt.Errorf("Only dtype uint8 image tensors are supported. Got %v\n", dtype)
+		return nil, err
 	}
 
-	assertChannels(img, []int64{1, 3})
+	if err := assertChannels(img, []int64{1, 3}); err != nil {
+		return nil, err
+	}
 
 	// single image
 	if ndim == 3 {
 		out := equalizeSingleImage(img)
-		return out
+		return out, nil
 	}
 
 	// batched images
@@ -1353,7 +1456,7 @@ func equalize(img *ts.Tensor) *ts.Tensor {
 		x.MustDrop()
 	}
 
-	return out
+	return out, nil
 }
 
 func equalizeSingleImage(img *ts.Tensor) *ts.Tensor {
@@ -1432,20 +1535,24 @@ func scaleChannel(imgChan *ts.Tensor) *ts.Tensor {
 // - std (sequence): Sequence of standard deviations for each channel.
 // Returns:
 // - Tensor: Normalized Tensor image.
-func normalize(img *ts.Tensor, mean, std []float64) *ts.Tensor {
+func normalize(img *ts.Tensor, mean, std []float64) (*ts.Tensor, error) {
 	for _, v := range std {
 		if v == 0 {
-			log.Fatalf("One of std (%v) is zero. This is invalid as it leads to division by zero.", std)
+			err := fmt.Errorf("One of std (%v) is zero. This is invalid as it leads to division by zero.\n", std)
+			return nil, err
 		}
 	}
 
-	assertImageTensor(img)
+	if err := assertImageTensor(img); err != nil {
+		return nil, err
+	}
 
 	dim := img.MustSize()
 	// dtype := img.DType()
 	device := img.MustDevice()
 	if len(dim) < 3 {
-		log.Fatalf("Expected tensor to be a tensor image of size (..., C, H, W). Got tensor.size() =%v", dim)
+		err := fmt.Errorf("Expected tensor to be a tensor image of size (..., C, H, W). Got tensor.size() =%v\n", dim)
+		return nil, err
 	}
 
 	meanTs := ts.MustOfSlice(mean).MustTotype(gotch.Float, true).MustTo(device, true)
@@ -1461,7 +1568,10 @@ func normalize(img *ts.Tensor, mean, std []float64) *ts.Tensor {
 		mTs = meanTs.MustShallowClone()
 		meanTs.MustDrop()
 	default:
-		log.Fatalf("mean must be 1 or 3 elements. Got %v\n", len(mean))
+		meanTs.MustDrop()
+		stdTs.MustDrop()
+		err := fmt.Errorf("mean must be 1 or 3 elements. Got %v\n", len(mean))
+		return nil, err
 	}
 
 	switch len(stdSize) {
@@ -1471,7 +1581,10 @@ func normalize(img *ts.Tensor, mean, std []float64) *ts.Tensor {
 		sTs = stdTs.MustShallowClone()
 		stdTs.MustDrop()
 	default:
-		log.Fatalf("std must be 1 or 3 elements. Got %v\n", len(std))
+		mTs.MustDrop()
+		stdTs.MustDrop()
+		err := fmt.Errorf("std must be 1 or 3 elements. Got %v\n", len(std))
+		return nil, err
 	}
 
 	out := img.MustSub(mTs, false).MustDiv(sTs, true)
@@ -1479,29 +1592,62 @@ func normalize(img *ts.Tensor, mean, std []float64) *ts.Tensor {
 	mTs.MustDrop()
 	sTs.MustDrop()
 
-	return out
+	return out, nil
 }
 
 // Byte2FloatImage converts uint8 dtype image tensor to float dtype.
-// It's panic if input image is not uint8 dtype.
-func Byte2FloatImage(x *ts.Tensor) *ts.Tensor {
-	dtype := x.DType()
+// It returns an error if input image is not uint8 dtype.
+func Byte2FloatImage(x *ts.Tensor) (*ts.Tensor, error) {
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 	if dtype != gotch.Uint8 {
-		err := fmt.Errorf("Input tensor is not uint8 dtype (%v)", dtype)
-		panic(err)
+		err := fmt.Errorf("Input tensor is not uint8 dtype (%v)\n", dtype)
+		return nil, err
+	}
+
+	return x.Div1(ts.FloatScalar(255.0), false)
+}
+
+// MustByte2FloatImage converts uint8 dtype image tensor to float dtype.
+// It panics if error occurred.
+func MustByte2FloatImage(x *ts.Tensor) *ts.Tensor {
+	out, err := Byte2FloatImage(x)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	return x.MustDiv1(ts.FloatScalar(255.0), false)
+	return out
 }
 
 // Float2ByteImage converts float dtype image to uint8 dtype image.
-// It's panic if input is not float dtype tensor.
-func Float2ByteImage(x *ts.Tensor) *ts.Tensor {
-	dtype := x.DType()
+// It returns an error if input is not float dtype tensor.
+func Float2ByteImage(x *ts.Tensor) (*ts.Tensor, error) {
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 	if dtype != gotch.Float && dtype != gotch.Double {
-		err := fmt.Errorf("Input tensor is not float dtype (%v)", dtype)
-		panic(err)
+		err := fmt.Errorf("Input tensor is not float dtype (%v)\n", dtype)
+		return nil, err
+	}
+
+	scaled, err := x.Mul1(ts.IntScalar(255), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return scaled.Totype(gotch.Uint8, true)
+}
+
+// MustFloat2ByteImage converts float dtype image to uint8 dtype image.
+// It panics if error occurred.
+func MustFloat2ByteImage(x *ts.Tensor) *ts.Tensor {
+	out, err := Float2ByteImage(x)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	return x.MustMul1(ts.IntScalar(255), false).MustTotype(gotch.Uint8, true)
+	return out
 }
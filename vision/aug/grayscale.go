@@ -1,7 +1,7 @@
 package aug
 
 import (
-	"log"
+	"fmt"
 
 	ts "github.com/sugarme/gotch/tensor"
 	// "github.com/sugarme/gotch/tensor"
@@ -16,19 +16,27 @@ type Grayscale struct {
 	outChan int64
 }
 
-func (gs *Grayscale) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for Grayscale struct.
+func (gs *Grayscale) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
-	out := rgb2Gray(fx, gs.outChan)
+	out, err := rgb2Gray(fx, gs.outChan)
+	if err != nil {
+		fx.MustDrop()
+		return nil, err
+	}
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
-func newGrayscale(outChanOpt ...int64) *Grayscale {
+func newGrayscale(outChanOpt ...int64) (*Grayscale, error) {
 	var outChan int64 = 3
 	if len(outChanOpt) > 0 {
 		c := outChanOpt[0]
@@ -38,10 +46,11 @@ func newGrayscale(outChanOpt ...int64) *Grayscale {
 		case 3:
 			outChan = 3
 		default:
-			log.Fatalf("Out channels should be either 1 or 3. Got %v\n", c)
+			err := fmt.Errorf("Out channels should be either 1 or 3. Got %v\n", c)
+			return nil, err
 		}
 	}
-	return &Grayscale{outChan}
+	return &Grayscale{outChan}, nil
 }
 
 // RandomGrayscale randomly converts image to grayscale with a probability of p (default 0.1).
@@ -61,18 +70,19 @@ func newRandomGrayscale(pvalueOpt ...float64) *RandomGrayscale {
 	return &RandomGrayscale{pvalue}
 }
 
-func (rgs *RandomGrayscale) Forward(x *ts.Tensor) *ts.Tensor {
-	c := getImageChanNum(x)
+// Transform implements Transformer interface for RandomGrayscale struct.
+func (rgs *RandomGrayscale) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	c, err := getImageChanNum(x)
+	if err != nil {
+		return nil, err
+	}
 	r := randPvalue()
-	var out *ts.Tensor
 	switch {
 	case r < rgs.pvalue:
-		out = rgb2Gray(x, c)
+		return rgb2Gray(x, c)
 	default:
-		out = x.MustShallowClone()
+		return x.MustShallowClone(), nil
 	}
-
-	return out
 }
 
 func WithRandomGrayscale(pvalueOpt ...float64) Option {
@@ -16,23 +16,31 @@ func newRandomInvert(pOpt ...float64) *RandomInvert {
 	return &RandomInvert{p}
 }
 
-func (ri *RandomInvert) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RandomInvert struct.
+func (ri *RandomInvert) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
 	r := randPvalue()
 	var out *ts.Tensor
 	switch {
 	case r < ri.pvalue:
-		out = invert(fx)
+		out, err = invert(fx)
+		if err != nil {
+			fx.MustDrop()
+			return nil, err
+		}
 	default:
 		out = fx.MustShallowClone()
 	}
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithRandomInvert(pvalueOpt ...float64) Option {
@@ -78,16 +78,24 @@ func newNormalize(opts ...normalizeOption) *Normalize {
 	}
 }
 
-func (n *Normalize) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for Normalize struct.
+func (n *Normalize) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
-	out := normalize(fx, n.mean, n.std)
+	out, err := normalize(fx, n.mean, n.std)
+	if err != nil {
+		fx.MustDrop()
+		return nil, err
+	}
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithNormalize(opts ...normalizeOption) Option {
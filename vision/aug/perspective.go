@@ -175,18 +175,29 @@ func (rp *RandomPerspective) getParams(w, h int64) ([][]int64, [][]int64) {
 	return startPoints, endPoints
 }
 
-func (rp *RandomPerspective) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RandomPerspective struct.
+func (rp *RandomPerspective) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	height, width, err := getImageSize(x)
+	if err != nil {
+		return nil, err
+	}
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
-	height, width := getImageSize(fx)
 	startPoints, endPoints := rp.getParams(height, width)
-	out := perspective(fx, startPoints, endPoints, rp.interpolationMode, rp.fillValue)
+	out, err := perspective(fx, startPoints, endPoints, rp.interpolationMode, rp.fillValue)
+	if err != nil {
+		fx.MustDrop()
+		return nil, err
+	}
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithRandomPerspective(opts ...perspectiveOption) Option {
@@ -54,18 +54,17 @@ func newRandomPosterize(opts ...posterizeOption) *RandomPosterize {
 	}
 }
 
-// NOTE. Input image must be uint8 dtype otherwise panic!
-func (rp *RandomPosterize) Forward(x *ts.Tensor) *ts.Tensor {
+// Transform implements Transformer interface for RandomPosterize struct.
+//
+// NOTE. Input image must be uint8 dtype otherwise error is returned.
+func (rp *RandomPosterize) Transform(x *ts.Tensor) (*ts.Tensor, error) {
 	r := randPvalue()
-	var out *ts.Tensor
 	switch {
 	case r < rp.pvalue:
-		out = posterize(x, rp.bits)
+		return posterize(x, rp.bits)
 	default:
-		out = x.MustShallowClone()
+		return x.MustShallowClone(), nil
 	}
-
-	return out
 }
 
 func WithRandomPosterize(opts ...posterizeOption) Option {
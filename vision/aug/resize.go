@@ -2,7 +2,6 @@ package aug
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
@@ -18,13 +17,16 @@ func newResizeModule(h, w int64) *ResizeModule {
 	return &ResizeModule{h, w}
 }
 
-// Forward implements ts.Module for RandRotateModule
-// NOTE. input tensor must be uint8 (Byte) dtype otherwise panic!
-func (rs *ResizeModule) Forward(x *ts.Tensor) *ts.Tensor {
-	dtype := x.DType()
+// Transform implements Transformer interface for ResizeModule struct.
+// NOTE. input tensor must be uint8 (Byte) dtype otherwise error is returned.
+func (rs *ResizeModule) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 	if dtype != gotch.Uint8 {
 		err := fmt.Errorf("Invalid dtype. Expect uint8 (Byte) dtype. Got %v\n", dtype)
-		panic(err)
+		return nil, err
 	}
 
 	device := x.MustDevice()
@@ -36,13 +38,12 @@ func (rs *ResizeModule) Forward(x *ts.Tensor) *ts.Tensor {
 	}
 
 	out, err := vision.Resize(xCPU, rs.width, rs.height)
+	xCPU.MustDrop()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	xCPU.MustDrop()
-
-	return out.MustTo(device, true)
+	return out.To(device, true)
 }
 
 func WithResize(h, w int64) Option {
@@ -61,13 +62,16 @@ func newDownSample(p float64) *DownSample {
 	return &DownSample{}
 }
 
-// Forward implements ts.Module for RandRotateModule
-// NOTE. input tensor must be uint8 (Byte) dtype otherwise panic!
-func (rs *DownSample) Forward(x *ts.Tensor) *ts.Tensor {
-	dtype := x.DType()
+// Transform implements Transformer interface for DownSample struct.
+// NOTE. input tensor must be uint8 (Byte) dtype otherwise error is returned.
+func (rs *DownSample) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 	if dtype != gotch.Uint8 {
 		err := fmt.Errorf("Invalid dtype. Expect uint8 (Byte) dtype. Got %v\n", dtype)
-		panic(err)
+		return nil, err
 	}
 
 	device := x.MustDevice()
@@ -81,12 +85,12 @@ func (rs *DownSample) Forward(x *ts.Tensor) *ts.Tensor {
 	}
 
 	out, err := vision.Resize(xCPU, w/2, h/2)
+	xCPU.MustDrop()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	xCPU.MustDrop()
-	return out.MustTo(device, true)
+	return out.To(device, true)
 }
 
 type ZoomIn struct {
@@ -98,23 +102,27 @@ func newZoomIn(v float64) *ZoomIn {
 }
 
 func WithZoomIn(v float64) Option {
-	if v < 0 || v > 0.5 {
-		err := fmt.Errorf("Invalid input value. Expect value in range [0, 0.5]. Got %v\n", v)
-		panic(err)
-	}
 	return func(o *Options) {
+		if v < 0 || v > 0.5 {
+			err := fmt.Errorf("Invalid input value. Expect value in range [0, 0.5]. Got %v\n", v)
+			o.setErr(err)
+			return
+		}
 		ds := newZoomIn(v)
 		o.zoomIn = ds
 	}
 }
 
-// Forward implements ts.Module for RandRotateModule
-// NOTE. input tensor must be uint8 (Byte) dtype otherwise panic!
-func (rs *ZoomIn) Forward(x *ts.Tensor) *ts.Tensor {
-	dtype := x.DType()
+// Transform implements Transformer interface for ZoomIn struct.
+// NOTE. input tensor must be uint8 (Byte) dtype otherwise error is returned.
+func (rs *ZoomIn) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 	if dtype != gotch.Uint8 {
 		err := fmt.Errorf("Invalid dtype. Expect uint8 (Byte) dtype. Got %v\n", dtype)
-		panic(err)
+		return nil, err
 	}
 
 	device := x.MustDevice()
@@ -128,7 +136,6 @@ func (rs *ZoomIn) Forward(x *ts.Tensor) *ts.Tensor {
 	}
 
 	var out *ts.Tensor
-	var err error
 	r := randPvalue()
 	switch {
 	case r < rs.v:
@@ -137,26 +144,28 @@ func (rs *ZoomIn) Forward(x *ts.Tensor) *ts.Tensor {
 		newW := w - cropW
 		newH := h - cropH
 		// img = PIL.ImageOps.fit(img, size=(new_w,new_h), bleed=v/2, method=Image.BILINEAR)
-		fitImg := fitImg(xCPU, newW, newH)
+		fitImg, err := fitImg(xCPU, newW, newH)
 		xCPU.MustDrop()
+		if err != nil {
+			return nil, err
+		}
 		// return img.resize((w,h), resample=Image.BILINEAR)
 		out, err = vision.Resize(fitImg, w, h)
+		fitImg.MustDrop()
 		if err != nil {
-			log.Fatal(err)
+			return nil, err
 		}
-
-		fitImg.MustDrop()
 	default:
 		out = x.MustShallowClone()
 	}
 
-	return out.MustTo(device, true)
+	return out.To(device, true)
 }
 
 // TODO.
-func fitImg(x *ts.Tensor, w, h int64) *ts.Tensor {
-
-	panic("Not implemented")
+func fitImg(x *ts.Tensor, w, h int64) (*ts.Tensor, error) {
+	err := fmt.Errorf("fitImg - Not implemented.\n")
+	return nil, err
 }
 
 type ZoomOut struct {
@@ -168,23 +177,27 @@ func newZoomOut(v float64) *ZoomOut {
 }
 
 func WithZoomOut(v float64) Option {
-	if v < 0 || v > 0.5 {
-		err := fmt.Errorf("Invalid input value. Expect value in range [0, 0.5]. Got %v\n", v)
-		panic(err)
-	}
 	return func(o *Options) {
+		if v < 0 || v > 0.5 {
+			err := fmt.Errorf("Invalid input value. Expect value in range [0, 0.5]. Got %v\n", v)
+			o.setErr(err)
+			return
+		}
 		ds := newZoomOut(v)
 		o.zoomOut = ds
 	}
 }
 
-// Forward implements ts.Module for RandRotateModule
-// NOTE. input tensor must be uint8 (Byte) dtype otherwise panic!
-func (rs *ZoomOut) Forward(x *ts.Tensor) *ts.Tensor {
-	dtype := x.DType()
+// Transform implements Transformer interface for ZoomOut struct.
+// NOTE. input tensor must be uint8 (Byte) dtype otherwise error is returned.
+func (rs *ZoomOut) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
+	}
 	if dtype != gotch.Uint8 {
 		err := fmt.Errorf("Invalid dtype. Expect uint8 (Byte) dtype. Got %v\n", dtype)
-		panic(err)
+		return nil, err
 	}
 
 	device := x.MustDevice()
@@ -195,7 +208,11 @@ func (rs *ZoomOut) Forward(x *ts.Tensor) *ts.Tensor {
 		xCPU = x.MustShallowClone()
 	}
 
-	Fimg := Byte2FloatImage(xCPU)
+	Fimg, err := Byte2FloatImage(xCPU)
+	xCPU.MustDrop()
+	if err != nil {
+		return nil, err
+	}
 
 	fmt.Printf("Fimg size: %v\n", Fimg.MustSize())
 	h := float64(Fimg.MustSize()[1])
@@ -207,16 +224,17 @@ func (rs *ZoomOut) Forward(x *ts.Tensor) *ts.Tensor {
 	// img = np.pad(img, [(pad_h//2,pad_h//2), (pad_w//2,pad_w//2), (0,0)], mode='reflect')
 	padding := []int64{padH / 2, padH / 2, padW / 2, padW / 2, 0, 0}
 	fmt.Printf("padding: %+v\n", padding)
-	padImg := pad(Fimg, padding, "reflection")
-	xCPU.MustDrop()
+	padImg, err := pad(Fimg, padding, "reflection")
 	Fimg.MustDrop()
+	if err != nil {
+		return nil, err
+	}
 	// return img.resize((w,h), resample=Image.BILINEAR)
 	out, err := vision.Resize(padImg, int64(w), int64(h))
+	padImg.MustDrop()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	padImg.MustDrop()
-
-	return out.MustTo(device, true)
+	return out.To(device, true)
 }
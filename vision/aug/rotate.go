@@ -2,10 +2,7 @@ package aug
 
 import (
 	"fmt"
-	"log"
 	"math"
-	"math/rand"
-	"time"
 
 	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
@@ -24,8 +21,7 @@ func RandomRotate(img *ts.Tensor, min, max float64) (*ts.Tensor, error) {
 	}
 	// device := img.MustDevice()
 	dtype := gotch.Double
-	rand.Seed(time.Now().UnixNano())
-	angle := min + rand.Float64()*(max-min)
+	angle := min + gotch.Rand().Float64()*(max-min)
 
 	theta := float64(angle) * (math.Pi / 180)
 	input := img.MustUnsqueeze(0, false).MustTotype(dtype, true)
@@ -64,20 +60,23 @@ func newRotate(angle float64) *RotateModule {
 	return &RotateModule{angle}
 }
 
-// Forward implements ts.Module for RotateModule
-func (r *RotateModule) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RotateModule struct.
+func (r *RotateModule) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
 	out, err := Rotate(fx, r.angle)
+	fx.MustDrop()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	bx := Float2ByteImage(out)
-	fx.MustDrop()
+	bx, err := Float2ByteImage(out)
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithRotate(angle float64) Option {
@@ -97,20 +96,23 @@ func newRandRotate(min, max float64) *RandRotateModule {
 	return &RandRotateModule{min, max}
 }
 
-// Forward implements ts.Module for RandRotateModule
-func (rr *RandRotateModule) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RandRotateModule struct.
+func (rr *RandRotateModule) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
 	out, err := RandomRotate(fx, rr.minAngle, rr.maxAngle)
+	fx.MustDrop()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	bx := Float2ByteImage(out)
-	fx.MustDrop()
+	bx, err := Float2ByteImage(out)
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithRandRotate(minAngle, maxAngle float64) Option {
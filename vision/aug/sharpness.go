@@ -53,18 +53,17 @@ func newRandomAdjustSharpness(opts ...sharpnessOption) *RandomAdjustSharpness {
 	}
 }
 
+// Transform implements Transformer interface for RandomAdjustSharpness struct.
+//
 // NOTE. input img dtype shoule be `uint8` (Byte)
-func (ras *RandomAdjustSharpness) Forward(x *ts.Tensor) *ts.Tensor {
+func (ras *RandomAdjustSharpness) Transform(x *ts.Tensor) (*ts.Tensor, error) {
 	r := randPvalue()
-	var out *ts.Tensor
 	switch {
 	case r < ras.pvalue:
-		out = adjustSharpness(x, ras.sharpnessFactor)
+		return adjustSharpness(x, ras.sharpnessFactor)
 	default:
-		out = x.MustShallowClone()
+		return x.MustShallowClone(), nil
 	}
-
-	return out
 }
 
 func WithRandomAdjustSharpness(opts ...sharpnessOption) Option {
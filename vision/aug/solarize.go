@@ -56,23 +56,31 @@ func newRandomSolarize(opts ...solarizeOption) *RandomSolarize {
 	}
 }
 
-func (rs *RandomSolarize) Forward(x *ts.Tensor) *ts.Tensor {
-	fx := Byte2FloatImage(x)
+// Transform implements Transformer interface for RandomSolarize struct.
+func (rs *RandomSolarize) Transform(x *ts.Tensor) (*ts.Tensor, error) {
+	fx, err := Byte2FloatImage(x)
+	if err != nil {
+		return nil, err
+	}
 
 	r := randPvalue()
 	var out *ts.Tensor
 	switch {
 	case r < rs.pvalue:
-		out = solarize(fx, rs.threshold)
+		out, err = solarize(fx, rs.threshold)
+		if err != nil {
+			fx.MustDrop()
+			return nil, err
+		}
 	default:
 		out = fx.MustShallowClone()
 	}
 
-	bx := Float2ByteImage(out)
+	bx, err := Float2ByteImage(out)
 	fx.MustDrop()
 	out.MustDrop()
 
-	return bx
+	return bx, err
 }
 
 func WithRandomSolarize(opts ...solarizeOption) Option {
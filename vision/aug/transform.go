@@ -1,26 +1,46 @@
 package aug
 
 import (
-	"math/rand"
-	"time"
+	"log"
 
-	"github.com/sugarme/gotch/nn"
+	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
 )
 
 // Transformer is an interface that can transform an image tensor.
 type Transformer interface {
-	Transform(x *ts.Tensor) *ts.Tensor
+	Transform(x *ts.Tensor) (*ts.Tensor, error)
 }
 
 // Augment is a struct composes of augmentation functions to implement Transformer interface.
 type Augment struct {
-	augments *nn.Sequential
+	augments []Transformer
 }
 
 // Transform implements Transformer interface for Augment struct.
-func (a *Augment) Transform(image *ts.Tensor) *ts.Tensor {
-	out := a.augments.Forward(image)
+//
+// Augmentations are applied in order. Input image is not dropped.
+func (a *Augment) Transform(image *ts.Tensor) (*ts.Tensor, error) {
+	out := image.MustShallowClone()
+	for _, aug := range a.augments {
+		x, err := aug.Transform(out)
+		out.MustDrop()
+		if err != nil {
+			return nil, err
+		}
+		out = x
+	}
+
+	return out, nil
+}
+
+// MustTransform is like Transform but panics if error occurred.
+func (a *Augment) MustTransform(image *ts.Tensor) *ts.Tensor {
+	out, err := a.Transform(image)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return out
 }
 
@@ -48,6 +68,14 @@ type Options struct {
 	zoomIn                *ZoomIn
 	zoomOut               *ZoomOut
 	normalize             *Normalize
+	err                   error // first invalid option
+}
+
+// setErr keeps the first error returned by an option.
+func (o *Options) setErr(err error) {
+	if o.err == nil {
+		o.err = err
+	}
 }
 
 func defaultOption() *Options {
@@ -81,6 +109,7 @@ func defaultOption() *Options {
 type Option func(o *Options)
 
 // Compose creates a new Augment struct by adding augmentation methods.
+// It returns an error if any option is invalid.
 func Compose(opts ...Option) (Transformer, error) {
 	augOpts := defaultOption()
 	for _, opt := range opts {
@@ -88,99 +117,102 @@ func Compose(opts ...Option) (Transformer, error) {
 			opt(augOpts)
 		}
 	}
+	if augOpts.err != nil {
+		return nil, augOpts.err
+	}
 
-	var augs *nn.Sequential = nn.Seq()
+	var augs []Transformer
 
 	if augOpts.rotate != nil {
-		augs.Add(augOpts.rotate)
+		augs = append(augs, augOpts.rotate)
 	}
 
 	if augOpts.randRotate != nil {
-		augs.Add(augOpts.randRotate)
+		augs = append(augs, augOpts.randRotate)
 	}
 
 	if augOpts.resize != nil {
-		augs.Add(augOpts.resize)
+		augs = append(augs, augOpts.resize)
 	}
 
 	if augOpts.colorJitter != nil {
-		augs.Add(augOpts.colorJitter)
+		augs = append(augs, augOpts.colorJitter)
 	}
 
 	if augOpts.gaussianBlur != nil {
-		augs.Add(augOpts.gaussianBlur)
+		augs = append(augs, augOpts.gaussianBlur)
 	}
 
 	if augOpts.randomHFlip != nil {
-		augs.Add(augOpts.randomHFlip)
+		augs = append(augs, augOpts.randomHFlip)
 	}
 
 	if augOpts.randomVFlip != nil {
-		augs.Add(augOpts.randomVFlip)
+		augs = append(augs, augOpts.randomVFlip)
 	}
 
 	if augOpts.randomCrop != nil {
-		augs.Add(augOpts.randomCrop)
+		augs = append(augs, augOpts.randomCrop)
 	}
 
 	if augOpts.centerCrop != nil {
-		augs.Add(augOpts.centerCrop)
+		augs = append(augs, augOpts.centerCrop)
 	}
 
 	if augOpts.randomCutout != nil {
-		augs.Add(augOpts.randomCutout)
+		augs = append(augs, augOpts.randomCutout)
 	}
 
 	if augOpts.randomPerspective != nil {
-		augs.Add(augOpts.randomPerspective)
+		augs = append(augs, augOpts.randomPerspective)
 	}
 
 	if augOpts.randomAffine != nil {
-		augs.Add(augOpts.randomAffine)
+		augs = append(augs, augOpts.randomAffine)
 	}
 
 	if augOpts.randomGrayscale != nil {
-		augs.Add(augOpts.randomGrayscale)
+		augs = append(augs, augOpts.randomGrayscale)
 	}
 
 	if augOpts.randomSolarize != nil {
-		augs.Add(augOpts.randomSolarize)
+		augs = append(augs, augOpts.randomSolarize)
 	}
 
 	if augOpts.randomPosterize != nil {
-		augs.Add(augOpts.randomPosterize)
+		augs = append(augs, augOpts.randomPosterize)
 	}
 
 	if augOpts.randomInvert != nil {
-		augs.Add(augOpts.randomInvert)
+		augs = append(augs, augOpts.randomInvert)
 	}
 
 	if augOpts.randomAutocontrast != nil {
-		augs.Add(augOpts.randomAutocontrast)
+		augs = append(augs, augOpts.randomAutocontrast)
 	}
 
 	if augOpts.randomAdjustSharpness != nil {
-		augs.Add(augOpts.randomAdjustSharpness)
+		augs = append(augs, augOpts.randomAdjustSharpness)
 	}
 
 	if augOpts.randomEqualize != nil {
-		augs.Add(augOpts.randomEqualize)
+		augs = append(augs, augOpts.randomEqualize)
 	}
 
 	if augOpts.normalize != nil {
-		augs.Add(augOpts.normalize)
+		augs = append(augs, augOpts.normalize)
 	}
 
 	if augOpts.downSample != nil {
-		augs.Add(augOpts.downSample)
+		augs = append(augs, augOpts.downSample)
 	}
 
 	if augOpts.zoomIn != nil {
-		augs.Add(augOpts.zoomIn)
+		augs = append(augs, augOpts.zoomIn)
 	}
 
 	if augOpts.zoomOut != nil {
-		augs.Add(augOpts.zoomOut)
+		augs = append(augs, augOpts.zoomOut)
 	}
 
 	return &Augment{augs}, nil
@@ -199,8 +231,7 @@ func OneOf(pvalue float64, tfOpts ...Option) Option {
 		return nil
 	}
 
-	rand.Seed(time.Now().UnixNano())
-	idx := rand.Intn(tfsNum)
+	idx := gotch.Rand().Intn(tfsNum)
 
 	return tfOpts[idx]
 }
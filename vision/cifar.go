@@ -24,10 +24,11 @@ const (
 	samplesPerFile int64 = 10000
 )
 
-func readFile(filename string) (imagesTs *ts.Tensor, labelsTs *ts.Tensor) {
+func readFile(filename string) (imagesTs *ts.Tensor, labelsTs *ts.Tensor, err error) {
 	f, err := os.Open(filename)
 	if err != nil {
-		log.Fatalf("readImages errors: %v\n", err)
+		err = fmt.Errorf("readImages errors: %v\n", err)
+		return nil, nil, err
 	}
 	defer f.Close()
 
@@ -36,25 +37,42 @@ func readFile(filename string) (imagesTs *ts.Tensor, labelsTs *ts.Tensor) {
 	len, err := f.Read(data)
 	if err != nil || len != dataLen {
 		err = fmt.Errorf("invalid format %v", f.Name())
-		log.Fatal(err)
+		return nil, nil, err
 	}
 
 	content, err := ts.OfSlice(data)
 	if err != nil {
 		err = fmt.Errorf("create images tensor err.")
-		log.Fatal(err)
+		return nil, nil, err
 	}
 
 	images := ts.MustZeros([]int64{samplesPerFile, cfC, cfH, cfW}, gotch.Float, gotch.CPU)
 	labels := ts.MustZeros([]int64{samplesPerFile}, gotch.Int64, gotch.CPU)
+	fail := func(err error) (*ts.Tensor, *ts.Tensor, error) {
+		content.MustDrop()
+		images.MustDrop()
+		labels.MustDrop()
+		return nil, nil, err
+	}
 
 	for idx := 0; idx < int(samplesPerFile); idx++ {
 		contentOffset := int(bytesPerImage) * idx
 
-		labelContentTs := content.Idx(ts.NewSelect(int64(contentOffset)))
-		selectLabelTs := labels.Idx(ts.NewSelect(int64(idx)))
-		selectLabelTs.Copy_(labelContentTs)
+		labelContentTs, err := content.IdxE(ts.NewSelect(int64(contentOffset)))
+		if err != nil {
+			return fail(err)
+		}
+		selectLabelTs, err := labels.IdxE(ts.NewSelect(int64(idx)))
+		if err != nil {
+			labelContentTs.MustDrop()
+			return fail(err)
+		}
+		err = selectLabelTs.Copy_(labelContentTs)
 		labelContentTs.MustDrop()
+		selectLabelTs.MustDrop()
+		if err != nil {
+			return fail(err)
+		}
 
 		tmp1 := content.MustNarrow(0, int64(1+contentOffset), int64(bytesPerImage-1), false)
 		tmp2 := tmp1.MustView([]int64{cfC, cfH, cfW}, true)
@@ -62,26 +80,41 @@ func readFile(filename string) (imagesTs *ts.Tensor, labelsTs *ts.Tensor) {
 
 		// NOTE: tensor indexing operations return view on the same memory
 		// images.Idx(ts.NewSelect(int64(idx))).Copy_(tmp3)
-		images.Idx(ts.NewSelect(int64(idx))).MustView([]int64{cfC, cfH, cfW}, false).Copy_(tmp3)
+		imageTs, err := images.IdxE(ts.NewSelect(int64(idx)))
+		if err != nil {
+			tmp3.MustDrop()
+			return fail(err)
+		}
+		imageView := imageTs.MustView([]int64{cfC, cfH, cfW}, true)
+		err = imageView.Copy_(tmp3)
+		imageView.MustDrop()
 		tmp3.MustDrop()
+		if err != nil {
+			return fail(err)
+		}
 	}
+	content.MustDrop()
 
 	tmp1 := images.MustTotype(gotch.Float, true)
 	imagesTs = tmp1.MustDiv1(ts.FloatScalar(255.0), true)
 
 	labelsTs = labels
 
-	return imagesTs, labelsTs
+	return imagesTs, labelsTs, nil
 }
 
-func CFLoadDir(dir string) *Dataset {
+// CFLoadDir loads all CIFAR-10 data from a given directory to Dataset.
+func CFLoadDir(dir string) (*Dataset, error) {
 
 	dirAbs, err := filepath.Abs(dir)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	testImages, testLabels := readFile(fmt.Sprintf("%v/test_batch.bin", dirAbs))
+	testImages, testLabels, err := readFile(fmt.Sprintf("%v/test_batch.bin", dirAbs))
+	if err != nil {
+		return nil, err
+	}
 
 	var trainImages []ts.Tensor
 	var trainLabels []ts.Tensor
@@ -95,16 +128,39 @@ func CFLoadDir(dir string) *Dataset {
 	}
 
 	for _, f := range trainFiles {
-		img, l := readFile(fmt.Sprintf("%v/%v", dirAbs, f))
+		img, l, err := readFile(fmt.Sprintf("%v/%v", dirAbs, f))
+		if err != nil {
+			return nil, err
+		}
 		trainImages = append(trainImages, *img)
 		trainLabels = append(trainLabels, *l)
 	}
 
+	trainImagesTs, err := ts.Cat(trainImages, 0)
+	if err != nil {
+		return nil, err
+	}
+	trainLabelsTs, err := ts.Cat(trainLabels, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Dataset{
-		TrainImages: ts.MustCat(trainImages, 0),
-		TrainLabels: ts.MustCat(trainLabels, 0),
+		TrainImages: trainImagesTs,
+		TrainLabels: trainLabelsTs,
 		TestImages:  testImages,
 		TestLabels:  testLabels,
 		Labels:      10,
+	}, nil
+}
+
+// MustCFLoadDir loads all CIFAR-10 data from a given directory to Dataset.
+// It panics if error occurred.
+func MustCFLoadDir(dir string) *Dataset {
+	ds, err := CFLoadDir(dir)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return ds
 }
@@ -3,11 +3,10 @@ package vision
 // A simple dataset structure shared by various computer vision datasets.
 
 import (
-	// "fmt"
+	"fmt"
 	"log"
-	"math/rand"
-	"time"
 
+	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
 )
 
@@ -36,65 +35,94 @@ func (ds *Dataset) TestIter(batchSize int64) *ts.Iter2 {
 // RandomFlip randomly applies horizontal flips
 // This expects a 4 dimension NCHW tensor and returns a tensor with
 // an identical shape.
-func RandomFlip(t *ts.Tensor) *ts.Tensor {
+func RandomFlip(t *ts.Tensor) (*ts.Tensor, error) {
 
 	size := t.MustSize()
 
 	if len(size) != 4 {
-		log.Fatalf("Unexpected shape for tensor %v\n", size)
+		err := fmt.Errorf("Unexpected shape for tensor %v\n", size)
+		return nil, err
 	}
 
 	output, err := t.ZerosLike(false)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	for batchIdx := 0; batchIdx < int(size[0]); batchIdx++ {
-		outputView := output.Idx(ts.NewSelect(int64(batchIdx)))
-		tView := t.Idx(ts.NewSelect(int64(batchIdx)))
+		outputView, err := output.IdxE(ts.NewSelect(int64(batchIdx)))
+		if err != nil {
+			output.MustDrop()
+			return nil, err
+		}
+		tView, err := t.IdxE(ts.NewSelect(int64(batchIdx)))
+		if err != nil {
+			outputView.MustDrop()
+			output.MustDrop()
+			return nil, err
+		}
 
 		var src *ts.Tensor
-		if rand.Float64() == 1.0 {
+		if gotch.Rand().Float64() == 1.0 {
 			src = tView
 		} else {
 			src = tView.MustFlip([]int64{2}, false)
 		}
 
 		tView.MustDrop()
-		outputView.Copy_(src)
+		outputView.MustCopy_(src)
 		src.MustDrop()
 		outputView.MustDrop()
 	}
 
+	return output, nil
+}
+
+// MustRandomFlip randomly applies horizontal flips. It panics if error occurred.
+func MustRandomFlip(t *ts.Tensor) *ts.Tensor {
+	output, err := RandomFlip(t)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return output
 }
 
 // Pad the image using reflections and take some random crops.
 // This expects a 4 dimension NCHW tensor and returns a tensor with
 // an identical shape.
-func RandomCrop(t *ts.Tensor, pad int64) *ts.Tensor {
+func RandomCrop(t *ts.Tensor, pad int64) (*ts.Tensor, error) {
 
 	size := t.MustSize()
 
 	if len(size) < 4 {
-		log.Fatalf("Unexpected shape (%v) for tensor %v\n", size, t)
+		err := fmt.Errorf("Unexpected shape (%v) for tensor %v\n", size, t)
+		return nil, err
 	}
 
 	szH := size[2]
 	szW := size[3]
-	padded := t.MustReflectionPad2d([]int64{pad, pad, pad, pad}, false)
+	padded, err := t.ReflectionPad2d([]int64{pad, pad, pad, pad}, false)
+	if err != nil {
+		return nil, err
+	}
 	output, err := t.ZerosLike(false)
 	if err != nil {
-		log.Fatal(err)
+		padded.MustDrop()
+		return nil, err
 	}
 
 	for bidx := 0; bidx < int(size[0]); bidx++ {
 		idx := ts.NewSelect(int64(bidx))
-		outputView := output.Idx(idx)
+		outputView, err := output.IdxE(idx)
+		if err != nil {
+			padded.MustDrop()
+			output.MustDrop()
+			return nil, err
+		}
 
-		rand.Seed(time.Now().UnixNano())
-		startW := rand.Intn(int(2 * pad))
-		startH := rand.Intn(int(2 * pad))
+		startW := gotch.Rand().Intn(int(2 * pad))
+		startH := gotch.Rand().Intn(int(2 * pad))
 
 		var srcIdx []ts.TensorIndexer
 		nIdx := ts.NewSelect(int64(bidx))
@@ -102,25 +130,43 @@ func RandomCrop(t *ts.Tensor, pad int64) *ts.Tensor {
 		hIdx := ts.NewNarrow(int64(startH), int64(startH)+szH)
 		wIdx := ts.NewNarrow(int64(startW), int64(startW)+szW)
 		srcIdx = append(srcIdx, nIdx, cIdx, hIdx, wIdx)
-		src := padded.Idx(srcIdx)
-		outputView.Copy_(src)
+		src, err := padded.IdxE(srcIdx)
+		if err != nil {
+			outputView.MustDrop()
+			padded.MustDrop()
+			output.MustDrop()
+			return nil, err
+		}
+		outputView.MustCopy_(src)
 		src.MustDrop()
 		outputView.MustDrop()
 	}
 
 	padded.MustDrop()
 
+	return output, nil
+}
+
+// MustRandomCrop pads the image using reflections and takes some random crops.
+// It panics if error occurred.
+func MustRandomCrop(t *ts.Tensor, pad int64) *ts.Tensor {
+	output, err := RandomCrop(t, pad)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return output
 }
 
 // Applies cutout: randomly remove some square areas in the original images.
 // https://arxiv.org/abs/1708.04552
-func RandomCutout(t *ts.Tensor, sz int64) *ts.Tensor {
+func RandomCutout(t *ts.Tensor, sz int64) (*ts.Tensor, error) {
 
 	size := t.MustSize()
 
 	if len(size) != 4 || sz > size[2] || sz > size[3] {
-		log.Fatalf("Unexpected shape (%v) for tensor %v\n", size, t)
+		err := fmt.Errorf("Unexpected shape (%v) for tensor %v\n", size, t)
+		return nil, err
 	}
 
 	// output, err := t.ShallowClone()
@@ -130,16 +176,15 @@ func RandomCutout(t *ts.Tensor, sz int64) *ts.Tensor {
 
 	output, err := t.ZerosLike(false)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	output.Copy_(t)
+	output.MustCopy_(t)
 
 	for bidx := 0; bidx < int(size[0]); bidx++ {
 
-		rand.Seed(time.Now().UnixNano())
-		startH := rand.Intn(int(size[2] - sz + 1))
-		startW := rand.Intn(int(size[3] - sz + 1))
+		startH := gotch.Rand().Intn(int(size[2] - sz + 1))
+		startW := gotch.Rand().Intn(int(size[3] - sz + 1))
 
 		var srcIdx []ts.TensorIndexer
 		nIdx := ts.NewSelect(int64(bidx))
@@ -155,46 +200,85 @@ func RandomCutout(t *ts.Tensor, sz int64) *ts.Tensor {
 		// zeroSc.MustDrop()
 		// view.MustDrop()
 
-		view := output.Idx(srcIdx)
+		view, err := output.IdxE(srcIdx)
+		if err != nil {
+			output.MustDrop()
+			return nil, err
+		}
 		zeroTs, err := view.ZerosLike(false)
 		if err != nil {
-			log.Fatal(err)
+			view.MustDrop()
+			output.MustDrop()
+			return nil, err
 		}
-		view.Copy_(zeroTs)
+		view.MustCopy_(zeroTs)
 		zeroTs.MustDrop()
 		view.MustDrop()
 	}
 
+	return output, nil
+}
+
+// MustRandomCutout randomly removes some square areas in the original images.
+// It panics if error occurred.
+func MustRandomCutout(t *ts.Tensor, sz int64) *ts.Tensor {
+	output, err := RandomCutout(t, sz)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return output
 }
 
-func Augmentation(t *ts.Tensor, flip bool, crop int64, cutout int64) *ts.Tensor {
+func Augmentation(t *ts.Tensor, flip bool, crop int64, cutout int64) (*ts.Tensor, error) {
 
 	tclone := t.MustShallowClone()
 
-	var flipTs *ts.Tensor
+	var (
+		flipTs *ts.Tensor
+		err    error
+	)
 	if flip {
-		flipTs = RandomFlip(tclone)
+		flipTs, err = RandomFlip(tclone)
 		tclone.MustDrop()
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		flipTs = tclone
 	}
 
 	var cropTs *ts.Tensor
 	if crop > 0 {
-		cropTs = RandomCrop(flipTs, crop)
+		cropTs, err = RandomCrop(flipTs, crop)
 		flipTs.MustDrop()
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		cropTs = flipTs
 	}
 
 	var output *ts.Tensor
 	if cutout > 0 {
-		output = RandomCutout(cropTs, cutout)
+		output, err = RandomCutout(cropTs, cutout)
 		cropTs.MustDrop()
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		output = cropTs
 	}
 
+	return output, nil
+}
+
+// MustAugmentation applies flip, crop and cutout augmentations. It panics if error occurred.
+func MustAugmentation(t *ts.Tensor, flip bool, crop int64, cutout int64) *ts.Tensor {
+	output, err := Augmentation(t, flip, crop, cutout)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return output
 }
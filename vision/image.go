@@ -4,8 +4,13 @@ package vision
 
 import (
 	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
 	"io/ioutil"
-	"log"
 	"math"
 
 	"github.com/sugarme/gotch"
@@ -13,20 +18,22 @@ import (
 )
 
 // (height, width, channel) -> (channel, height, width)
-func hwcToCHW(tensor *ts.Tensor) *ts.Tensor {
+func hwcToCHW(tensor *ts.Tensor) (*ts.Tensor, error) {
 	retVal, err := tensor.Permute([]int64{2, 0, 1}, false)
 	if err != nil {
-		log.Fatalf("hwcToCHW error: %v\n", err)
+		err = fmt.Errorf("hwcToCHW error: %v\n", err)
+		return nil, err
 	}
-	return retVal
+	return retVal, nil
 }
 
-func chwToHWC(tensor *ts.Tensor) *ts.Tensor {
+func chwToHWC(tensor *ts.Tensor) (*ts.Tensor, error) {
 	retVal, err := tensor.Permute([]int64{1, 2, 0}, false)
 	if err != nil {
-		log.Fatalf("hwcToCHW error: %v\n", err)
+		err = fmt.Errorf("chwToHWC error: %v\n", err)
+		return nil, err
 	}
-	return retVal
+	return retVal, nil
 }
 
 // Load loads an image from a file.
@@ -39,8 +46,11 @@ func Load(path string) (*ts.Tensor, error) {
 		return nil, err
 	}
 
-	loadedTs := hwcToCHW(tensor)
+	loadedTs, err := hwcToCHW(tensor)
 	tensor.MustDrop()
+	if err != nil {
+		return nil, err
+	}
 
 	return loadedTs, nil
 }
@@ -69,13 +79,19 @@ func Save(tensor *ts.Tensor, path string) error {
 	switch {
 	case len(shape) == 4 && shape[0] == 1:
 		tsCHW = t.MustSqueeze1(int64(0), true)
-		chwTs := chwToHWC(tsCHW)
+		chwTs, err := chwToHWC(tsCHW)
 		tsCHW.MustDrop()
+		if err != nil {
+			return err
+		}
 		tsHWC = chwTs.MustTo(gotch.CPU, true)
 	case len(shape) == 3:
 		chwTs := t.MustTo(gotch.CPU, true)
-		tsHWC = chwToHWC(chwTs)
+		tsHWC, err = chwToHWC(chwTs)
 		chwTs.MustDrop()
+		if err != nil {
+			return err
+		}
 	default:
 		err = fmt.Errorf("Unexpected size (%v) for image tensor.\n", len(shape))
 		return err
@@ -94,15 +110,21 @@ func Save(tensor *ts.Tensor, path string) error {
 // This expects as input a tensor of shape [channel, height, width] and returns
 // a tensor of shape [channel, out_h, out_w].
 func Resize(t *ts.Tensor, outW int64, outH int64) (*ts.Tensor, error) {
-	hwcTs := chwToHWC(t)
+	hwcTs, err := chwToHWC(t)
+	if err != nil {
+		return nil, err
+	}
 	tmpTs, err := ts.ResizeHwc(hwcTs, outW, outH)
 	if err != nil {
 		return nil, err
 	}
 	hwcTs.MustDrop()
 
-	tsCHW := hwcToCHW(tmpTs)
+	tsCHW, err := hwcToCHW(tmpTs)
 	tmpTs.MustDrop()
+	if err != nil {
+		return nil, err
+	}
 
 	return tsCHW, nil
 }
@@ -124,8 +146,11 @@ func resizePreserveAspectRatioHWC(t *ts.Tensor, outW int64, outH int64) (*ts.Ten
 			err = fmt.Errorf("resizePreserveAspectRatioHWC - ts.ResizeHwc() method call err: %v\n", err)
 			return nil, err
 		}
-		tsCHW := hwcToCHW(tmpTs)
+		tsCHW, err := hwcToCHW(tmpTs)
 		tmpTs.MustDrop()
+		if err != nil {
+			return nil, err
+		}
 
 		return tsCHW, nil
 
@@ -141,8 +166,15 @@ func resizePreserveAspectRatioHWC(t *ts.Tensor, outW int64, outH int64) (*ts.Ten
 		resizeH = maxInt64(resizeH, outH)
 
 		tmpTs, err := ts.ResizeHwc(t, resizeW, resizeH)
-		tsCHW := hwcToCHW(tmpTs)
+		if err != nil {
+			err = fmt.Errorf("resizePreserveAspectRatioHWC - ts.ResizeHwc() method call err: %v\n", err)
+			return nil, err
+		}
+		tsCHW, err := hwcToCHW(tmpTs)
 		tmpTs.MustDrop()
+		if err != nil {
+			return nil, err
+		}
 
 		var tensorW *ts.Tensor
 		if resizeW == outW {
@@ -184,7 +216,10 @@ func resizePreserveAspectRatioHWC(t *ts.Tensor, outW int64, outH int64) (*ts.Ten
 //
 // This expects as input a tensor of shape [channel, height, width] and returns
 func ResizePreserveAspectRatio(t *ts.Tensor, outW int64, outH int64) (*ts.Tensor, error) {
-	hwcTs := chwToHWC(t)
+	hwcTs, err := chwToHWC(t)
+	if err != nil {
+		return nil, err
+	}
 	resizedTs, err := resizePreserveAspectRatioHWC(hwcTs, outW, outH)
 	if err != nil {
 		return nil, err
@@ -250,3 +285,257 @@ func maxInt64(v1, v2 int64) int64 {
 
 	return v2
 }
+
+// Go images:
+// ==========
+//
+// `FromImage` and `ToImage` convert between Go `image.Image` and tensors of
+// shape [channel, height, width]:
+//
+//   - Gray: 1 channel of kind Uint8.
+//   - Gray16: 1 channel of kind Int (int32) with values ranging from 0 to
+//     65535.
+//   - RGBA, NRGBA and other images: 4 channels (R, G, B, A) of kind Uint8 with
+//     non-premultiplied alpha, as stored in PNG files. Use
+//     `t.MustNarrow(0, 0, 3, false)` to drop the alpha channel.
+//   - YCbCr (e.g. JPEG): 3 channels (R, G, B) of kind Uint8.
+//
+// `Decode` returns 3 channels (R, G, B) of kind Uint8 for all images as
+// `Load` does.
+
+// FromImage converts a Go image to a tensor of shape [channel, height, width]
+// (see "Go images" for channels and kinds).
+func FromImage(img image.Image) (*ts.Tensor, error) {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	var (
+		data []uint8
+		c    int
+	)
+	switch img := img.(type) {
+	case *image.Gray:
+		c = 1
+		data = make([]uint8, 0, h*w)
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			i := img.PixOffset(b.Min.X, y)
+			data = append(data, img.Pix[i:i+w]...)
+		}
+
+	case *image.Gray16:
+		values := make([]int32, 0, h*w)
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				values = append(values, int32(img.Gray16At(x, y).Y))
+			}
+		}
+		return ofImageData(values, []int64{int64(h), int64(w), 1})
+
+	case *image.NRGBA:
+		c = 4
+		data = make([]uint8, 0, h*w*4)
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			i := img.PixOffset(b.Min.X, y)
+			data = append(data, img.Pix[i:i+w*4]...)
+		}
+
+	case *image.YCbCr:
+		c = 3
+		data = make([]uint8, 0, h*w*3)
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				ycc := img.YCbCrAt(x, y)
+				red, green, blue := color.YCbCrToRGB(ycc.Y, ycc.Cb, ycc.Cr)
+				data = append(data, red, green, blue)
+			}
+		}
+
+	default:
+		// *image.RGBA (alpha-premultiplied) and others.
+		c = 4
+		data = make([]uint8, 0, h*w*4)
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				p := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+				data = append(data, p.R, p.G, p.B, p.A)
+			}
+		}
+	}
+
+	return ofImageData(data, []int64{int64(h), int64(w), int64(c)})
+}
+
+// ofImageData creates a tensor of shape [channel, height, width] from data of
+// shape [height, width, channel].
+func ofImageData(data interface{}, hwc []int64) (*ts.Tensor, error) {
+	x, err := ts.OfSlice(data)
+	if err != nil {
+		return nil, err
+	}
+	hwcTs, err := x.View(hwc, true)
+	if err != nil {
+		return nil, err
+	}
+	chwTs, err := hwcToCHW(hwcTs)
+	hwcTs.MustDrop()
+	if err != nil {
+		return nil, err
+	}
+
+	return chwTs, nil
+}
+
+// ToImage converts a tensor of shape [channel, height, width] (or [1,
+// channel, height, width]) with 1, 3 or 4 channels to a Go image (see "Go
+// images"):
+//
+//   - 1 channel of kind Int: *image.Gray16.
+//   - 1 channel of other kinds: *image.Gray.
+//   - 3 channels: *image.RGBA (opaque).
+//   - 4 channels: *image.NRGBA.
+//
+// Tensors of kinds other than Uint8 (except 1 channel Int) are converted to
+// Uint8, so values should range from 0 to 255.
+func ToImage(tensor *ts.Tensor) (image.Image, error) {
+	shape, err := tensor.Size()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(shape) == 4 && shape[0] == 1:
+		shape = shape[1:]
+	case len(shape) == 3:
+	default:
+		err := fmt.Errorf("ToImage - Unexpected shape (%v) for image tensor.\n", shape)
+		return nil, err
+	}
+	c, h, w := shape[0], int(shape[1]), int(shape[2])
+	if c != 1 && c != 3 && c != 4 {
+		err := fmt.Errorf("ToImage - Unexpected number of channels (%v), should be 1, 3 or 4.\n", c)
+		return nil, err
+	}
+
+	x, err := tensor.View([]int64{c, int64(h), int64(w)}, false)
+	if err != nil {
+		return nil, err
+	}
+	defer x.MustDrop()
+	rect := image.Rect(0, 0, w, h)
+
+	dtype, err := x.DTypeE()
+	if err != nil {
+		return nil, err
+	}
+	if c == 1 && dtype == gotch.Int {
+		vals, err := x.ValsE()
+		if err != nil {
+			return nil, err
+		}
+		img := image.NewGray16(rect)
+		for i, v := range vals.([]int32) {
+			if v < 0 {
+				v = 0
+			} else if v > math.MaxUint16 {
+				v = math.MaxUint16
+			}
+			img.SetGray16(i%w, i/w, color.Gray16{Y: uint16(v)})
+		}
+		return img, nil
+	}
+
+	u8, err := x.Totype(gotch.Uint8, false)
+	if err != nil {
+		return nil, err
+	}
+	hwcTs, err := chwToHWC(u8)
+	u8.MustDrop()
+	if err != nil {
+		return nil, err
+	}
+	vals, err := hwcTs.ValsE()
+	hwcTs.MustDrop()
+	if err != nil {
+		return nil, err
+	}
+	data := vals.([]uint8)
+
+	switch c {
+	case 1:
+		img := image.NewGray(rect)
+		copy(img.Pix, data)
+		return img, nil
+	case 3:
+		img := image.NewRGBA(rect)
+		for i := 0; i < h*w; i++ {
+			copy(img.Pix[i*4:i*4+3], data[i*3:i*3+3])
+			img.Pix[i*4+3] = 255
+		}
+		return img, nil
+	default:
+		img := image.NewNRGBA(rect)
+		copy(img.Pix, data)
+		return img, nil
+	}
+}
+
+// Decode decodes an image in a format registered with package `image` (JPEG,
+// PNG and GIF are registered by this package) from r and returns a tensor of
+// shape [3, height, width] of kind Uint8 and the format name.
+//
+// As `Load`, gray images are converted to RGB and alpha channel, if any, is
+// dropped so that images received over network or stored in archives can be
+// processed as images loaded from files. Use `image.Decode` and `FromImage`
+// to keep channels of the image.
+func Decode(r io.Reader) (*ts.Tensor, string, error) {
+	img, format, err := image.Decode(r)
+	if err != nil {
+		err = fmt.Errorf("Decode - image.Decode() error: %w\n", err)
+		return nil, "", err
+	}
+
+	b := img.Bounds()
+	yccImg, isYCbCr := img.(*image.YCbCr)
+	data := make([]uint8, 0, b.Dx()*b.Dy()*3)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if isYCbCr {
+				ycc := yccImg.YCbCrAt(x, y)
+				red, green, blue := color.YCbCrToRGB(ycc.Y, ycc.Cb, ycc.Cr)
+				data = append(data, red, green, blue)
+				continue
+			}
+			p := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			data = append(data, p.R, p.G, p.B)
+		}
+	}
+
+	tensor, err := ofImageData(data, []int64{int64(b.Dy()), int64(b.Dx()), 3})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return tensor, format, nil
+}
+
+// Encode encodes a tensor of shape [channel, height, width] (see `ToImage`)
+// to w in `format`, which is one of "png", "jpeg" (or "jpg", with quality 90)
+// and "gif".
+func Encode(w io.Writer, tensor *ts.Tensor, format string) error {
+	img, err := ToImage(tensor)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "png":
+		err = png.Encode(w, img)
+	case "jpeg", "jpg":
+		err = jpeg.Encode(w, img, &jpeg.Options{Quality: 90})
+	case "gif":
+		err = gif.Encode(w, img, nil)
+	default:
+		err = fmt.Errorf("Encode - Unsupported image format %q.\n", format)
+	}
+
+	return err
+}
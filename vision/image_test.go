@@ -0,0 +1,125 @@
+package vision_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch/vision"
+)
+
+func TestFromImageToImage(t *testing.T) {
+	gray := image.NewGray(image.Rect(0, 0, 3, 2))
+	copy(gray.Pix, []uint8{0, 1, 2, 3, 4, 5})
+	x, err := vision.FromImage(gray)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int64{1, 2, 3}
+	if !reflect.DeepEqual(want, x.MustSize()) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", x.MustSize())
+	}
+	img, err := vision.ToImage(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gray, img) {
+		t.Errorf("Want: %v\n", gray)
+		t.Errorf("Got: %v\n", img)
+	}
+	x.MustDrop()
+
+	gray16 := image.NewGray16(image.Rect(0, 0, 2, 1))
+	gray16.SetGray16(1, 0, color.Gray16{Y: 60000})
+	x, err = vision.FromImage(gray16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err = vision.ToImage(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gray16, img) {
+		t.Errorf("Want: %v\n", gray16)
+		t.Errorf("Got: %v\n", img)
+	}
+	x.MustDrop()
+
+	// Channels are R, G, B, A with non-premultiplied alpha.
+	rgba := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	rgba.Set(0, 0, color.NRGBA{R: 200, G: 100, B: 0, A: 255})
+	x, err = vision.FromImage(rgba)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantVals := []uint8{200, 100, 0, 255}
+	if !reflect.DeepEqual(wantVals, x.Vals()) {
+		t.Errorf("Want: %v\n", wantVals)
+		t.Errorf("Got: %v\n", x.Vals())
+	}
+	x.MustDrop()
+}
+
+func TestEncodeDecode(t *testing.T) {
+	nrgba := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	for i := range nrgba.Pix {
+		nrgba.Pix[i] = uint8(i * 10)
+	}
+	x, err := vision.FromImage(nrgba)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer x.MustDrop()
+
+	var buf bytes.Buffer
+	if err := vision.Encode(&buf, x, "png"); err != nil {
+		t.Fatal(err)
+	}
+	y, format, err := vision.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer y.MustDrop()
+
+	if format != "png" {
+		t.Errorf("Want: %v\n", "png")
+		t.Errorf("Got: %v\n", format)
+	}
+	// Decode drops alpha channel as Load.
+	rgb := x.MustNarrow(0, 0, 3, false)
+	defer rgb.MustDrop()
+	if !reflect.DeepEqual(rgb.MustSize(), y.MustSize()) {
+		t.Errorf("Want: %v\n", rgb.MustSize())
+		t.Errorf("Got: %v\n", y.MustSize())
+	}
+	if !reflect.DeepEqual(rgb.Vals(), y.Vals()) {
+		t.Errorf("Want: %v\n", rgb.Vals())
+		t.Errorf("Got: %v\n", y.Vals())
+	}
+
+	// Gray images are decoded to RGB.
+	gray := image.NewGray(image.Rect(0, 0, 2, 1))
+	copy(gray.Pix, []uint8{7, 9})
+	buf.Reset()
+	if err := png.Encode(&buf, gray); err != nil {
+		t.Fatal(err)
+	}
+	z, _, err := vision.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer z.MustDrop()
+	wantGray := []uint8{7, 9, 7, 9, 7, 9}
+	if !reflect.DeepEqual(wantGray, z.Vals()) {
+		t.Errorf("Want: %v\n", wantGray)
+		t.Errorf("Got: %v\n", z.Vals())
+	}
+
+	if err := vision.Encode(&buf, x, "tiff"); err == nil {
+		t.Errorf("Want error for unsupported format\n")
+	}
+}
@@ -24,8 +24,8 @@ type ImageNet struct {
 func NewImageNet() *ImageNet {
 	return &ImageNet{
 		mutex: &sync.Mutex{},
-		mean:  ts.MustOfSlice([]float32{0.485, 0.456, 0.406}).MustView([]int64{3, 1, 1}, true),
-		std:   ts.MustOfSlice([]float32{0.229, 0.224, 0.225}).MustView([]int64{3, 1, 1}, true),
+		mean:  ts.Untrack(ts.MustOfSlice([]float32{0.485, 0.456, 0.406}).MustView([]int64{3, 1, 1}, true)),
+		std:   ts.Untrack(ts.MustOfSlice([]float32{0.229, 0.224, 0.225}).MustView([]int64{3, 1, 1}, true)),
 	}
 }
 
@@ -216,7 +216,7 @@ func (in *ImageNet) LoadFromDir(path string) (*Dataset, error) {
 
 	absPath, err := filepath.Abs(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	trainPath := fmt.Sprintf("%v/train", absPath)
@@ -1322,7 +1322,7 @@ type TopItem struct {
 }
 
 // Returns the top k classes as well as the associated scores.
-func (in *ImageNet) Top(input *ts.Tensor, k int64) []TopItem {
+func (in *ImageNet) Top(input *ts.Tensor, k int64) ([]TopItem, error) {
 
 	var tensor *ts.Tensor
 	shape := input.MustSize()
@@ -1335,7 +1335,8 @@ func (in *ImageNet) Top(input *ts.Tensor, k int64) []TopItem {
 	case reflect.DeepEqual(shape, []int64{1, 1, imagenetClassCount}):
 		tensor = input.MustView([]int64{imagenetClassCount}, false) // shape: [1000]
 	default:
-		log.Fatalf("Unexpected tensor shape: %v\n", shape)
+		err := fmt.Errorf("Unexpected tensor shape: %v\n", shape)
+		return nil, err
 	}
 
 	valsTs, idxsTs := tensor.MustTopK(k, 0, true, true)
@@ -1356,5 +1357,16 @@ func (in *ImageNet) Top(input *ts.Tensor, k int64) []TopItem {
 		topItems = append(topItems, item)
 	}
 
+	return topItems, nil
+}
+
+// MustTop returns the top k classes as well as the associated scores.
+// It panics if error occurred.
+func (in *ImageNet) MustTop(input *ts.Tensor, k int64) []TopItem {
+	topItems, err := in.Top(input, k)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return topItems
 }
@@ -52,62 +52,64 @@ func checkMagicNumber(f *os.File, wantNumber int) (err error) {
 	return nil
 }
 
-func readLabels(filename string) *ts.Tensor {
+func readLabels(filename string) (*ts.Tensor, error) {
 
 	f, err := os.Open(filename)
 	if err != nil {
-		log.Fatalf("readLabels errors: %v\n", err)
+		err = fmt.Errorf("readLabels errors: %v\n", err)
+		return nil, err
 	}
 	defer f.Close()
 
 	if err = checkMagicNumber(f, 2049); err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	samples, err := readInt32(f)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	var data []uint8 = make([]uint8, samples)
 	len, err := f.Read(data)
 	if err != nil || len != samples {
 		err = fmt.Errorf("invalid format %v", f.Name())
-		log.Fatal(err)
+		return nil, err
 	}
 
 	labelsTs, err := ts.OfSlice(data)
 	if err != nil {
 		err = fmt.Errorf("create label tensor err.")
-		log.Fatal(err)
+		return nil, err
 	}
 
-	return labelsTs.MustTotype(gotch.Int64, true)
+	return labelsTs.Totype(gotch.Int64, true)
 }
 
-func readImages(filename string) *ts.Tensor {
+func readImages(filename string) (*ts.Tensor, error) {
 	f, err := os.Open(filename)
 	if err != nil {
-		log.Fatalf("readImages errors: %v\n", err)
+		err = fmt.Errorf("readImages errors: %v\n", err)
+		return nil, err
 	}
 	defer f.Close()
 
 	if err = checkMagicNumber(f, 2051); err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	samples, err := readInt32(f)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	rows, err := readInt32(f)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	cols, err := readInt32(f)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	dataLen := samples * rows * cols
@@ -115,20 +117,20 @@ func readImages(filename string) *ts.Tensor {
 	len, err := f.Read(data)
 	if err != nil || len != dataLen {
 		err = fmt.Errorf("invalid format %v", f.Name())
-		log.Fatal(err)
+		return nil, err
 	}
 
 	imagesTs, err := ts.OfSlice(data)
 	if err != nil {
 		err = fmt.Errorf("create images tensor err.")
-		log.Fatal(err)
+		return nil, err
 	}
 
-	return imagesTs.MustView([]int64{int64(samples), int64(rows * cols)}, true).MustTotype(gotch.Float, true).MustDiv1(ts.FloatScalar(255.0), true)
+	return imagesTs.MustView([]int64{int64(samples), int64(rows * cols)}, true).MustTotype(gotch.Float, true).Div1(ts.FloatScalar(255.0), true)
 }
 
 // LoadMNISTDir loads all MNIST data from a given directory to Dataset
-func LoadMNISTDir(dir string) *Dataset {
+func LoadMNISTDir(dir string) (*Dataset, error) {
 	const (
 		trainLabels = "train-labels-idx1-ubyte"
 		trainImages = "train-images-idx3-ubyte"
@@ -141,10 +143,22 @@ func LoadMNISTDir(dir string) *Dataset {
 	testLabelsFile := filepath.Join(dir, testLabels)
 	testImagesFile := filepath.Join(dir, testImages)
 
-	trainImagesTs := readImages(trainImagesFile)
-	trainLabelsTs := readLabels(trainLabelsFile)
-	testImagesTs := readImages(testImagesFile)
-	testLabelsTs := readLabels(testLabelsFile)
+	trainImagesTs, err := readImages(trainImagesFile)
+	if err != nil {
+		return nil, err
+	}
+	trainLabelsTs, err := readLabels(trainLabelsFile)
+	if err != nil {
+		return nil, err
+	}
+	testImagesTs, err := readImages(testImagesFile)
+	if err != nil {
+		return nil, err
+	}
+	testLabelsTs, err := readLabels(testLabelsFile)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Dataset{
 		TrainImages: trainImagesTs,
@@ -152,5 +166,16 @@ func LoadMNISTDir(dir string) *Dataset {
 		TestImages:  testImagesTs,
 		TestLabels:  testLabelsTs,
 		Labels:      10,
+	}, nil
+}
+
+// MustLoadMNISTDir loads all MNIST data from a given directory to Dataset.
+// It panics if error occurred.
+func MustLoadMNISTDir(dir string) *Dataset {
+	ds, err := LoadMNISTDir(dir)
+	if err != nil {
+		log.Fatal(err)
 	}
+
+	return ds
 }
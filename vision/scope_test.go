@@ -0,0 +1,63 @@
+package vision_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+	"github.com/sugarme/gotch/vision"
+	"github.com/sugarme/gotch/vision/aug"
+)
+
+func TestScope_ResNet(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	net := vision.ResNet18(vs.Root(), 10)
+
+	ts.CheckLeaks(t, func() {
+		ts.WithScope(func() {
+			x := ts.MustRandn([]int64{2, 3, 32, 32}, gotch.Float, gotch.CPU)
+			logits := net.ForwardT(x, false)
+
+			want := []int64{2, 10}
+			if got := logits.MustSize(); !reflect.DeepEqual(want, got) {
+				t.Errorf("Want: %v\n", want)
+				t.Errorf("Got: %v\n", got)
+			}
+		})
+	})
+}
+
+func TestScope_Augment(t *testing.T) {
+	tf, err := aug.Compose(
+		aug.WithRandomHFlip(1.0),
+		aug.WithRandomCutout(aug.WithCutoutPvalue(1.0)),
+		aug.WithColorJitter(aug.WithColorBrightness([]float64{0.3})),
+		aug.WithNormalize(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts.CheckLeaks(t, func() {
+		ts.WithScope(func() {
+			img := ts.MustOnes([]int64{3, 16, 16}, gotch.Float, gotch.CPU).MustMul1(ts.FloatScalar(128), true).MustTotype(gotch.Uint8, true)
+			out, err := tf.Transform(img)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := []int64{3, 16, 16}
+			if got := out.MustSize(); !reflect.DeepEqual(want, got) {
+				t.Errorf("Want: %v\n", want)
+				t.Errorf("Got: %v\n", got)
+			}
+		})
+	})
+
+	// Invalid options are reported by Compose.
+	if _, err := aug.Compose(aug.WithCenterCrop([]int64{1})); err == nil {
+		t.Errorf("Want error for invalid center crop size\n")
+	}
+}